@@ -0,0 +1,125 @@
+export default function (config) {
+  var allowMissingToken = Boolean(config.jwtAuth?.allowMissingToken)
+
+  var providers = (config.jwtAuth?.providers || []).map(p => {
+    var keys = {}
+    JSON.parse(p.jwks || '{"keys":[]}').keys.forEach(
+      (k, i) => keys[k.kid || `#${i}`] = new crypto.JWK(k)
+    )
+    var fromHeaders = p.fromHeaders || []
+    var fromParams = p.fromParams || []
+    if (fromHeaders.length === 0 && fromParams.length === 0) {
+      fromHeaders = [{ name: 'authorization', prefix: 'Bearer ' }]
+    }
+    return {
+      issuer: p.issuer,
+      audiences: p.audiences?.length > 0 ? p.audiences : null,
+      keys,
+      fromHeaders: fromHeaders.map(h => ({ name: h.name.toLowerCase(), prefix: h.prefix || '' })),
+      fromParams,
+      claimToHeaders: (p.outputClaimToHeaders || []).map(c => ({ header: c.header.toLowerCase(), claim: c.claim })),
+      forwardOriginalToken: Boolean(p.forwardOriginalToken),
+    }
+  })
+
+  var missingToken = new Message(
+    { status: 401, headers: { 'www-authenticate': 'Bearer' } },
+    'Unauthorized'
+  )
+
+  function unauthorized(reason) {
+    return new Message(
+      { status: 401, headers: { 'www-authenticate': `Bearer error="invalid_token", error_description="${reason}"` } },
+      'Unauthorized'
+    )
+  }
+
+  function extractToken(provider, head, params) {
+    for (var h of provider.fromHeaders) {
+      var value = head.headers[h.name]
+      if (value && value.startsWith(h.prefix)) {
+        return { token: value.substring(h.prefix.length).trim(), header: h.name }
+      }
+    }
+    for (var p of provider.fromParams) {
+      var value = params()?.get(p)
+      if (value) return { token: value }
+    }
+    return null
+  }
+
+  function verifyToken(provider, token) {
+    var jwt = new crypto.JWT(token)
+    if (!jwt.isValid) return 'malformed token'
+    var payload = jwt.payload
+    if (payload.iss !== provider.issuer) return 'issuer mismatch'
+    var kid = jwt.header?.kid
+    var key = provider.keys[kid]
+    if (!key && !kid) {
+      var all = Object.values(provider.keys)
+      if (all.length === 1) key = all[0]
+    }
+    if (!key) return 'no matching key'
+    if (!jwt.verify(key)) return 'signature verification failed'
+    var now = Date.now() / 1000
+    if (payload.exp && payload.exp < now) return 'token expired'
+    if (payload.nbf && payload.nbf > now) return 'token not yet valid'
+    if (provider.audiences) {
+      var aud = Array.isArray(payload.aud) ? payload.aud : [payload.aud]
+      if (!aud.some(a => provider.audiences.includes(a))) return 'audience mismatch'
+    }
+    return payload
+  }
+
+  function applyClaims(provider, extracted, head, payload) {
+    provider.claimToHeaders.forEach(c => {
+      var value = c.claim.split('.').reduce((v, k) => v?.[k], payload)
+      if (value === undefined || value === null) {
+        delete head.headers[c.header]
+      } else {
+        head.headers[c.header] = typeof value === 'object' ? JSON.stringify(value) : `${value}`
+      }
+    })
+    if (!provider.forwardOriginalToken && extracted.header) {
+      delete head.headers[extracted.header]
+    }
+  }
+
+  function authenticate(head) {
+    var query
+    var params = () => {
+      if (query === undefined) {
+        query = head.path?.includes('?') ? new URL(`http://localhost${head.path}`).searchParams : null
+      }
+      return query
+    }
+    var reason = null
+    var found = false
+    for (var provider of providers) {
+      var extracted = extractToken(provider, head, params)
+      if (!extracted?.token) continue
+      found = true
+      var result = verifyToken(provider, extracted.token)
+      if (typeof result === 'string') {
+        reason = result
+        continue
+      }
+      applyClaims(provider, extracted, head, result)
+      return null
+    }
+    if (!found) return allowMissingToken ? null : missingToken
+    return unauthorized(reason)
+  }
+
+  var $reject = null
+
+  return pipeline($=>$
+    .handleMessageStart(
+      msg => { $reject = authenticate(msg.head) }
+    )
+    .pipe(() => $reject ? 'reject' : 'pass', {
+      'reject': $=>$.replaceData().replaceMessage(() => $reject),
+      'pass': $=>$.pipeNext(),
+    })
+  )
+}
@@ -51,7 +51,13 @@
     Boolean(config?.tls?.enabled), (
       $=>$
         .listen(config?.tls?.listen || 8443)
+        .handleTLSClientHello(
+          hello => (
+            _sni = hello?.serverNames?.[0]
+          )
+        )
         .branch(
+          () => Boolean(_sni && config?.serviceExports?.[_sni]), 'service-export',
           () => config?.sslPassthrough?.enabled === true, 'passthrough',
           'inbound-tls'
         )
@@ -102,6 +108,9 @@
       )
     }).to('inbound-http')
 
+  .pipeline('service-export')
+    .connect(() => config.serviceExports[_sni])
+
   .pipeline('passthrough')
     .handleTLSClientHello(
       hello => (
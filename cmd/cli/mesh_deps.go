@@ -0,0 +1,255 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/constants"
+	"github.com/flomesh-io/fsm/pkg/dependency"
+)
+
+const meshDepsDescription = `
+This command prints the services called by the workloads in the mesh as a
+dependency graph, learned by the fsm controller from the metrics of the sidecars
+while dependency discovery is enabled.
+
+Dependency discovery is enabled with 'spec.observability.dependencyDiscovery.enable'
+in the MeshConfig. The graph can be printed as JSON or in the DOT format of Graphviz.
+
+With --recommend-scope, the command prints instead a namespace wide SidecarScope
+policy per namespace, limiting the sidecars to the services their workloads call.
+`
+
+const meshDepsExample = `
+# Print the services called by the workloads of namespace 'bookbuyer' as JSON
+fsm mesh deps --namespace bookbuyer
+
+# Render the dependency graph of the mesh with Graphviz
+fsm mesh deps --output dot | dot -Tsvg > deps.svg
+
+# Apply the recommended SidecarScope policies
+fsm mesh deps --recommend-scope | kubectl apply -f -
+`
+
+const (
+	meshDepsOutputJSON = "json"
+	meshDepsOutputDOT  = "dot"
+)
+
+type meshDepsCmd struct {
+	out            io.Writer
+	namespace      string
+	output         string
+	recommendScope bool
+	clientSet      kubernetes.Interface
+}
+
+func newMeshDepsCmd(out io.Writer) *cobra.Command {
+	depsCmd := &meshDepsCmd{
+		out: out,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "deps",
+		Short: "print the services called by the workloads in the mesh",
+		Long:  meshDepsDescription,
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if depsCmd.output != meshDepsOutputJSON && depsCmd.output != meshDepsOutputDOT {
+				return fmt.Errorf("Invalid output format [%s], must be one of [%s, %s]", depsCmd.output, meshDepsOutputJSON, meshDepsOutputDOT)
+			}
+
+			config, err := settings.RESTClientGetter().ToRESTConfig()
+			if err != nil {
+				return fmt.Errorf("Error fetching kubeconfig: %w", err)
+			}
+
+			clientset, err := kubernetes.NewForConfig(config)
+			if err != nil {
+				return fmt.Errorf("Could not access Kubernetes cluster, check kubeconfig: %w", err)
+			}
+			depsCmd.clientSet = clientset
+
+			return depsCmd.run()
+		},
+		Example: meshDepsExample,
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&depsCmd.namespace, "namespace", "", "Namespace of the workloads, all the namespaces if not specified")
+	f.StringVar(&depsCmd.output, "output", meshDepsOutputJSON, "Output format, one of [json, dot]")
+	f.BoolVar(&depsCmd.recommendScope, "recommend-scope", false, "Print the recommended SidecarScope policies instead of the dependency graph")
+
+	return cmd
+}
+
+func (cmd *meshDepsCmd) run() error {
+	dependencies, err := cmd.getDependencies()
+	if err != nil {
+		return err
+	}
+
+	if cmd.recommendScope {
+		scopes := recommendSidecarScopes(dependencies)
+		if len(scopes) == 0 {
+			return errors.New("No service dependencies found")
+		}
+
+		for i, scope := range scopes {
+			out, err := yaml.Marshal(scope)
+			if err != nil {
+				return fmt.Errorf("Error marshalling SidecarScope %s/%s: %w", scope.Namespace, scope.Name, err)
+			}
+			if i > 0 {
+				fmt.Fprintln(cmd.out, "---")
+			}
+			fmt.Fprint(cmd.out, string(out))
+		}
+		return nil
+	}
+
+	if cmd.output == meshDepsOutputDOT {
+		fmt.Fprint(cmd.out, dependencyGraphDOT(dependencies))
+		return nil
+	}
+
+	out, err := json.MarshalIndent(dependencies, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Error marshalling service dependencies: %w", err)
+	}
+	fmt.Fprintln(cmd.out, string(out))
+	return nil
+}
+
+func (cmd *meshDepsCmd) getDependencies() ([]dependency.Dependency, error) {
+	fsmNamespace := settings.FsmNamespace()
+
+	controllerPods, err := getControllerPods(cmd.clientSet, fsmNamespace)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing fsm-controller pods in namespace [%s]: %w", fsmNamespace, err)
+	}
+	if len(controllerPods.Items) == 0 {
+		return nil, fmt.Errorf("No fsm-controller pods found in namespace [%s]", fsmNamespace)
+	}
+
+	var errs []error
+	for _, pod := range controllerPods.Items {
+		params := map[string]string{"namespace": cmd.namespace}
+		resp, err := cmd.clientSet.CoreV1().Pods(fsmNamespace).ProxyGet("", pod.Name, strconv.Itoa(constants.FSMHTTPServerPort), constants.FSMControllerServiceDependenciesPath, params).DoRaw(context.TODO())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("Error retrieving service dependencies from pod [%s] in namespace [%s]: %w", pod.Name, fsmNamespace, err))
+			continue
+		}
+
+		var dependencies []dependency.Dependency
+		if err := json.Unmarshal(resp, &dependencies); err != nil {
+			errs = append(errs, fmt.Errorf("Error unmarshalling service dependencies from pod [%s] in namespace [%s]: %w", pod.Name, fsmNamespace, err))
+			continue
+		}
+		return dependencies, nil
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+// dependencyGraphDOT renders the dependencies as a directed graph in the DOT format,
+// workloads are boxes named after their service account and services are ellipses
+func dependencyGraphDOT(dependencies []dependency.Dependency) string {
+	var sb strings.Builder
+	sb.WriteString("digraph dependencies {\n")
+	sb.WriteString("  rankdir=LR;\n")
+
+	workloads := make(map[string]struct{})
+	services := make(map[string]struct{})
+	var edges []string
+	for _, dep := range dependencies {
+		source := fmt.Sprintf("%s/%s", dep.Source.Namespace, dep.Source.ServiceAccount)
+		destination := fmt.Sprintf("%s/%s", dep.Destination.Namespace, dep.Destination.Name)
+		workloads[source] = struct{}{}
+		services[destination] = struct{}{}
+		edges = append(edges, fmt.Sprintf("  %q -> %q [label=%q];\n", "sa:"+source, "svc:"+destination,
+			fmt.Sprintf("%d: %d req, %d bytes", dep.Destination.TargetPort, dep.Requests, dep.Bytes)))
+	}
+
+	for _, name := range sortedKeys(workloads) {
+		fmt.Fprintf(&sb, "  %q [label=%q, shape=box];\n", "sa:"+name, name)
+	}
+	for _, name := range sortedKeys(services) {
+		fmt.Fprintf(&sb, "  %q [label=%q, shape=ellipse];\n", "svc:"+name, name)
+	}
+	for _, edge := range edges {
+		sb.WriteString(edge)
+	}
+
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// recommendSidecarScopes builds a namespace wide SidecarScope policy per namespace of the workloads,
+// allowing the services outside of the namespace its workloads call
+func recommendSidecarScopes(dependencies []dependency.Dependency) []*policyv1alpha1.SidecarScope {
+	services := make(map[string]map[policyv1alpha1.SidecarScopeServiceSpec]struct{})
+	for _, dep := range dependencies {
+		namespace := dep.Source.Namespace
+		if _, ok := services[namespace]; !ok {
+			services[namespace] = make(map[policyv1alpha1.SidecarScopeServiceSpec]struct{})
+		}
+		// Services in the namespace of the SidecarScope are always reachable
+		if dep.Destination.Namespace == namespace {
+			continue
+		}
+		services[namespace][policyv1alpha1.SidecarScopeServiceSpec{Name: dep.Destination.Name, Namespace: dep.Destination.Namespace}] = struct{}{}
+	}
+
+	namespaces := sortedKeys(services)
+	scopes := make([]*policyv1alpha1.SidecarScope, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		specs := make([]policyv1alpha1.SidecarScopeServiceSpec, 0, len(services[namespace]))
+		for spec := range services[namespace] {
+			specs = append(specs, spec)
+		}
+		sort.Slice(specs, func(i, j int) bool {
+			if specs[i].Namespace != specs[j].Namespace {
+				return specs[i].Namespace < specs[j].Namespace
+			}
+			return specs[i].Name < specs[j].Name
+		})
+
+		scopes = append(scopes, &policyv1alpha1.SidecarScope{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: policyv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "SidecarScope",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "observed-dependencies",
+				Namespace: namespace,
+			},
+			Spec: policyv1alpha1.SidecarScopeSpec{
+				Services: specs,
+			},
+		})
+	}
+
+	return scopes
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
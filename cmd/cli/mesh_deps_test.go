@@ -0,0 +1,63 @@
+package main
+
+import (
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/dependency"
+)
+
+var testDependencies = []dependency.Dependency{
+	{
+		Source:      dependency.Workload{Namespace: "bookbuyer", ServiceAccount: "bookbuyer"},
+		Destination: dependency.Service{Namespace: "bookstore", Name: "bookstore", TargetPort: 14001},
+		Requests:    12,
+		Bytes:       2048,
+	},
+	{
+		Source:      dependency.Workload{Namespace: "bookbuyer", ServiceAccount: "bookbuyer"},
+		Destination: dependency.Service{Namespace: "bookbuyer", Name: "cache", TargetPort: 6379},
+		Requests:    3,
+		Bytes:       64,
+	},
+	{
+		Source:      dependency.Workload{Namespace: "bookstore", ServiceAccount: "bookstore"},
+		Destination: dependency.Service{Namespace: "bookwarehouse", Name: "bookwarehouse", TargetPort: 14001},
+		Requests:    5,
+		Bytes:       512,
+	},
+}
+
+func TestDependencyGraphDOT(t *testing.T) {
+	assert := tassert.New(t)
+
+	expected := `digraph dependencies {
+  rankdir=LR;
+  "sa:bookbuyer/bookbuyer" [label="bookbuyer/bookbuyer", shape=box];
+  "sa:bookstore/bookstore" [label="bookstore/bookstore", shape=box];
+  "svc:bookbuyer/cache" [label="bookbuyer/cache", shape=ellipse];
+  "svc:bookstore/bookstore" [label="bookstore/bookstore", shape=ellipse];
+  "svc:bookwarehouse/bookwarehouse" [label="bookwarehouse/bookwarehouse", shape=ellipse];
+  "sa:bookbuyer/bookbuyer" -> "svc:bookstore/bookstore" [label="14001: 12 req, 2048 bytes"];
+  "sa:bookbuyer/bookbuyer" -> "svc:bookbuyer/cache" [label="6379: 3 req, 64 bytes"];
+  "sa:bookstore/bookstore" -> "svc:bookwarehouse/bookwarehouse" [label="14001: 5 req, 512 bytes"];
+}
+`
+	assert.Equal(expected, dependencyGraphDOT(testDependencies))
+}
+
+func TestRecommendSidecarScopes(t *testing.T) {
+	assert := tassert.New(t)
+
+	scopes := recommendSidecarScopes(testDependencies)
+	assert.Len(scopes, 2)
+
+	assert.Equal("bookbuyer", scopes[0].Namespace)
+	assert.Equal([]policyv1alpha1.SidecarScopeServiceSpec{{Name: "bookstore", Namespace: "bookstore"}}, scopes[0].Spec.Services)
+	assert.Nil(scopes[0].Spec.WorkloadSelector)
+
+	assert.Equal("bookstore", scopes[1].Namespace)
+	assert.Equal([]policyv1alpha1.SidecarScopeServiceSpec{{Name: "bookwarehouse", Namespace: "bookwarehouse"}}, scopes[1].Spec.Services)
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	policyClientset "github.com/flomesh-io/fsm/pkg/gen/client/policy/clientset/versioned"
+
+	"github.com/flomesh-io/fsm/pkg/identity"
+	"github.com/flomesh-io/fsm/pkg/policy"
+	"github.com/flomesh-io/fsm/pkg/service"
+	"github.com/flomesh-io/fsm/pkg/trafficpolicy"
+)
+
+const policyCheckRequestDesc = `
+This command evaluates a synthetic request against the effective
+AuthorizationPolicy rules of a backend and reports whether the request
+is allowed, along with the rule making the decision.
+`
+
+const policyCheckRequestExample = `
+# To check if the 'client' service account in the 'curl' namespace is allowed to
+# send a 'POST /api' request to port 8080 of the 'httpbin' backend in the 'test' namespace
+fsm policy check-request httpbin -n test --port 8080 --source curl/client --method POST --path /api
+
+# To check a request carrying a header and authenticated with a JWT claim
+fsm policy check-request httpbin -n test --port 8080 --header x-tenant=foo --claim groups=admin
+`
+
+type policyCheckRequestCmd struct {
+	stdout       io.Writer
+	policyClient policyClientset.Interface
+	namespace    string
+	backend      string
+	port         uint16
+	source       string
+	method       string
+	path         string
+	headers      []string
+	claims       []string
+	trustDomain  string
+}
+
+func newPolicyCheckRequest(stdout io.Writer) *cobra.Command {
+	policyCheckRequestCmd := &policyCheckRequestCmd{
+		stdout: stdout,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "check-request BACKEND",
+		Short: "check if a request to a backend is allowed by AuthorizationPolicy resources",
+		Long:  policyCheckRequestDesc,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			policyCheckRequestCmd.backend = args[0]
+
+			config, err := settings.RESTClientGetter().ToRESTConfig()
+			if err != nil {
+				return fmt.Errorf("Error fetching kubeconfig: %w", err)
+			}
+
+			policyClient, err := policyClientset.NewForConfig(config)
+			if err != nil {
+				return fmt.Errorf("Error initializing %s client: %w", policyv1alpha1.SchemeGroupVersion, err)
+			}
+			policyCheckRequestCmd.policyClient = policyClient
+
+			return policyCheckRequestCmd.run()
+		},
+		Example: policyCheckRequestExample,
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(&policyCheckRequestCmd.namespace, "namespace", "n", "default", "Namespace of the backend")
+	f.Uint16Var(&policyCheckRequestCmd.port, "port", 0, "Target port of the backend")
+	f.StringVar(&policyCheckRequestCmd.source, "source", "", "Service account of the source in the <namespace>/<name> format, empty for a source outside the mesh")
+	f.StringVar(&policyCheckRequestCmd.method, "method", "GET", "HTTP method of the request")
+	f.StringVar(&policyCheckRequestCmd.path, "path", "/", "HTTP path of the request")
+	f.StringArrayVar(&policyCheckRequestCmd.headers, "header", nil, "Header of the request in the <name>=<value> format, can be specified multiple times")
+	f.StringArrayVar(&policyCheckRequestCmd.claims, "claim", nil, "JWT claim of the request in the <name>=<value> format, can be specified multiple times")
+	f.StringVar(&policyCheckRequestCmd.trustDomain, "trust-domain", "cluster.local", "Trust domain of the mesh")
+
+	return cmd
+}
+
+func (cmd *policyCheckRequestCmd) run() error {
+	if cmd.port == 0 {
+		return fmt.Errorf("Requires the target port of the backend specified by '--port'")
+	}
+
+	req, err := cmd.request()
+	if err != nil {
+		return err
+	}
+
+	authzPolicies, err := cmd.policyClient.PolicyV1alpha1().AuthorizationPolicies(cmd.namespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("Error listing AuthorizationPolicy resources in namespace %s: %w", cmd.namespace, err)
+	}
+
+	svc := service.MeshService{
+		Name:       cmd.backend,
+		Namespace:  cmd.namespace,
+		TargetPort: cmd.port,
+	}
+
+	var applied []*policyv1alpha1.AuthorizationPolicy
+	for i := range authzPolicies.Items {
+		authz := &authzPolicies.Items[i]
+		if policy.AuthorizationPolicyAppliesTo(authz, svc) {
+			applied = append(applied, authz)
+		}
+	}
+
+	if len(applied) == 0 {
+		fmt.Fprintf(cmd.stdout, "[+] No AuthorizationPolicy applies to backend %s/%s on port %d, request is ALLOWED\n", cmd.namespace, cmd.backend, cmd.port)
+		return nil
+	}
+
+	fmt.Fprintf(cmd.stdout, "[+] AuthorizationPolicy resources applied to backend %s/%s on port %d:\n", cmd.namespace, cmd.backend, cmd.port)
+	for _, authz := range applied {
+		fmt.Fprintf(cmd.stdout, "%s/%s\n", authz.Namespace, authz.Name)
+	}
+	fmt.Fprintf(cmd.stdout, "\n")
+
+	allowed, rule := policy.CompileAuthorizationPolicies(applied, cmd.trustDomain).Evaluate(req)
+
+	decision := "DENIED"
+	if allowed {
+		decision = "ALLOWED"
+	}
+
+	if rule != nil {
+		fmt.Fprintf(cmd.stdout, "[+] Request is %s by rule %d of AuthorizationPolicy %s with action %s\n", decision, rule.Index, rule.Policy, rule.Action)
+	} else {
+		fmt.Fprintf(cmd.stdout, "[+] Request is %s by default, no rule matches the request\n", decision)
+	}
+
+	return nil
+}
+
+// request returns the synthetic request specified by the flags
+func (cmd *policyCheckRequestCmd) request() (*trafficpolicy.AuthorizationRequest, error) {
+	req := &trafficpolicy.AuthorizationRequest{
+		Method: strings.ToUpper(cmd.method),
+		Path:   cmd.path,
+	}
+
+	if len(cmd.source) > 0 {
+		ns, name, found := strings.Cut(cmd.source, "/")
+		if !found || len(ns) == 0 || len(name) == 0 {
+			return nil, fmt.Errorf("Invalid source %s, expected the <namespace>/<name> format", cmd.source)
+		}
+		req.Principal = identity.New(name, ns).String()
+	}
+
+	if len(cmd.headers) > 0 {
+		req.Headers = make(map[string]string)
+		for _, header := range cmd.headers {
+			name, value, found := strings.Cut(header, "=")
+			if !found || len(name) == 0 {
+				return nil, fmt.Errorf("Invalid header %s, expected the <name>=<value> format", header)
+			}
+			req.Headers[strings.ToLower(name)] = value
+		}
+	}
+
+	if len(cmd.claims) > 0 {
+		req.Claims = make(map[string]interface{})
+		for _, claim := range cmd.claims {
+			name, value, found := strings.Cut(claim, "=")
+			if !found || len(name) == 0 {
+				return nil, fmt.Errorf("Invalid claim %s, expected the <name>=<value> format", claim)
+			}
+			setClaim(req.Claims, strings.Split(name, "."), value)
+		}
+	}
+
+	return req, nil
+}
+
+// setClaim sets the value of a possibly nested claim, repeated claims are turned into a list
+func setClaim(claims map[string]interface{}, keys []string, value string) {
+	if len(keys) > 1 {
+		nested, ok := claims[keys[0]].(map[string]interface{})
+		if !ok {
+			nested = make(map[string]interface{})
+			claims[keys[0]] = nested
+		}
+		setClaim(nested, keys[1:], value)
+		return
+	}
+
+	switch existing := claims[keys[0]].(type) {
+	case nil:
+		claims[keys[0]] = value
+	case []interface{}:
+		claims[keys[0]] = append(existing, value)
+	default:
+		claims[keys[0]] = []interface{}{existing, value}
+	}
+}
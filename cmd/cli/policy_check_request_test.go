@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	fakePolicyClientset "github.com/flomesh-io/fsm/pkg/gen/client/policy/clientset/versioned/fake"
+)
+
+func TestPolicyCheckRequestRun(t *testing.T) {
+	testNs := "test"
+
+	allowClient := &policyv1alpha1.AuthorizationPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "allow-client",
+			Namespace: testNs,
+		},
+		Spec: policyv1alpha1.AuthorizationPolicySpec{
+			Backends: []policyv1alpha1.AuthorizationBackendSpec{
+				{
+					Name: "httpbin",
+					Port: policyv1alpha1.PortSpec{Number: 8080},
+				},
+			},
+			Action: policyv1alpha1.AuthorizationActionAllow,
+			Rules: []policyv1alpha1.AuthorizationRule{
+				{
+					Sources: []policyv1alpha1.AuthorizationSource{
+						{
+							Kind:      policyv1alpha1.KindServiceAccount,
+							Name:      "client",
+							Namespace: "curl",
+						},
+					},
+					Methods: []string{"GET"},
+				},
+				{
+					Claims: []policyv1alpha1.AuthorizationClaimMatch{
+						{
+							Name:   "realm.groups",
+							Values: []string{"admin"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	denyAdmin := &policyv1alpha1.AuthorizationPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "deny-admin",
+			Namespace: testNs,
+		},
+		Spec: policyv1alpha1.AuthorizationPolicySpec{
+			Backends: []policyv1alpha1.AuthorizationBackendSpec{
+				{
+					Name: "httpbin",
+					Port: policyv1alpha1.PortSpec{Number: 8080},
+				},
+			},
+			Action: policyv1alpha1.AuthorizationActionDeny,
+			Rules: []policyv1alpha1.AuthorizationRule{
+				{
+					Paths: []string{"/admin"},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                  string
+		cmd                   policyCheckRequestCmd
+		existingResources     []runtime.Object
+		expectErr             bool
+		expectedRegexMatchOut string
+	}{
+		{
+			name:                  "No AuthorizationPolicy applies",
+			cmd:                   policyCheckRequestCmd{backend: "httpbin", port: 9090, method: "GET", path: "/"},
+			existingResources:     []runtime.Object{allowClient},
+			expectedRegexMatchOut: "No AuthorizationPolicy applies to backend test/httpbin on port 9090, request is ALLOWED",
+		},
+		{
+			name:                  "Request allowed by a rule",
+			cmd:                   policyCheckRequestCmd{backend: "httpbin", port: 8080, source: "curl/client", method: "get", path: "/"},
+			existingResources:     []runtime.Object{allowClient},
+			expectedRegexMatchOut: "Request is ALLOWED by rule 0 of AuthorizationPolicy test/allow-client with action ALLOW",
+		},
+		{
+			name:                  "Request allowed by a nested claim",
+			cmd:                   policyCheckRequestCmd{backend: "httpbin", port: 8080, method: "POST", path: "/", claims: []string{"realm.groups=dev", "realm.groups=admin"}},
+			existingResources:     []runtime.Object{allowClient},
+			expectedRegexMatchOut: "Request is ALLOWED by rule 1 of AuthorizationPolicy test/allow-client with action ALLOW",
+		},
+		{
+			name:                  "Request denied by default",
+			cmd:                   policyCheckRequestCmd{backend: "httpbin", port: 8080, source: "curl/client", method: "POST", path: "/"},
+			existingResources:     []runtime.Object{allowClient},
+			expectedRegexMatchOut: "Request is DENIED by default, no rule matches the request",
+		},
+		{
+			name:                  "Request denied by a rule on a path to be normalized",
+			cmd:                   policyCheckRequestCmd{backend: "httpbin", port: 8080, source: "curl/client", method: "GET", path: "//x/.././%61dmin/users"},
+			existingResources:     []runtime.Object{allowClient, denyAdmin},
+			expectedRegexMatchOut: "Request is DENIED by rule 0 of AuthorizationPolicy test/deny-admin with action DENY",
+		},
+		{
+			name:      "Invalid source",
+			cmd:       policyCheckRequestCmd{backend: "httpbin", port: 8080, source: "client", method: "GET", path: "/"},
+			expectErr: true,
+		},
+		{
+			name:      "Missing port",
+			cmd:       policyCheckRequestCmd{backend: "httpbin", method: "GET", path: "/"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assert.New(t)
+			stdout := new(bytes.Buffer)
+
+			cmd := tc.cmd
+			cmd.stdout = stdout
+			cmd.namespace = testNs
+			cmd.trustDomain = "cluster.local"
+			cmd.policyClient = fakePolicyClientset.NewSimpleClientset(tc.existingResources...)
+
+			err := cmd.run()
+			a.Equal(tc.expectErr, err != nil, err)
+			if err != nil {
+				return
+			}
+
+			a.Regexp(tc.expectedRegexMatchOut, stdout.String())
+		})
+	}
+}
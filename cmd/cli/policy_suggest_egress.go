@@ -0,0 +1,229 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/constants"
+	"github.com/flomesh-io/fsm/pkg/egressaudit"
+)
+
+const policySuggestEgressDescription = `
+This command prints the Egress policies that cover the outbound traffic
+observed by sidecars in the given namespace while egress audit mode is
+enabled. Destinations already allowed by an Egress policy are not included.
+
+Egress audit mode is enabled with 'spec.observability.egressLogging.auditMode'
+in the MeshConfig. The suggested policies can be reviewed and applied with kubectl.
+`
+
+const policySuggestEgressExample = `
+# Print the Egress policies covering the audited outbound traffic of namespace 'bookbuyer'
+fsm policy suggest-egress bookbuyer
+
+# Apply the suggested Egress policies
+fsm policy suggest-egress bookbuyer | kubectl apply -f -
+`
+
+type policySuggestEgressCmd struct {
+	out       io.Writer
+	namespace string
+	clientSet kubernetes.Interface
+}
+
+func newPolicySuggestEgress(out io.Writer) *cobra.Command {
+	suggestCmd := &policySuggestEgressCmd{
+		out: out,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "suggest-egress NAMESPACE",
+		Short: "suggest Egress policies covering the audited outbound traffic of a namespace",
+		Long:  policySuggestEgressDescription,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			suggestCmd.namespace = args[0]
+
+			config, err := settings.RESTClientGetter().ToRESTConfig()
+			if err != nil {
+				return fmt.Errorf("Error fetching kubeconfig: %w", err)
+			}
+
+			clientset, err := kubernetes.NewForConfig(config)
+			if err != nil {
+				return fmt.Errorf("Could not access Kubernetes cluster, check kubeconfig: %w", err)
+			}
+			suggestCmd.clientSet = clientset
+
+			return suggestCmd.run()
+		},
+		Example: policySuggestEgressExample,
+	}
+
+	return cmd
+}
+
+func (cmd *policySuggestEgressCmd) run() error {
+	records, err := cmd.getAuditRecords()
+	if err != nil {
+		return err
+	}
+
+	egresses := suggestEgressPolicies(cmd.namespace, records)
+	if len(egresses) == 0 {
+		return fmt.Errorf("No audited outbound traffic found in namespace [%s]", cmd.namespace)
+	}
+
+	for i, egress := range egresses {
+		out, err := yaml.Marshal(egress)
+		if err != nil {
+			return fmt.Errorf("Error marshalling Egress %s/%s: %w", egress.Namespace, egress.Name, err)
+		}
+		if i > 0 {
+			fmt.Fprintln(cmd.out, "---")
+		}
+		fmt.Fprint(cmd.out, string(out))
+	}
+
+	return nil
+}
+
+func (cmd *policySuggestEgressCmd) getAuditRecords() ([]egressaudit.Record, error) {
+	fsmNamespace := settings.FsmNamespace()
+
+	controllerPods, err := getControllerPods(cmd.clientSet, fsmNamespace)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing fsm-controller pods in namespace [%s]: %w", fsmNamespace, err)
+	}
+	if len(controllerPods.Items) == 0 {
+		return nil, fmt.Errorf("No fsm-controller pods found in namespace [%s]", fsmNamespace)
+	}
+
+	var errs []error
+	for _, pod := range controllerPods.Items {
+		params := map[string]string{"namespace": cmd.namespace}
+		resp, err := cmd.clientSet.CoreV1().Pods(fsmNamespace).ProxyGet("", pod.Name, strconv.Itoa(constants.FSMHTTPServerPort), constants.FSMControllerEgressAuditPath, params).DoRaw(context.TODO())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("Error retrieving audited egress traffic from pod [%s] in namespace [%s]: %w", pod.Name, fsmNamespace, err))
+			continue
+		}
+
+		var records []egressaudit.Record
+		if err := json.Unmarshal(resp, &records); err != nil {
+			errs = append(errs, fmt.Errorf("Error unmarshalling audited egress traffic from pod [%s] in namespace [%s]: %w", pod.Name, fsmNamespace, err))
+			continue
+		}
+		return records, nil
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+// suggestEgressPolicies builds an Egress policy per service account and destination port
+// covering the audited destinations, HTTP and HTTPS destinations are matched by host
+// while other destinations are matched by IP address
+func suggestEgressPolicies(namespace string, records []egressaudit.Record) []*policyv1alpha1.Egress {
+	type egressKey struct {
+		serviceAccount string
+		protocol       string
+		port           int
+	}
+
+	hosts := make(map[egressKey]map[string]struct{})
+	for _, record := range records {
+		if record.Namespace != namespace {
+			continue
+		}
+
+		dest := record.Destination
+		protocol := dest.Protocol
+		host := dest.Host
+		if ip := net.ParseIP(host); ip != nil {
+			protocol = constants.ProtocolTCP
+			if ip.To4() != nil {
+				host += "/32"
+			} else {
+				host += "/128"
+			}
+		} else if protocol != constants.ProtocolHTTP && protocol != constants.ProtocolHTTPS {
+			// Non HTTP(s) traffic can only be matched by IP address
+			continue
+		}
+
+		key := egressKey{serviceAccount: record.ServiceAccount, protocol: protocol, port: dest.Port}
+		if _, ok := hosts[key]; !ok {
+			hosts[key] = make(map[string]struct{})
+		}
+		hosts[key][host] = struct{}{}
+	}
+
+	keys := make([]egressKey, 0, len(hosts))
+	for key := range hosts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].serviceAccount != keys[j].serviceAccount {
+			return keys[i].serviceAccount < keys[j].serviceAccount
+		}
+		if keys[i].port != keys[j].port {
+			return keys[i].port < keys[j].port
+		}
+		return keys[i].protocol < keys[j].protocol
+	})
+
+	egresses := make([]*policyv1alpha1.Egress, 0, len(keys))
+	for _, key := range keys {
+		values := make([]string, 0, len(hosts[key]))
+		for host := range hosts[key] {
+			values = append(values, host)
+		}
+		sort.Strings(values)
+
+		egress := &policyv1alpha1.Egress{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: policyv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "Egress",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%s-%d", key.serviceAccount, key.protocol, key.port),
+				Namespace: namespace,
+			},
+			Spec: policyv1alpha1.EgressSpec{
+				Sources: []policyv1alpha1.EgressSourceSpec{
+					{
+						Kind:      "ServiceAccount",
+						Name:      key.serviceAccount,
+						Namespace: namespace,
+					},
+				},
+				Ports: []policyv1alpha1.PortSpec{
+					{
+						Number:   key.port,
+						Protocol: key.protocol,
+					},
+				},
+			},
+		}
+		if key.protocol == constants.ProtocolTCP {
+			egress.Spec.IPAddresses = values
+		} else {
+			egress.Spec.Hosts = values
+		}
+
+		egresses = append(egresses, egress)
+	}
+
+	return egresses
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/egressaudit"
+)
+
+func TestSuggestEgressPolicies(t *testing.T) {
+	records := []egressaudit.Record{
+		{
+			Namespace:      "test",
+			ServiceAccount: "client",
+			Destination:    egressaudit.Destination{Host: "httpbin.org", Port: 443, Protocol: "https"},
+			Connections:    3,
+		},
+		{
+			Namespace:      "test",
+			ServiceAccount: "client",
+			Destination:    egressaudit.Destination{Host: "api.github.com", Port: 443, Protocol: "https"},
+			Connections:    1,
+		},
+		{
+			Namespace:      "test",
+			ServiceAccount: "client",
+			Destination:    egressaudit.Destination{Host: "httpbin.org", Port: 80, Protocol: "http"},
+			Connections:    2,
+		},
+		{
+			Namespace:      "test",
+			ServiceAccount: "db-client",
+			Destination:    egressaudit.Destination{Host: "10.0.0.10", Port: 5432, Protocol: "tcp"},
+			Connections:    4,
+		},
+		{
+			Namespace:      "test",
+			ServiceAccount: "db-client",
+			Destination:    egressaudit.Destination{Host: "db.example.com", Port: 5432, Protocol: "tcp"},
+			Connections:    1,
+		},
+		{
+			Namespace:      "other",
+			ServiceAccount: "client",
+			Destination:    egressaudit.Destination{Host: "example.com", Port: 443, Protocol: "https"},
+			Connections:    1,
+		},
+	}
+
+	egresses := suggestEgressPolicies("test", records)
+	assert.Len(t, egresses, 3)
+
+	assert.Equal(t, "client-http-80", egresses[0].Name)
+	assert.Equal(t, []string{"httpbin.org"}, egresses[0].Spec.Hosts)
+	assert.Equal(t, []policyv1alpha1.PortSpec{{Number: 80, Protocol: "http"}}, egresses[0].Spec.Ports)
+
+	assert.Equal(t, "client-https-443", egresses[1].Name)
+	assert.Equal(t, "test", egresses[1].Namespace)
+	assert.Equal(t, []string{"api.github.com", "httpbin.org"}, egresses[1].Spec.Hosts)
+	assert.Equal(t, []policyv1alpha1.EgressSourceSpec{{Kind: "ServiceAccount", Name: "client", Namespace: "test"}}, egresses[1].Spec.Sources)
+
+	assert.Equal(t, "db-client-tcp-5432", egresses[2].Name)
+	assert.Empty(t, egresses[2].Spec.Hosts)
+	assert.Equal(t, []string{"10.0.0.10/32"}, egresses[2].Spec.IPAddresses)
+
+	assert.Empty(t, suggestEgressPolicies("none", records))
+}
@@ -22,6 +22,7 @@ func newProxyCmd(config *action.Configuration, factory common.Factory, out io.Wr
 		Args:  cobra.NoArgs,
 	}
 	cmd.AddCommand(newProxyGetCmd(config, factory, out))
+	cmd.AddCommand(newProxySyncStatusCmd(out))
 
 	return cmd
 }
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/flomesh-io/fsm/pkg/constants"
+	"github.com/flomesh-io/fsm/pkg/propagation"
+)
+
+const proxySyncStatusDescription = `
+This command prints the propagation of the latest changes of the TrafficSplit
+and AccessControl policies to the sidecar proxies, as tracked by the fsm controller.
+
+GENERATED is the number of proxies whose config was generated since the change was
+observed, PROGRAMMED is the number of proxies that loaded such a config from the repo.
+A policy change is live once it is programmed on every proxy.
+`
+
+const proxySyncStatusExample = `
+# Print the propagation of the policies in namespace 'bookstore'
+fsm proxy sync-status --namespace bookstore
+
+# Also print the proxies that have not loaded the latest changes
+fsm proxy sync-status --show-pending
+`
+
+type proxySyncStatusCmd struct {
+	out         io.Writer
+	namespace   string
+	showPending bool
+	clientSet   kubernetes.Interface
+}
+
+func newProxySyncStatusCmd(out io.Writer) *cobra.Command {
+	syncStatusCmd := &proxySyncStatusCmd{
+		out: out,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "sync-status",
+		Short: "print the propagation of policy changes to the proxies",
+		Long:  proxySyncStatusDescription,
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			config, err := settings.RESTClientGetter().ToRESTConfig()
+			if err != nil {
+				return fmt.Errorf("Error fetching kubeconfig: %w", err)
+			}
+
+			clientset, err := kubernetes.NewForConfig(config)
+			if err != nil {
+				return fmt.Errorf("Could not access Kubernetes cluster, check kubeconfig: %w", err)
+			}
+			syncStatusCmd.clientSet = clientset
+
+			return syncStatusCmd.run()
+		},
+		Example: proxySyncStatusExample,
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&syncStatusCmd.namespace, "namespace", "", "Namespace of the policies, all the namespaces if not specified")
+	f.BoolVar(&syncStatusCmd.showPending, "show-pending", false, "Print the proxies that have not loaded the latest changes of the policies")
+
+	return cmd
+}
+
+func (cmd *proxySyncStatusCmd) run() error {
+	policies, err := cmd.getSyncStatus()
+	if err != nil {
+		return err
+	}
+
+	if len(policies) == 0 {
+		fmt.Fprintln(cmd.out, "No policy changes tracked")
+		return nil
+	}
+
+	w := newTabWriter(cmd.out)
+	fmt.Fprint(w, getPrettyPrintedSyncStatus(policies))
+	_ = w.Flush()
+
+	if cmd.showPending {
+		fmt.Fprint(cmd.out, getPrettyPrintedPendingProxies(policies))
+	}
+	return nil
+}
+
+func (cmd *proxySyncStatusCmd) getSyncStatus() ([]propagation.PolicyStatus, error) {
+	fsmNamespace := settings.FsmNamespace()
+
+	controllerPods, err := getControllerPods(cmd.clientSet, fsmNamespace)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing fsm-controller pods in namespace [%s]: %w", fsmNamespace, err)
+	}
+	if len(controllerPods.Items) == 0 {
+		return nil, fmt.Errorf("No fsm-controller pods found in namespace [%s]", fsmNamespace)
+	}
+
+	var errs []error
+	for _, pod := range controllerPods.Items {
+		params := map[string]string{"namespace": cmd.namespace}
+		resp, err := cmd.clientSet.CoreV1().Pods(fsmNamespace).ProxyGet("", pod.Name, strconv.Itoa(constants.FSMHTTPServerPort), constants.FSMControllerConfigPropagationPath, params).DoRaw(context.TODO())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("Error retrieving sync status from pod [%s] in namespace [%s]: %w", pod.Name, fsmNamespace, err))
+			continue
+		}
+
+		var policies []propagation.PolicyStatus
+		if err := json.Unmarshal(resp, &policies); err != nil {
+			errs = append(errs, fmt.Errorf("Error unmarshalling sync status from pod [%s] in namespace [%s]: %w", pod.Name, fsmNamespace, err))
+			continue
+		}
+		return policies, nil
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+func getPrettyPrintedSyncStatus(policies []propagation.PolicyStatus) string {
+	s := "\nKIND\tNAMESPACE\tNAME\tGENERATION\tGENERATED\tPROGRAMMED\t\n"
+	for _, policy := range policies {
+		s += fmt.Sprintf("%s\t%s\t%s\t%d\t%d/%d\t%d/%d\t\n",
+			policy.Kind, policy.Namespace, policy.Name, policy.Generation,
+			policy.Generated, policy.Proxies, policy.Programmed, policy.Proxies)
+	}
+	return s
+}
+
+func getPrettyPrintedPendingProxies(policies []propagation.PolicyStatus) string {
+	var sb strings.Builder
+	for _, policy := range policies {
+		if len(policy.Pending) == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "\nProxies pending %s %s/%s generation %d:\n", policy.Kind, policy.Namespace, policy.Name, policy.Generation)
+		for _, proxy := range policy.Pending {
+			fmt.Fprintf(&sb, "  %s\n", proxy)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/flomesh-io/fsm/pkg/propagation"
+)
+
+var testSyncStatus = []propagation.PolicyStatus{
+	{
+		Policy:     propagation.Policy{Kind: propagation.KindAccessControl, Namespace: "bookstore", Name: "bookstore-acl"},
+		Generation: 3,
+		Proxies:    3,
+		Generated:  3,
+		Programmed: 2,
+		Pending:    []string{"bookstore/bookstore-v2-5d8c7b9c4-x2x7q"},
+	},
+	{
+		Policy:     propagation.Policy{Kind: propagation.KindTrafficSplit, Namespace: "bookstore", Name: "bookstore-split"},
+		Generation: 1,
+		Proxies:    3,
+		Generated:  3,
+		Programmed: 3,
+	},
+}
+
+func TestGetPrettyPrintedSyncStatus(t *testing.T) {
+	assert := tassert.New(t)
+
+	expected := "\nKIND\tNAMESPACE\tNAME\tGENERATION\tGENERATED\tPROGRAMMED\t\n" +
+		"AccessControl\tbookstore\tbookstore-acl\t3\t3/3\t2/3\t\n" +
+		"TrafficSplit\tbookstore\tbookstore-split\t1\t3/3\t3/3\t\n"
+	assert.Equal(expected, getPrettyPrintedSyncStatus(testSyncStatus))
+}
+
+func TestGetPrettyPrintedPendingProxies(t *testing.T) {
+	assert := tassert.New(t)
+
+	expected := "\nProxies pending AccessControl bookstore/bookstore-acl generation 3:\n" +
+		"  bookstore/bookstore-v2-5d8c7b9c4-x2x7q\n"
+	assert.Equal(expected, getPrettyPrintedPendingProxies(testSyncStatus))
+}
@@ -48,6 +48,7 @@ import (
 	machinescheme "github.com/flomesh-io/fsm/pkg/gen/client/machine/clientset/versioned/scheme"
 	mcscheme "github.com/flomesh-io/fsm/pkg/gen/client/multicluster/clientset/versioned/scheme"
 	nsigscheme "github.com/flomesh-io/fsm/pkg/gen/client/namespacedingress/clientset/versioned/scheme"
+	policyscheme "github.com/flomesh-io/fsm/pkg/gen/client/policy/clientset/versioned/scheme"
 	pascheme "github.com/flomesh-io/fsm/pkg/gen/client/policyattachment/clientset/versioned/scheme"
 
 	"github.com/spf13/pflag"
@@ -73,6 +74,8 @@ import (
 	"github.com/flomesh-io/fsm/pkg/certificate/providers"
 	"github.com/flomesh-io/fsm/pkg/configurator"
 	"github.com/flomesh-io/fsm/pkg/constants"
+	"github.com/flomesh-io/fsm/pkg/dependency"
+	"github.com/flomesh-io/fsm/pkg/egressaudit"
 	"github.com/flomesh-io/fsm/pkg/endpoint"
 	"github.com/flomesh-io/fsm/pkg/errcode"
 	"github.com/flomesh-io/fsm/pkg/health"
@@ -87,6 +90,7 @@ import (
 	"github.com/flomesh-io/fsm/pkg/multicluster"
 	"github.com/flomesh-io/fsm/pkg/plugin"
 	"github.com/flomesh-io/fsm/pkg/policy"
+	"github.com/flomesh-io/fsm/pkg/propagation"
 	"github.com/flomesh-io/fsm/pkg/providers/fsm"
 	"github.com/flomesh-io/fsm/pkg/providers/kube"
 	"github.com/flomesh-io/fsm/pkg/reconciler"
@@ -173,6 +177,7 @@ func init() {
 	_ = mcscheme.AddToScheme(scheme)
 	_ = nsigscheme.AddToScheme(scheme)
 	_ = pascheme.AddToScheme(scheme)
+	_ = policyscheme.AddToScheme(scheme)
 	_ = machinescheme.AddToScheme(scheme)
 	_ = connectorscheme.AddToScheme(scheme)
 	_ = extscheme.AddToScheme(scheme)
@@ -283,6 +288,9 @@ func main() {
 
 	// This component will be watching resources in the config.flomesh.io API group
 	cfg := configurator.NewConfigurator(informerCollection, fsmNamespace, fsmMeshConfigName, msgBroker)
+	msgBroker.SetProxyUpdateWindowFunc(func() (time.Duration, time.Duration) {
+		return cfg.GetConfigUpdateDebounceWindow(), cfg.GetConfigUpdateMaxDelay()
+	})
 	k8sClient := k8s.NewKubernetesController(informerCollection, policyClient, pluginClient, msgBroker)
 	meshSpec := smi.NewSMIClient(informerCollection, fsmNamespace, k8sClient, msgBroker)
 
@@ -346,6 +354,7 @@ func main() {
 	background.MeshCatalog = meshCatalog
 	background.CertManager = certManager
 	background.MsgBroker = msgBroker
+	background.PropagationTracker = propagation.NewTracker(k8sClient, smiTrafficSplitClientSet, msgBroker)
 
 	// Health/Liveness probes
 	var funcProbes []health.Probes
@@ -400,6 +409,17 @@ func main() {
 	httpServer.AddHandler(constants.VersionPath, version.GetVersionHandler())
 	// Supported SMI Versions
 	httpServer.AddHandler(constants.FSMControllerSMIVersionPath, smi.GetSmiClientVersionHTTPHandler())
+	// Egress destinations audited by sidecars
+	egressAuditCollector := egressaudit.NewCollector(k8sClient, cfg, policyController)
+	httpServer.AddHandler(constants.FSMControllerEgressAuditPath, egressAuditCollector.Handler())
+	go egressAuditCollector.Run(stop)
+	// Services called by workloads
+	dependencyCollector := dependency.NewCollector(k8sClient, cfg)
+	httpServer.AddHandler(constants.FSMControllerServiceDependenciesPath, dependencyCollector.Handler())
+	go dependencyCollector.Run(stop)
+	// Propagation of policy changes to proxies
+	httpServer.AddHandler(constants.FSMControllerConfigPropagationPath, background.PropagationTracker.Handler())
+	go background.PropagationTracker.Run(stop)
 
 	// Start HTTP server
 	err = httpServer.Start()
@@ -460,6 +480,10 @@ func main() {
 		go listeners.WatchAndUpdateLoggingConfig(kubeClient, msgBroker, background.RepoClient, stop)
 	}
 
+	if cfg.GetMeshConfig().Spec.EgressGateway.Enabled {
+		go listeners.WatchAndUpdateEgressGatewayRules(kubeClient, informerCollection, msgBroker, policyController, fsmNamespace, stop)
+	}
+
 	if err := mgr.Start(ctx); err != nil {
 		log.Fatal().Msgf("problem running manager, %s", err)
 		events.GenericEventRecorder().FatalEvent(err, events.InitializationError, "Error starting manager")
@@ -488,11 +512,18 @@ func startMetricsStore() {
 		metricsstore.DefaultMetricsStore.VersionInfo,
 		metricsstore.DefaultMetricsStore.ProxyXDSRequestCount,
 		metricsstore.DefaultMetricsStore.ProxyMaxConnectionsRejected,
+		metricsstore.DefaultMetricsStore.ProxyConfigUpdateQueueDepth,
+		metricsstore.DefaultMetricsStore.ProxyConfigUpdateCoalescedCount,
+		metricsstore.DefaultMetricsStore.ProxyConfigPropagationLatency,
 		metricsstore.DefaultMetricsStore.AdmissionWebhookResponseTotal,
 		metricsstore.DefaultMetricsStore.EventsQueued,
 		metricsstore.DefaultMetricsStore.ReconciliationTotal,
 		metricsstore.DefaultMetricsStore.IngressBroadcastEventCount,
 		metricsstore.DefaultMetricsStore.GatewayBroadcastEventCounter,
+		metricsstore.DefaultMetricsStore.DNSCacheHitCount,
+		metricsstore.DefaultMetricsStore.DNSCacheMissCount,
+		metricsstore.DefaultMetricsStore.DNSCachePrefetchCount,
+		metricsstore.DefaultMetricsStore.DNSCacheEntries,
 	)
 }
 
@@ -53,6 +53,11 @@ const (
 
 	// ---
 
+	// NodeZoneUpdated is the type of announcement emitted when we observe a change of the topology zone of a Kubernetes Node
+	NodeZoneUpdated Kind = "node-zone-updated"
+
+	// ---
+
 	// NamespaceAdded is the type of announcement emitted when we observe an addition of a Kubernetes Namespace
 	NamespaceAdded Kind = "namespace-added"
 
@@ -351,6 +356,51 @@ const (
 	// UpstreamTrafficSettingUpdated is the type of announcement emitted when we observe an update of upstreamtrafficsettings.policy.flomesh.io
 	UpstreamTrafficSettingUpdated Kind = "upstreamtrafficsetting-updated"
 
+	// RequestAuthenticationAdded is the type of announcement emitted when we observe an addition of requestauthentications.policy.flomesh.io
+	RequestAuthenticationAdded Kind = "requestauthentication-added"
+
+	// RequestAuthenticationDeleted is the type of announcement emitted when we observe a deletion of requestauthentications.policy.flomesh.io
+	RequestAuthenticationDeleted Kind = "requestauthentication-deleted"
+
+	// RequestAuthenticationUpdated is the type of announcement emitted when we observe an update of requestauthentications.policy.flomesh.io
+	RequestAuthenticationUpdated Kind = "requestauthentication-updated"
+
+	// JWKSUpdated is the type of announcement emitted when a cached JSON Web Key Set is changed after being refreshed
+	JWKSUpdated Kind = "jwks-updated"
+
+	// ---
+
+	// AuthorizationPolicyAdded is the type of announcement emitted when we observe an addition of authorizationpolicies.policy.flomesh.io
+	AuthorizationPolicyAdded Kind = "authorizationpolicy-added"
+
+	// AuthorizationPolicyDeleted is the type of announcement emitted when we observe a deletion of authorizationpolicies.policy.flomesh.io
+	AuthorizationPolicyDeleted Kind = "authorizationpolicy-deleted"
+
+	// AuthorizationPolicyUpdated is the type of announcement emitted when we observe an update of authorizationpolicies.policy.flomesh.io
+	AuthorizationPolicyUpdated Kind = "authorizationpolicy-updated"
+
+	// ---
+
+	// SidecarScopeAdded is the type of announcement emitted when we observe an addition of sidecarscopes.policy.flomesh.io
+	SidecarScopeAdded Kind = "sidecarscope-added"
+
+	// SidecarScopeDeleted is the type of announcement emitted when we observe a deletion of sidecarscopes.policy.flomesh.io
+	SidecarScopeDeleted Kind = "sidecarscope-deleted"
+
+	// SidecarScopeUpdated is the type of announcement emitted when we observe an update of sidecarscopes.policy.flomesh.io
+	SidecarScopeUpdated Kind = "sidecarscope-updated"
+
+	// ---
+
+	// MeshFaultInjectionAdded is the type of announcement emitted when we observe an addition of meshfaultinjections.policy.flomesh.io
+	MeshFaultInjectionAdded Kind = "meshfaultinjection-added"
+
+	// MeshFaultInjectionDeleted is the type of announcement emitted when we observe a deletion of meshfaultinjections.policy.flomesh.io
+	MeshFaultInjectionDeleted Kind = "meshfaultinjection-deleted"
+
+	// MeshFaultInjectionUpdated is the type of announcement emitted when we observe an update of meshfaultinjections.policy.flomesh.io
+	MeshFaultInjectionUpdated Kind = "meshfaultinjection-updated"
+
 	// ---
 
 	// PluginAdded is the type of announcement emitted when we observe an addition of plugins.plugin.flomesh.io
@@ -753,6 +803,15 @@ const (
 
 	// GatewayDNSModifierUpdated is the type of announcement emitted when we observe an update to dnsmodifiers.extension.gateway.flomesh.io
 	GatewayDNSModifierUpdated Kind = "gatewaydnsmodifier-updated"
+
+	// GatewayJWTAuthAdded is the type of announcement emitted when we observe an addition of jwtauths.extension.gateway.flomesh.io
+	GatewayJWTAuthAdded Kind = "gatewayjwtauth-added"
+
+	// GatewayJWTAuthDeleted the type of announcement emitted when we observe a deletion of jwtauths.extension.gateway.flomesh.io
+	GatewayJWTAuthDeleted Kind = "gatewayjwtauth-deleted"
+
+	// GatewayJWTAuthUpdated is the type of announcement emitted when we observe an update to jwtauths.extension.gateway.flomesh.io
+	GatewayJWTAuthUpdated Kind = "gatewayjwtauth-updated"
 )
 
 // Announcement is a struct for messages between various components of FSM signaling a need for a change in Sidecar proxy configuration
@@ -45,6 +45,9 @@ type MeshConfigSpec struct {
 	// Warmup defines the traffic warm up policy
 	Warmup TrafficWarmupSpec `json:"warmup,omitempty"`
 
+	// LocalityLoadBalancing defines the zone aware load balancing within the cluster
+	LocalityLoadBalancing LocalityLoadBalancingSpec `json:"localityLoadBalancing,omitempty"`
+
 	// Observalility defines the observability configurations for a mesh instance.
 	Observability ObservabilitySpec `json:"observability,omitempty"`
 
@@ -136,6 +139,11 @@ type LocalDNSProxy struct {
 	// +optional
 	SecondaryUpstreamDNSServerIPAddr string `json:"secondaryUpstreamDNSServerIPAddr,omitempty"`
 
+	// Upstreams defines the upstream DNS servers for local DNS Proxy, queried in order.
+	// It takes precedence over PrimaryUpstreamDNSServerIPAddr and SecondaryUpstreamDNSServerIPAddr.
+	// +optional
+	Upstreams []LocalDNSUpstream `json:"upstreams,omitempty"`
+
 	// +kubebuilder:default=false
 	// +optional
 	GenerateIPv6BasedOnIPv4 bool `json:"generateIPv6BasedOnIPv4,omitempty"`
@@ -145,6 +153,138 @@ type LocalDNSProxy struct {
 
 	// DB defines Resolve DB.
 	DB []ResolveDN `json:"db,omitempty"`
+
+	// Cache defines the response cache of local DNS Proxy.
+	// +optional
+	Cache LocalDNSCache `json:"cache,omitempty"`
+
+	// Zones defines the conditional forwarding rules of local DNS Proxy,
+	// queries for names in a zone are sent to the upstreams of the zone.
+	// +optional
+	Zones []LocalDNSZone `json:"zones,omitempty"`
+}
+
+// LocalDNSZone is the type to represent a conditional forwarding zone of FSM's local DNS proxy.
+type LocalDNSZone struct {
+	// Name defines the domain of the zone, e.g. corp.example.com.
+	// The zone matches the domain and all its subdomains, the most specific zone wins.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespaces defines the namespaces whose pods see this zone,
+	// the zone is visible to the pods of all namespaces if empty.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// Upstreams defines the upstream DNS servers of the zone, queried in order.
+	// +kubebuilder:validation:MinItems=1
+	Upstreams []LocalDNSUpstream `json:"upstreams"`
+}
+
+// LocalDNSUpstreamProtocol is the transport used to reach an upstream DNS server
+// +kubebuilder:validation:Enum=udp;tcp;tls;https
+type LocalDNSUpstreamProtocol string
+
+const (
+	// LocalDNSUpstreamProtocolUDP is plain DNS over UDP, falling back to TCP for truncated responses
+	LocalDNSUpstreamProtocolUDP LocalDNSUpstreamProtocol = "udp"
+
+	// LocalDNSUpstreamProtocolTCP is plain DNS over TCP
+	LocalDNSUpstreamProtocolTCP LocalDNSUpstreamProtocol = "tcp"
+
+	// LocalDNSUpstreamProtocolTLS is DNS over TLS (RFC 7858)
+	LocalDNSUpstreamProtocolTLS LocalDNSUpstreamProtocol = "tls"
+
+	// LocalDNSUpstreamProtocolHTTPS is DNS over HTTPS (RFC 8484)
+	LocalDNSUpstreamProtocolHTTPS LocalDNSUpstreamProtocol = "https"
+)
+
+// LocalDNSUpstream is the type to represent an upstream DNS server of FSM's local DNS proxy.
+type LocalDNSUpstream struct {
+	// Protocol defines the transport used to reach the upstream DNS server.
+	// +kubebuilder:default=udp
+	// +optional
+	Protocol LocalDNSUpstreamProtocol `json:"protocol,omitempty"`
+
+	// Address defines the address of the upstream DNS server.
+	// It is host:port for udp, tcp and tls, the port defaults to 53 for udp and tcp and 853 for tls.
+	// It is the query URL for https, e.g. https://dns.example.com/dns-query.
+	Address string `json:"address"`
+
+	// TLS defines the TLS settings for tls and https upstreams.
+	// +optional
+	TLS *LocalDNSUpstreamTLS `json:"tls,omitempty"`
+}
+
+// LocalDNSUpstreamTLS is the type to represent the TLS settings of an encrypted upstream DNS server.
+type LocalDNSUpstreamTLS struct {
+	// ServerName defines the name used to verify the certificate of the upstream DNS server,
+	// defaults to the host of Address.
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+
+	// CABundle defines the PEM encoded CA certificates used to verify the upstream DNS server,
+	// the system roots are used if empty.
+	// +optional
+	CABundle string `json:"caBundle,omitempty"`
+
+	// InsecureSkipVerify defines whether the certificate of the upstream DNS server is verified.
+	// +kubebuilder:default=false
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// LocalDNSCache is the type to represent the response cache of FSM's local DNS proxy.
+type LocalDNSCache struct {
+	// Enable defines a boolean indicating if responses from upstream DNS servers are cached.
+	Enable bool `json:"enable"`
+
+	// MaxEntries defines the maximum number of responses kept in the cache.
+	// +kubebuilder:default=10240
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxEntries int `json:"maxEntries,omitempty"`
+
+	// MinTTL defines the lower bound of the TTL for cached positive responses.
+	// +kubebuilder:validation:Format="duration"
+	// +optional
+	MinTTL metav1.Duration `json:"minTTL,omitempty"`
+
+	// MaxTTL defines the upper bound of the TTL for cached positive responses.
+	// +kubebuilder:validation:Format="duration"
+	// +kubebuilder:default="1h"
+	// +optional
+	MaxTTL metav1.Duration `json:"maxTTL,omitempty"`
+
+	// NegativeTTL defines the upper bound of the TTL for cached NXDOMAIN and NODATA responses,
+	// it is also used when the upstream response carries no SOA record.
+	// +kubebuilder:validation:Format="duration"
+	// +kubebuilder:default="30s"
+	// +optional
+	NegativeTTL metav1.Duration `json:"negativeTTL,omitempty"`
+
+	// Prefetch defines the refreshing of popular entries before they expire.
+	// +optional
+	Prefetch LocalDNSCachePrefetch `json:"prefetch,omitempty"`
+}
+
+// LocalDNSCachePrefetch is the type to represent the prefetch configuration of local DNS proxy cache.
+type LocalDNSCachePrefetch struct {
+	// Enable defines a boolean indicating if popular entries are refreshed before they expire.
+	Enable bool `json:"enable"`
+
+	// Hits defines the number of cache hits after which an entry is considered popular.
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Hits uint32 `json:"hits,omitempty"`
+
+	// Percentage defines the percentage of the original TTL remaining when a popular entry is refreshed.
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	// +optional
+	Percentage uint32 `json:"percentage,omitempty"`
 }
 
 type DNSUpstream struct {
@@ -207,6 +347,17 @@ type SidecarSpec struct {
 	// ConfigResyncInterval defines the resync interval for regular proxy broadcast updates.
 	ConfigResyncInterval string `json:"configResyncInterval,omitempty"`
 
+	// ConfigUpdateDebounceWindow defines the sliding window used to coalesce proxy update events before
+	// the configurations of the proxies are regenerated, each event received within the window slides it
+	// further ahead in time. Defaults to 2s.
+	// +optional
+	ConfigUpdateDebounceWindow string `json:"configUpdateDebounceWindow,omitempty"`
+
+	// ConfigUpdateMaxDelay defines the max amount of time a proxy update event can be held by the debounce
+	// window before the configurations of the proxies are regenerated. Defaults to 10s.
+	// +optional
+	ConfigUpdateMaxDelay string `json:"configUpdateMaxDelay,omitempty"`
+
 	// SidecarTimeout defines the connect/idle/read/write timeout.
 	SidecarTimeout int `json:"sidecarTimeout,omitempty"`
 
@@ -274,6 +425,34 @@ type TrafficWarmupSpec struct {
 	Aggression *float64 `json:"aggression,omitempty"`
 }
 
+// LocalityLoadBalancingSpec is the specification for zone aware load balancing, the sidecars
+// prefer the endpoints in the same topology zone and spill over to the other zones when the
+// capacity of the local zone falls below a threshold
+type LocalityLoadBalancingSpec struct {
+	// +kubebuilder:default=false
+	// +optional
+	Enable bool `json:"enable"`
+
+	// MinLocalCapacity configures the minimum percentage of ready endpoints in the local zone,
+	// relative to an even distribution of the ready endpoints across the zones, below which
+	// the traffic spills over to the other zones.
+	// If unspecified, defaults to 70
+	// +kubebuilder:default=70
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinLocalCapacity *uint32 `json:"minLocalCapacity,omitempty"`
+
+	// SpilloverPercentage configures the percentage of the traffic sent to the other zones
+	// once the capacity of the local zone falls below the threshold.
+	// If unspecified, defaults to 50
+	// +kubebuilder:default=50
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SpilloverPercentage *uint32 `json:"spilloverPercentage,omitempty"`
+}
+
 func (tw *TrafficWarmupSpec) Weight(startTimestampSeconds, currTimestampSeconds int64) float64 {
 	maxWeight := uint64(100)
 	minWeight := uint64(10)
@@ -400,6 +579,30 @@ type ObservabilitySpec struct {
 
 	// RemoteLogging defines FSM's remote logging configuration.
 	RemoteLogging RemoteLoggingSpec `json:"remoteLogging,omitempty"`
+
+	// EgressLogging defines FSM's egress access logging and metrics configuration.
+	EgressLogging EgressLoggingSpec `json:"egressLogging,omitempty"`
+
+	// DependencyDiscovery defines FSM's service dependency discovery configuration.
+	DependencyDiscovery DependencyDiscoverySpec `json:"dependencyDiscovery,omitempty"`
+}
+
+// DependencyDiscoverySpec is the type to represent FSM's service dependency discovery configuration.
+type DependencyDiscoverySpec struct {
+	// Enable defines a boolean indicating if the controller learns the services each workload calls
+	// from the metrics of the sidecars.
+	Enable bool `json:"enable"`
+}
+
+// EgressLoggingSpec is the type to represent FSM's egress access logging and metrics configuration.
+type EgressLoggingSpec struct {
+	// Enable defines a boolean indicating if the sidecars emit egress access logs and per destination host metrics.
+	Enable bool `json:"enable"`
+
+	// AuditMode defines a boolean indicating if egress traffic allowed by no Egress policy is let through and
+	// logged as audited instead of being denied, so that Egress policies can be verified before enforcing them.
+	// +optional
+	AuditMode bool `json:"auditMode,omitempty"`
 }
 
 // TracingSpec is the type to represent FSM's tracing configuration.
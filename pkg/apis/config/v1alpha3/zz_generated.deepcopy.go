@@ -162,6 +162,22 @@ func (in *DNSUpstream) DeepCopy() *DNSUpstream {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DependencyDiscoverySpec) DeepCopyInto(out *DependencyDiscoverySpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DependencyDiscoverySpec.
+func (in *DependencyDiscoverySpec) DeepCopy() *DependencyDiscoverySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DependencyDiscoverySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressGatewaySpec) DeepCopyInto(out *EgressGatewaySpec) {
 	*out = *in
@@ -193,6 +209,22 @@ func (in *EgressGatewaySpec) DeepCopy() *EgressGatewaySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressLoggingSpec) DeepCopyInto(out *EgressLoggingSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressLoggingSpec.
+func (in *EgressLoggingSpec) DeepCopy() *EgressLoggingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressLoggingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalAuthzSpec) DeepCopyInto(out *ExternalAuthzSpec) {
 	*out = *in
@@ -372,9 +404,52 @@ func (in *LoadBalancer) DeepCopy() *LoadBalancer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalDNSCache) DeepCopyInto(out *LocalDNSCache) {
+	*out = *in
+	out.MinTTL = in.MinTTL
+	out.MaxTTL = in.MaxTTL
+	out.NegativeTTL = in.NegativeTTL
+	out.Prefetch = in.Prefetch
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalDNSCache.
+func (in *LocalDNSCache) DeepCopy() *LocalDNSCache {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalDNSCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalDNSCachePrefetch) DeepCopyInto(out *LocalDNSCachePrefetch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalDNSCachePrefetch.
+func (in *LocalDNSCachePrefetch) DeepCopy() *LocalDNSCachePrefetch {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalDNSCachePrefetch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalDNSProxy) DeepCopyInto(out *LocalDNSProxy) {
 	*out = *in
+	if in.Upstreams != nil {
+		in, out := &in.Upstreams, &out.Upstreams
+		*out = make([]LocalDNSUpstream, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.Wildcard.DeepCopyInto(&out.Wildcard)
 	if in.DB != nil {
 		in, out := &in.DB, &out.DB
@@ -383,6 +458,14 @@ func (in *LocalDNSProxy) DeepCopyInto(out *LocalDNSProxy) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	out.Cache = in.Cache
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]LocalDNSZone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -396,6 +479,97 @@ func (in *LocalDNSProxy) DeepCopy() *LocalDNSProxy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalDNSUpstream) DeepCopyInto(out *LocalDNSUpstream) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(LocalDNSUpstreamTLS)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalDNSUpstream.
+func (in *LocalDNSUpstream) DeepCopy() *LocalDNSUpstream {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalDNSUpstream)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalDNSUpstreamTLS) DeepCopyInto(out *LocalDNSUpstreamTLS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalDNSUpstreamTLS.
+func (in *LocalDNSUpstreamTLS) DeepCopy() *LocalDNSUpstreamTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalDNSUpstreamTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalDNSZone) DeepCopyInto(out *LocalDNSZone) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Upstreams != nil {
+		in, out := &in.Upstreams, &out.Upstreams
+		*out = make([]LocalDNSUpstream, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalDNSZone.
+func (in *LocalDNSZone) DeepCopy() *LocalDNSZone {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalDNSZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalityLoadBalancingSpec) DeepCopyInto(out *LocalityLoadBalancingSpec) {
+	*out = *in
+	if in.MinLocalCapacity != nil {
+		in, out := &in.MinLocalCapacity, &out.MinLocalCapacity
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SpilloverPercentage != nil {
+		in, out := &in.SpilloverPercentage, &out.SpilloverPercentage
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalityLoadBalancingSpec.
+func (in *LocalityLoadBalancingSpec) DeepCopy() *LocalityLoadBalancingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalityLoadBalancingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MeshConfig) DeepCopyInto(out *MeshConfig) {
 	*out = *in
@@ -464,6 +638,7 @@ func (in *MeshConfigSpec) DeepCopyInto(out *MeshConfigSpec) {
 	out.RepoServer = in.RepoServer
 	in.Traffic.DeepCopyInto(&out.Traffic)
 	in.Warmup.DeepCopyInto(&out.Warmup)
+	in.LocalityLoadBalancing.DeepCopyInto(&out.LocalityLoadBalancing)
 	in.Observability.DeepCopyInto(&out.Observability)
 	in.Certificate.DeepCopyInto(&out.Certificate)
 	out.FeatureFlags = in.FeatureFlags
@@ -604,6 +779,8 @@ func (in *ObservabilitySpec) DeepCopyInto(out *ObservabilitySpec) {
 	*out = *in
 	in.Tracing.DeepCopyInto(&out.Tracing)
 	in.RemoteLogging.DeepCopyInto(&out.RemoteLogging)
+	out.EgressLogging = in.EgressLogging
+	out.DependencyDiscovery = in.DependencyDiscovery
 	return
 }
 
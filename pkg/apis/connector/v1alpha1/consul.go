@@ -101,6 +101,10 @@ type ConsulSyncToK8SSpec struct {
 	// +optional
 	WithGateway C2KGateway `json:"withGateway,omitempty"`
 
+	// +kubebuilder:default={enable: false}
+	// +optional
+	NativeDNS C2KNativeDNS `json:"nativeDNS,omitempty"`
+
 	// +kubebuilder:default=false
 	// +optional
 	GenerateInternalServiceHealthCheck bool `json:"generateInternalServiceHealthCheck,omitempty"`
@@ -102,6 +102,10 @@ type NacosSyncToK8SSpec struct {
 	// +optional
 	WithGateway C2KGateway `json:"withGateway,omitempty"`
 
+	// +kubebuilder:default={enable: false}
+	// +optional
+	NativeDNS C2KNativeDNS `json:"nativeDNS,omitempty"`
+
 	// +optional
 	AppendLabels map[string]string `json:"appendLabels,omitempty"`
 
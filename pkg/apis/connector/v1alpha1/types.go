@@ -60,6 +60,18 @@ type C2KGateway struct {
 	MultiGateways bool `json:"multiGateways,omitempty"`
 }
 
+// C2KNativeDNS defines the registry native names the FSM DNS proxy answers for synced services
+type C2KNativeDNS struct {
+	// +kubebuilder:default=false
+	// +optional
+	Enable bool `json:"enable,omitempty"`
+
+	// Domain defines the top level domain of the registry native names,
+	// defaults to consul for <service>.service.consul and to nacos for <service>.<group>.nacos
+	// +optional
+	Domain string `json:"domain,omitempty"`
+}
+
 type Connector interface {
 	runtime.Object
 	metav1.Object
@@ -39,6 +39,22 @@ func (in *C2KGateway) DeepCopy() *C2KGateway {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *C2KNativeDNS) DeepCopyInto(out *C2KNativeDNS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new C2KNativeDNS.
+func (in *C2KNativeDNS) DeepCopy() *C2KNativeDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(C2KNativeDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnectorStatus) DeepCopyInto(out *ConnectorStatus) {
 	*out = *in
@@ -273,6 +289,7 @@ func (in *ConsulSyncToK8SSpec) DeepCopyInto(out *ConsulSyncToK8SSpec) {
 		**out = **in
 	}
 	out.WithGateway = in.WithGateway
+	out.NativeDNS = in.NativeDNS
 	if in.AppendLabels != nil {
 		in, out := &in.AppendLabels, &out.AppendLabels
 		*out = make(map[string]string, len(*in))
@@ -1142,6 +1159,7 @@ func (in *NacosSyncToK8SSpec) DeepCopyInto(out *NacosSyncToK8SSpec) {
 		copy(*out, *in)
 	}
 	out.WithGateway = in.WithGateway
+	out.NativeDNS = in.NativeDNS
 	if in.AppendLabels != nil {
 		in, out := &in.AppendLabels, &out.AppendLabels
 		*out = make(map[string]string, len(*in))
@@ -0,0 +1,117 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// JWTAuthSpec defines the desired state of JWTAuth
+type JWTAuthSpec struct {
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// Providers is the list of JWT providers, a request is authenticated if its JWT is validated by any of the providers
+	Providers []JWTProvider `json:"providers"`
+
+	// +optional
+	// +kubebuilder:default=false
+	// AllowMissingToken is the flag to allow requests without JWT, requests carrying an invalid JWT are always rejected
+	AllowMissingToken *bool `json:"allowMissingToken,omitempty"`
+}
+
+// JWTProvider defines how to validate the JWT issued by an issuer
+type JWTProvider struct {
+	// +kubebuilder:validation:MinLength=1
+	// Issuer is the issuer of the JWT, it must match the `iss` claim
+	Issuer string `json:"issuer"`
+
+	// +optional
+	// +listType=set
+	// Audiences is the list of accepted audiences, one of them must match the `aud` claim, any audience is accepted if empty
+	Audiences []string `json:"audiences,omitempty"`
+
+	// +optional
+	// JWKSURI is the URI of the JSON Web Key Set of the issuer, the key set is fetched and cached by the controller
+	JWKSURI *string `json:"jwksUri,omitempty"`
+
+	// +optional
+	// JWKS is the inline JSON Web Key Set of the issuer, it takes precedence over JWKSURI
+	JWKS *string `json:"jwks,omitempty"`
+
+	// +optional
+	// FromHeaders is the list of headers the JWT is extracted from, defaults to the Authorization header with the `Bearer ` prefix
+	FromHeaders []JWTHeader `json:"fromHeaders,omitempty"`
+
+	// +optional
+	// +listType=set
+	// FromParams is the list of query parameters the JWT is extracted from
+	FromParams []string `json:"fromParams,omitempty"`
+
+	// +optional
+	// OutputClaimToHeaders is the list of claims copied to request headers once the JWT is validated
+	OutputClaimToHeaders []JWTClaimToHeader `json:"outputClaimToHeaders,omitempty"`
+
+	// +optional
+	// +kubebuilder:default=false
+	// ForwardOriginalToken is the flag to keep the JWT in the request forwarded to the backend
+	ForwardOriginalToken *bool `json:"forwardOriginalToken,omitempty"`
+}
+
+// JWTHeader defines a header the JWT is extracted from
+type JWTHeader struct {
+	// +kubebuilder:validation:MinLength=1
+	// Name is the name of the header
+	Name string `json:"name"`
+
+	// +optional
+	// Prefix is the prefix stripped from the header value, e.g. `Bearer `
+	Prefix *string `json:"prefix,omitempty"`
+}
+
+// JWTClaimToHeader defines a claim copied to a request header
+type JWTClaimToHeader struct {
+	// +kubebuilder:validation:MinLength=1
+	// Header is the name of the request header
+	Header string `json:"header"`
+
+	// +kubebuilder:validation:MinLength=1
+	// Claim is the name of the claim, nested claims are separated by `.`
+	Claim string `json:"claim"`
+}
+
+// JWTAuthStatus defines the observed state of JWTAuth
+type JWTAuthStatus struct {
+	// Conditions describe the current conditions of the JWTAuth.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:storageversion
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories=gateway-api
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels={app.kubernetes.io/name=flomesh.io,gateway.flomesh.io/extension=Filter}
+
+// JWTAuth is the Schema for the JWTAuth API
+type JWTAuth struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JWTAuthSpec   `json:"spec,omitempty"`
+	Status JWTAuthStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// JWTAuthList contains a list of JWTAuth
+type JWTAuthList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JWTAuth `json:"items"`
+}
@@ -78,6 +78,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&HTTPLogList{},
 		&IPRestriction{},
 		&IPRestrictionList{},
+		&JWTAuth{},
+		&JWTAuthList{},
 		&ListenerFilter{},
 		&ListenerFilterList{},
 		&Metrics{},
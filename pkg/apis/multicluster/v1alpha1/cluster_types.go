@@ -31,6 +31,31 @@ import (
 	"github.com/flomesh-io/fsm/pkg/utils"
 )
 
+// ClusterHealthCheck defines how the health of a managed cluster is checked
+type ClusterHealthCheck struct {
+	// Interval between two health checks of the API server and the gateway of the cluster
+	// +optional
+	// +kubebuilder:default="10s"
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// Timeout of a health check
+	// +optional
+	// +kubebuilder:default="3s"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// FailureThreshold is the number of consecutive failed health checks before the cluster is considered unreachable
+	// +optional
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=1
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
+
+	// GracePeriod is how long the cluster can stay unreachable before the endpoints it exports
+	// are withdrawn from the ServiceImports of the other clusters
+	// +optional
+	// +kubebuilder:default="60s"
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
 // ClusterSpec defines the desired state of Cluster
 type ClusterSpec struct {
 	// +kubebuilder:default=default
@@ -63,6 +88,15 @@ type ClusterSpec struct {
 	// The port number of the gateway
 	GatewayPort int32 `json:"gatewayPort,omitempty"`
 
+	// +kubebuilder:default=443
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+
+	// The TLS port number of the gateway, the services exported as GRPC or TCP
+	// are routed by SNI through it
+	GatewayTLSPort int32 `json:"gatewayTLSPort,omitempty"`
+
 	// FIXME: temp solution, should NOT store this as plain text.
 	//  consider use cli to add cluster to control plane, import kubeconfig
 	//  and create a Secret with proper SA to store it as bytes
@@ -79,6 +113,11 @@ type ClusterSpec struct {
 
 	// FsmNamespace, defines the namespace of managed cluster in which fsm is installed
 	FsmNamespace string `json:"fsmNamespace"`
+
+	// +optional
+
+	// HealthCheck, defines how the health of the cluster is checked
+	HealthCheck *ClusterHealthCheck `json:"healthCheck,omitempty"`
 }
 
 // ClusterStatus defines the observed state of Cluster
@@ -89,6 +128,15 @@ type ClusterStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// +optional
+	// LastSyncTime is the last time the cluster was checked healthy along with a change of the status,
+	// it's not refreshed if nothing else in the status is changed
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// +optional
+	// ImportedServices is the number of the services imported into the cluster
+	ImportedServices int32 `json:"importedServices,omitempty"`
 }
 
 // ClusterConditionType identifies a specific condition.
@@ -98,6 +146,14 @@ const (
 	// ClusterManaged means that the cluster has joined the CLusterSet successfully
 	//  and is managed by Control Plane.
 	ClusterManaged ClusterConditionType = "Managed"
+
+	// ClusterReachable means that the API server and the gateway of the cluster
+	//  pass the health checks.
+	ClusterReachable ClusterConditionType = "Reachable"
+
+	// ClusterReady means that the cluster is reachable and its connector is running,
+	//  the services exported by it are imported into the other clusters.
+	ClusterReady ClusterConditionType = "Ready"
 )
 
 // +genclient
@@ -114,6 +170,10 @@ const (
 // +kubebuilder:printcolumn:name="Gateway Port",type="integer",priority=0,JSONPath=".spec.gatewayPort"
 // +kubebuilder:printcolumn:name="Managed",type="string",priority=0,JSONPath=".status.conditions[?(@.type=='Managed')].status"
 // +kubebuilder:printcolumn:name="Managed Age",type="date",priority=0,JSONPath=".status.conditions[?(@.type=='Managed')].lastTransitionTime"
+// +kubebuilder:printcolumn:name="Ready",type="string",priority=0,JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Reachable",type="string",priority=1,JSONPath=".status.conditions[?(@.type=='Reachable')].status"
+// +kubebuilder:printcolumn:name="Imported",type="integer",priority=1,JSONPath=".status.importedServices"
+// +kubebuilder:printcolumn:name="Last Sync",type="date",priority=1,JSONPath=".status.lastSyncTime"
 // +kubebuilder:printcolumn:name="Age",type="date",priority=0,JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:metadata:labels=app.kubernetes.io/name=flomesh.io
 
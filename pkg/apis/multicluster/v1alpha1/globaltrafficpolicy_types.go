@@ -47,6 +47,38 @@ type TrafficTarget struct {
 
 	// +optional
 	Weight *int `json:"weight,omitempty"`
+
+	// Priority of the target in FailOver load balancing, the healthy target with
+	// the lowest value serves the traffic, targets of the same priority are
+	// preferred in the listed order.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Priority *int `json:"priority,omitempty"`
+}
+
+// GlobalTrafficHealthCheck defines how the health of the endpoints of the targets is probed
+type GlobalTrafficHealthCheck struct {
+	// Interval between two probes of an endpoint
+	// +optional
+	// +kubebuilder:default="10s"
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// Timeout of a probe
+	// +optional
+	// +kubebuilder:default="1s"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// UnhealthyThreshold is the number of consecutive failed probes before an endpoint is considered unhealthy
+	// +optional
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=1
+	UnhealthyThreshold *int32 `json:"unhealthyThreshold,omitempty"`
+
+	// HealthyThreshold is the number of consecutive successful probes before an unhealthy endpoint is considered healthy again
+	// +optional
+	// +kubebuilder:default=2
+	// +kubebuilder:validation:Minimum=1
+	HealthyThreshold *int32 `json:"healthyThreshold,omitempty"`
 }
 
 // GlobalTrafficPolicySpec defines the desired state of GlobalTrafficPolicy
@@ -58,10 +90,70 @@ type GlobalTrafficPolicySpec struct {
 
 	// +optional
 	Targets []TrafficTarget `json:"targets,omitempty"`
+
+	// HealthCheck defines how the health of the endpoints of the targets is probed,
+	// the active target of FailOver load balancing is selected from the healthy ones
+	// +optional
+	HealthCheck *GlobalTrafficHealthCheck `json:"healthCheck,omitempty"`
+}
+
+// FailOverReason defines the reason of the active target selection
+type FailOverReason string
+
+const (
+	// FailOverReasonPrimaryHealthy is the reason used when the target of the highest priority is healthy and serving
+	FailOverReasonPrimaryHealthy FailOverReason = "PrimaryHealthy"
+
+	// FailOverReasonFailedOver is the reason used when the traffic failed over to a target of lower priority
+	FailOverReasonFailedOver FailOverReason = "FailedOver"
+
+	// FailOverReasonNoHealthyTarget is the reason used when none of the targets is healthy
+	FailOverReasonNoHealthyTarget FailOverReason = "NoHealthyTarget"
+)
+
+// TrafficTargetStatus defines the observed health of a target
+type TrafficTargetStatus struct {
+	// Format: [region]/[zone]/[group]/[cluster]
+	ClusterKey string `json:"clusterKey"`
+
+	// Priority is the effective priority of the target
+	Priority int `json:"priority"`
+
+	// Healthy tells if at least one endpoint of the target is healthy
+	Healthy bool `json:"healthy"`
+
+	// Endpoints is the number of endpoints imported from the target
+	Endpoints int `json:"endpoints"`
+
+	// HealthyEndpoints is the number of healthy endpoints imported from the target
+	HealthyEndpoints int `json:"healthyEndpoints"`
+
+	// LastTransitionTime is the last time the health of the target changed
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
 }
 
 // GlobalTrafficPolicyStatus defines the observed state of GlobalTrafficPolicy
 type GlobalTrafficPolicyStatus struct {
+	// ActiveCluster is the cluster key of the target currently serving the traffic in FailOver load balancing
+	// +optional
+	ActiveCluster string `json:"activeCluster,omitempty"`
+
+	// Reason tells why the active target serves the traffic
+	// +optional
+	Reason FailOverReason `json:"reason,omitempty"`
+
+	// Message is a human readable description of the active target selection
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastFailOverTime is the last time the active target changed
+	// +optional
+	LastFailOverTime *metav1.Time `json:"lastFailOverTime,omitempty"`
+
+	// Targets is the observed health of the targets
+	// +optional
+	Targets []TrafficTargetStatus `json:"targets,omitempty"`
 }
 
 // +genclient
@@ -71,6 +163,8 @@ type GlobalTrafficPolicyStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:shortName=gtp,scope=Namespaced
 // +kubebuilder:printcolumn:name="LB Type",type="string",priority=0,JSONPath=".spec.lbType"
+// +kubebuilder:printcolumn:name="Active",type="string",priority=0,JSONPath=".status.activeCluster"
+// +kubebuilder:printcolumn:name="Reason",type="string",priority=1,JSONPath=".status.reason"
 // +kubebuilder:printcolumn:name="Age",type="date",priority=0,JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:metadata:labels=app.kubernetes.io/name=flomesh.io
 
@@ -25,17 +25,41 @@
 package v1alpha1
 
 import (
+	"fmt"
+
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	commons "github.com/flomesh-io/fsm/pkg/apis"
 )
 
+// ServiceExportProtocol defines the protocol of an exported service port
+type ServiceExportProtocol string
+
+const (
+	// ServiceExportProtocolHTTP means the port is exported as HTTP and routed by path through the gateway
+	ServiceExportProtocolHTTP ServiceExportProtocol = "HTTP"
+
+	// ServiceExportProtocolGRPC means the port is exported as gRPC and routed by SNI through the gateway
+	ServiceExportProtocolGRPC ServiceExportProtocol = "GRPC"
+
+	// ServiceExportProtocolTCP means the port is exported as plain TCP and routed by SNI through the gateway
+	ServiceExportProtocolTCP ServiceExportProtocol = "TCP"
+)
+
 // ServiceExportRule defines the rule for service export
 type ServiceExportRule struct {
 	// The port number of service
 	PortNumber int32 `json:"portNumber,omitempty"`
 
+	// +kubebuilder:default=HTTP
+	// +kubebuilder:validation:Enum=HTTP;GRPC;TCP
+	// +optional
+	// Protocol is the protocol the port is exported with. HTTP ports are routed by
+	// path through the gateway, GRPC and TCP ports are routed by SNI through the TLS
+	// port of the gateway and the traffic stays mTLS encrypted end to end.
+	Protocol ServiceExportProtocol `json:"protocol,omitempty"`
+
 	// Path is matched against the path of an incoming request. Currently it can
 	// contain characters disallowed from the conventional "path" part of a URL
 	// as defined by RFC 3986. Paths must begin with a '/' and must be present
@@ -54,7 +78,18 @@ type ServiceExportRule struct {
 	//   matches /foo/bar/baz, but does not match /foo/barbaz).
 
 	// +kubebuilder:validation:Enum=Exact;Prefix
-	PathType *networkingv1.PathType `json:"pathType"`
+	// +optional
+	PathType *networkingv1.PathType `json:"pathType,omitempty"`
+}
+
+// IsRoutedBySNI returns true if the port is routed by SNI through the gateway instead of by path
+func (r ServiceExportRule) IsRoutedBySNI() bool {
+	return r.Protocol == ServiceExportProtocolGRPC || r.Protocol == ServiceExportProtocolTCP
+}
+
+// ServiceExportServerName returns the SNI the gateway routes the traffic of an exported port by
+func ServiceExportServerName(namespace, name string, port int32) string {
+	return fmt.Sprintf("%d.%s.%s.svc.clusterset.local", port, name, namespace)
 }
 
 // PathRewrite defines the rewrite rule for service export
@@ -134,6 +134,11 @@ type Target struct {
 	IP   string `json:"ip"`
 	Port int32  `json:"port"`
 	Path string `json:"path"`
+
+	// +optional
+	// ServerName is the SNI to connect to the gateway with, it's set for the ports
+	// exported as GRPC or TCP, which are routed by SNI instead of Path
+	ServerName string `json:"serverName,omitempty"`
 }
 
 // ServiceImportStatus describes derived state of an imported service.
@@ -12,6 +12,7 @@ import (
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:metadata:labels=app.kubernetes.io/name=flomesh.io
 // +kubebuilder:resource:shortName=accesscontrol,scope=Namespaced
+// +kubebuilder:subresource:status
 type AccessControl struct {
 	// Object's type metadata
 	metav1.TypeMeta `json:",inline"`
@@ -89,4 +90,13 @@ type AccessControlStatus struct {
 	// Reason defines the reason for the current status of an AccessControl resource.
 	// +optional
 	Reason string `json:"reason,omitempty"`
+
+	// Conditions describe the current conditions of an AccessControl resource,
+	// the Programmed condition reports how many proxies have loaded its latest generation.
+	// +optional
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 }
@@ -0,0 +1,166 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AuthorizationPolicy is the type used to represent an AuthorizationPolicy policy.
+// An AuthorizationPolicy policy allows or denies the HTTP requests received by one or more backends
+// based on the source identity, method, path, headers and JWT claims of the requests.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:metadata:labels=app.kubernetes.io/name=flomesh.io
+// +kubebuilder:resource:shortName=authz,scope=Namespaced
+// +kubebuilder:printcolumn:name="Action",type=string,JSONPath=`.spec.action`
+// +kubebuilder:printcolumn:name="Priority",type=integer,JSONPath=`.spec.priority`
+type AuthorizationPolicy struct {
+	// Object's type metadata
+	metav1.TypeMeta `json:",inline"`
+
+	// Object's metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the AuthorizationPolicy policy specification
+	// +optional
+	Spec AuthorizationPolicySpec `json:"spec,omitempty"`
+
+	// Status is the status of the AuthorizationPolicy configuration.
+	// +optional
+	Status AuthorizationPolicyStatus `json:"status,omitempty"`
+}
+
+// AuthorizationAction is the type used to represent the action of an AuthorizationPolicy policy.
+// +kubebuilder:validation:Enum=ALLOW;DENY
+type AuthorizationAction string
+
+const (
+	// AuthorizationActionAllow allows the requests matching the rules
+	AuthorizationActionAllow AuthorizationAction = "ALLOW"
+
+	// AuthorizationActionDeny denies the requests matching the rules
+	AuthorizationActionDeny AuthorizationAction = "DENY"
+)
+
+const (
+	// KindServiceAccount is the kind corresponding to a service account.
+	KindServiceAccount = "ServiceAccount"
+)
+
+// AuthorizationPolicySpec is the type used to represent the AuthorizationPolicy policy specification.
+//
+// The rules of all the AuthorizationPolicy policies applied to a backend are evaluated in order of
+// priority, the policies with a lower priority value are evaluated first and DENY policies are
+// evaluated before ALLOW policies of the same priority. The action of the first matching rule is taken.
+// A request matching no rule is denied if an ALLOW policy applies to the backend, or allowed otherwise.
+type AuthorizationPolicySpec struct {
+	// Backends defines the list of backends the AuthorizationPolicy policy applies to.
+	// The policy applies to all the backends in the namespace if not specified.
+	// +optional
+	Backends []AuthorizationBackendSpec `json:"backends,omitempty"`
+
+	// Action defines the action taken for the requests matching the rules.
+	// +kubebuilder:default=ALLOW
+	// +optional
+	Action AuthorizationAction `json:"action,omitempty"`
+
+	// Priority defines the order the policy is evaluated in, lower values are evaluated first.
+	// +kubebuilder:default=0
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// Rules defines the list of rules, a request matches the policy if it matches any of the rules.
+	// +kubebuilder:validation:MinItems=1
+	Rules []AuthorizationRule `json:"rules"`
+}
+
+// AuthorizationBackendSpec is the type used to represent a Backend specified in the AuthorizationPolicy policy specification.
+type AuthorizationBackendSpec struct {
+	// Name defines the name of the backend.
+	Name string `json:"name"`
+
+	// Port defines the specification for the backend's port.
+	Port PortSpec `json:"port"`
+}
+
+// AuthorizationRule is the type used to represent the conditions of a request,
+// a request matches the rule if it matches all of the specified conditions.
+type AuthorizationRule struct {
+	// Sources defines the list of sources the request must originate from, any source matches if not specified.
+	// +optional
+	Sources []AuthorizationSource `json:"sources,omitempty"`
+
+	// Methods defines the list of HTTP methods the request must use, any method matches if not specified.
+	// +optional
+	Methods []string `json:"methods,omitempty"`
+
+	// Paths defines the list of path prefixes the request path must start with, any path matches if not specified.
+	// +optional
+	Paths []string `json:"paths,omitempty"`
+
+	// Headers defines the list of headers the request must carry.
+	// +optional
+	Headers []AuthorizationHeaderMatch `json:"headers,omitempty"`
+
+	// Claims defines the list of JWT claims the request must carry,
+	// the JWT of the request is validated by a RequestAuthentication policy.
+	// +optional
+	Claims []AuthorizationClaimMatch `json:"claims,omitempty"`
+}
+
+// AuthorizationSource is the type used to represent the source of a request.
+type AuthorizationSource struct {
+	// Kind defines the kind of the source, one of ServiceAccount or AuthenticatedPrincipal.
+	// +kubebuilder:validation:Enum=ServiceAccount;AuthenticatedPrincipal
+	Kind string `json:"kind"`
+
+	// Name defines the name of the source, `*` matches any name.
+	// The name of an AuthenticatedPrincipal is a principal with a trust domain, e.g. `sa.ns.cluster.local`.
+	Name string `json:"name"`
+
+	// Namespace defines the namespace of a ServiceAccount source.
+	// Defaults to the namespace of the AuthorizationPolicy.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// AuthorizationHeaderMatch is the type used to represent a header a request must carry.
+type AuthorizationHeaderMatch struct {
+	// Name defines the name of the header.
+	Name string `json:"name"`
+
+	// Values defines the list of accepted values, any value matches if not specified.
+	// +optional
+	Values []string `json:"values,omitempty"`
+}
+
+// AuthorizationClaimMatch is the type used to represent a JWT claim a request must carry.
+type AuthorizationClaimMatch struct {
+	// Name defines the name of the claim, nested claims are separated by `.`.
+	Name string `json:"name"`
+
+	// Values defines the list of accepted values, one of them must be equal to the claim
+	// or be contained in the claim if the claim is a list.
+	// +kubebuilder:validation:MinItems=1
+	Values []string `json:"values"`
+}
+
+// AuthorizationPolicyList defines the list of AuthorizationPolicy objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type AuthorizationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []AuthorizationPolicy `json:"items"`
+}
+
+// AuthorizationPolicyStatus is the type used to represent the status of an AuthorizationPolicy resource.
+type AuthorizationPolicyStatus struct {
+	// CurrentStatus defines the current status of an AuthorizationPolicy resource.
+	// +optional
+	CurrentStatus string `json:"currentStatus,omitempty"`
+
+	// Reason defines the reason for the current status of an AuthorizationPolicy resource.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -29,6 +30,11 @@ type EgressGatewaySpec struct {
 	// +optional
 	GlobalEgressGateways []GatewayBindingSubject `json:"global"`
 
+	// Hosts defines the per destination host rules enforced by the global egress gateways of the policy.
+	// When any host rule is defined, destinations matching none of them are denied by those gateways.
+	// +optional
+	Hosts []EgressGatewayHostRule `json:"hosts,omitempty"`
+
 	//// EgressPolicyGatewayRules defines the rules of gateway based egress policies.
 	//// +optional
 	//EgressPolicyGatewayRules []EgressPolicyGatewayRule `json:"rules"`
@@ -38,6 +44,58 @@ type EgressGatewaySpec struct {
 	//Matches []corev1.TypedLocalObjectReference `json:"matches,omitempty"`
 }
 
+// EgressGatewayHostRule is the type used to represent a destination host rule of the egress gateway.
+type EgressGatewayHostRule struct {
+	// Host is the destination host allowed through the egress gateway.
+	// A leading '*.' matches any subdomain, e.g. *.example.com.
+	Host string `json:"host"`
+
+	// Ports restricts the rule to the given destination ports, all ports are matched if empty.
+	// +optional
+	Ports []int32 `json:"ports,omitempty"`
+
+	// TLS enables TLS origination from the egress gateway to the destination.
+	// +optional
+	TLS *EgressGatewayTLSOrigination `json:"tls,omitempty"`
+
+	// UpstreamProxy chains the egress connection through an upstream HTTP proxy.
+	// +optional
+	UpstreamProxy *EgressGatewayUpstreamProxy `json:"upstreamProxy,omitempty"`
+}
+
+// EgressGatewayTLSOrigination is the type used to represent the TLS origination of the egress gateway.
+type EgressGatewayTLSOrigination struct {
+	// SNI is the server name sent to the destination, defaults to the destination host.
+	// +optional
+	SNI string `json:"sni,omitempty"`
+
+	// Port is the destination port TLS is originated to, defaults to the requested port.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+
+	// CACertificate references the secret whose ca.crt is used to verify the destination certificate,
+	// the secret must be in the namespace of the EgressGateway.
+	// +optional
+	CACertificate *corev1.SecretReference `json:"caCertificate,omitempty"`
+
+	// InsecureSkipVerify disables the verification of the destination certificate.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// EgressGatewayUpstreamProxy is the type used to represent an upstream HTTP proxy the egress gateway chains to.
+type EgressGatewayUpstreamProxy struct {
+	// Address is the host:port of the upstream proxy.
+	Address string `json:"address"`
+
+	// CredentialsSecret references the secret whose username and password authenticate to the upstream proxy,
+	// the secret must be in the namespace of the EgressGateway.
+	// +optional
+	CredentialsSecret *corev1.SecretReference `json:"credentialsSecret,omitempty"`
+}
+
 // EgressPolicyGatewayRule is the type used to represent the rule of Egress Gateway specification based egress policies.
 type EgressPolicyGatewayRule struct {
 	EgressPolicies []EgressBindingSubject  `json:"egressPolicies"`
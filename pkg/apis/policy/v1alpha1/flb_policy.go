@@ -0,0 +1,223 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// FLBAlgorithm is the load balancing algorithm used by FLB
+// +kubebuilder:validation:Enum=rr;lc;ch
+type FLBAlgorithm string
+
+const (
+	// FLBAlgorithmRoundRobin is the round-robin algorithm
+	FLBAlgorithmRoundRobin FLBAlgorithm = "rr"
+
+	// FLBAlgorithmLeastConnections is the least connections algorithm
+	FLBAlgorithmLeastConnections FLBAlgorithm = "lc"
+
+	// FLBAlgorithmConsistentHashing is the consistent hashing algorithm
+	FLBAlgorithmConsistentHashing FLBAlgorithm = "ch"
+)
+
+// FLBTLSSecretMode is the mode of the secret which stores the TLS certificate
+// +kubebuilder:validation:Enum=local;remote
+type FLBTLSSecretMode string
+
+const (
+	// FLBTLSSecretModeLocal means the secret is stored in the cluster and pushed to FLB
+	FLBTLSSecretModeLocal FLBTLSSecretMode = "local"
+
+	// FLBTLSSecretModeRemote means the secret is stored in FLB already
+	FLBTLSSecretModeRemote FLBTLSSecretMode = "remote"
+)
+
+// FLBPolicySpec defines the desired state of FLBPolicy
+type FLBPolicySpec struct {
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// TargetRefs is the references to the Services or the Namespace to which the policy is applied,
+	// only LoadBalancer Services are served by FLB
+	TargetRefs []gwv1alpha2.LocalPolicyTargetReference `json:"targetRefs"`
+
+	// +optional
+	// AddressPool is the FLB address pool the VIP is allocated from,
+	// defaults to the address pool of the FLB setting
+	AddressPool *string `json:"addressPool,omitempty"`
+
+	// +optional
+	// DesiredIP is the VIP requested from FLB, it can only be set when the policy targets a single Service
+	DesiredIP *string `json:"desiredIP,omitempty"`
+
+	// +optional
+	// Algorithm is the load balancing algorithm, defaults to the algorithm of the FLB setting
+	Algorithm *FLBAlgorithm `json:"algorithm,omitempty"`
+
+	// +optional
+	// XForwardedFor indicates if the X-Forwarded-For header is added to the requests
+	XForwardedFor *bool `json:"xForwardedFor,omitempty"`
+
+	// +optional
+	// SessionSticky indicates if session sticky is enabled
+	SessionSticky *bool `json:"sessionSticky,omitempty"`
+
+	// +optional
+	// Limits is the connection and bandwidth limits
+	Limits *FLBLimits `json:"limits,omitempty"`
+
+	// +optional
+	// Timeouts is the connection timeouts
+	Timeouts *FLBTimeouts `json:"timeouts,omitempty"`
+
+	// +optional
+	// TLS is the TLS termination configuration, TLS is enabled if it's set
+	TLS *FLBTLSConfig `json:"tls,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=port
+	// +kubebuilder:validation:MaxItems=64
+	// Tags is the list of tags attached to the service ports in FLB
+	Tags []FLBPortTags `json:"tags,omitempty"`
+}
+
+// FLBLimits defines the limits of the FLB service
+type FLBLimits struct {
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// MaxConnections is the maximum number of connections
+	MaxConnections *int32 `json:"maxConnections,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// Size is the bandwidth limit size
+	Size *int32 `json:"size,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// SyncRate is the bandwidth limit sync rate
+	SyncRate *int32 `json:"syncRate,omitempty"`
+}
+
+// FLBTimeouts defines the timeouts of the FLB service, in seconds
+type FLBTimeouts struct {
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// Read is the read timeout in seconds
+	Read *int32 `json:"read,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// Write is the write timeout in seconds
+	Write *int32 `json:"write,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// Idle is the idle timeout in seconds
+	Idle *int32 `json:"idle,omitempty"`
+}
+
+// FLBTLSConfig defines the TLS termination configuration of the FLB service
+type FLBTLSConfig struct {
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// Port is the service port which TLS is terminated on
+	Port int32 `json:"port"`
+
+	// +kubebuilder:validation:MinLength=1
+	// SecretName is the name of the secret which stores the TLS certificate,
+	// in local mode it must be in the same namespace as the policy and be labeled with flb.flomesh.io/tls=true
+	SecretName string `json:"secretName"`
+
+	// +optional
+	// +kubebuilder:default=local
+	// SecretMode is the mode of the secret, defaults to local
+	SecretMode FLBTLSSecretMode `json:"secretMode,omitempty"`
+}
+
+// FLBPortTags defines the tags of a service port
+type FLBPortTags struct {
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// Port is the service port
+	Port int32 `json:"port"`
+
+	// Tags is the tags of the port
+	Tags map[string]string `json:"tags"`
+}
+
+// FLBPolicyStatus defines the observed state of FLBPolicy
+type FLBPolicyStatus struct {
+	// +optional
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	// +listType=map
+	// +listMapKey=type
+	// Conditions describe the current conditions of the FLBPolicy
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=namespace
+	// +listMapKey=name
+	// Services is the FLB status of each Service the policy is applied to
+	Services []FLBServiceStatus `json:"services,omitempty"`
+}
+
+// FLBServiceStatus defines the FLB status of a Service
+type FLBServiceStatus struct {
+	// Namespace is the namespace of the Service
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the Service
+	Name string `json:"name"`
+
+	// +optional
+	// Addresses is the VIPs allocated by FLB
+	Addresses []string `json:"addresses,omitempty"`
+
+	// +optional
+	// LastError is the error returned by the last failed FLB API call
+	LastError string `json:"lastError,omitempty"`
+}
+
+// FLBPolicyConditionType identifies a specific condition.
+type FLBPolicyConditionType string
+
+const (
+	// FLBPolicyConditionAccepted means the policy is valid and attached to its targets
+	FLBPolicyConditionAccepted FLBPolicyConditionType = "Accepted"
+
+	// FLBPolicyConditionProgrammed means all the target Services are programmed into FLB
+	FLBPolicyConditionProgrammed FLBPolicyConditionType = "Programmed"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=flbpolicy,scope=Namespaced
+// +kubebuilder:printcolumn:name="Programmed",type="string",priority=0,JSONPath=".status.conditions[?(@.type=='Programmed')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",priority=0,JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:metadata:labels=app.kubernetes.io/name=flomesh.io
+
+// FLBPolicy configures how FLB serves the LoadBalancer Services it targets,
+// it takes precedence over the flb.flomesh.io/* annotations
+type FLBPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FLBPolicySpec   `json:"spec,omitempty"`
+	Status FLBPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FLBPolicyList contains a list of FLBPolicy
+type FLBPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FLBPolicy `json:"items"`
+}
@@ -0,0 +1,125 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MeshFaultInjection is the type used to represent a MeshFaultInjection policy.
+// A MeshFaultInjection policy injects delays and aborts into the HTTP requests
+// sent by the sidecars of the mesh to a destination service.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:metadata:labels=app.kubernetes.io/name=flomesh.io
+// +kubebuilder:resource:shortName=meshfault,scope=Namespaced
+type MeshFaultInjection struct {
+	// Object's type metadata
+	metav1.TypeMeta `json:",inline"`
+
+	// Object's metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the MeshFaultInjection policy specification
+	// +optional
+	Spec MeshFaultInjectionSpec `json:"spec,omitempty"`
+
+	// Status is the status of the MeshFaultInjection configuration.
+	// +optional
+	Status MeshFaultInjectionStatus `json:"status,omitempty"`
+}
+
+// MeshFaultInjectionSpec is the type used to represent the MeshFaultInjection policy specification.
+// The delay is applied before the abort, a request can be both delayed and aborted.
+type MeshFaultInjectionSpec struct {
+	// Destination defines the service in the namespace of the MeshFaultInjection
+	// policy the faults are injected into the requests to.
+	Destination MeshFaultInjectionDestinationSpec `json:"destination"`
+
+	// Sources defines the downstream pods injecting the faults.
+	// All the pods of the mesh inject the faults if not specified.
+	// +optional
+	Sources *MeshFaultInjectionSourcesSpec `json:"sources,omitempty"`
+
+	// Delay defines the delay injected into the requests.
+	// +optional
+	Delay *MeshFaultDelaySpec `json:"delay,omitempty"`
+
+	// Abort defines the abort injected into the requests.
+	// +optional
+	Abort *MeshFaultAbortSpec `json:"abort,omitempty"`
+}
+
+// MeshFaultInjectionDestinationSpec is the type used to represent the destination of a MeshFaultInjection policy.
+type MeshFaultInjectionDestinationSpec struct {
+	// Name defines the name of the service.
+	Name string `json:"name"`
+
+	// Port defines the port of the service.
+	// The faults are injected into the requests to all the ports if not specified.
+	// +optional
+	Port *uint16 `json:"port,omitempty"`
+}
+
+// MeshFaultInjectionSourcesSpec is the type used to represent the downstream pods of a MeshFaultInjection policy.
+type MeshFaultInjectionSourcesSpec struct {
+	// Namespaces defines the namespaces of the downstream pods.
+	// Pods in all the namespaces match if not specified.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// PodSelector defines the labels of the downstream pods.
+	// All the pods in the matching namespaces match if not specified.
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+}
+
+// MeshFaultDelaySpec is the type used to represent the delay injected by a MeshFaultInjection policy.
+type MeshFaultDelaySpec struct {
+	// Percent defines the percentage of the requests delayed.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percent uint32 `json:"percent"`
+
+	// FixedDelay defines the duration the requests are delayed by.
+	FixedDelay metav1.Duration `json:"fixedDelay"`
+}
+
+// MeshFaultAbortSpec is the type used to represent the abort injected by a MeshFaultInjection policy.
+type MeshFaultAbortSpec struct {
+	// Percent defines the percentage of the requests aborted.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percent uint32 `json:"percent"`
+
+	// HTTPStatus defines the status code of the response to the aborted requests.
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	HTTPStatus int32 `json:"httpStatus"`
+
+	// GRPCStatus defines the gRPC status code of the response to the aborted
+	// gRPC requests, in place of HTTPStatus.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=16
+	// +optional
+	GRPCStatus *uint32 `json:"grpcStatus,omitempty"`
+}
+
+// MeshFaultInjectionStatus is the type used to represent the status of a MeshFaultInjection resource.
+type MeshFaultInjectionStatus struct {
+	// CurrentStatus defines the current status of a MeshFaultInjection resource.
+	// +optional
+	CurrentStatus string `json:"currentStatus,omitempty"`
+
+	// Reason defines the reason for the current status of a MeshFaultInjection resource.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// MeshFaultInjectionList defines the list of MeshFaultInjection objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type MeshFaultInjectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []MeshFaultInjection `json:"items"`
+}
@@ -0,0 +1,132 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RequestAuthentication is the type used to represent a RequestAuthentication policy.
+// A RequestAuthentication policy validates the JSON Web Tokens (JWT) of the requests
+// received by one or more backends.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:metadata:labels=app.kubernetes.io/name=flomesh.io
+// +kubebuilder:resource:shortName=requestauthn,scope=Namespaced
+type RequestAuthentication struct {
+	// Object's type metadata
+	metav1.TypeMeta `json:",inline"`
+
+	// Object's metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the RequestAuthentication policy specification
+	// +optional
+	Spec RequestAuthenticationSpec `json:"spec,omitempty"`
+
+	// Status is the status of the RequestAuthentication configuration.
+	// +optional
+	Status RequestAuthenticationStatus `json:"status,omitempty"`
+}
+
+// RequestAuthenticationSpec is the type used to represent the RequestAuthentication policy specification.
+type RequestAuthenticationSpec struct {
+	// Backends defines the list of backends the RequestAuthentication policy applies to.
+	// The policy applies to all the backends in the namespace if not specified.
+	// +optional
+	Backends []RequestAuthenticationBackendSpec `json:"backends,omitempty"`
+
+	// JWTRules defines the list of rules used to validate the JWT of requests,
+	// a request is authenticated if its JWT is validated by any of the rules.
+	// +kubebuilder:validation:MinItems=1
+	JWTRules []JWTRule `json:"jwtRules"`
+
+	// AllowMissingToken defines if requests without JWT are allowed,
+	// requests carrying an invalid JWT are always rejected.
+	// +optional
+	AllowMissingToken bool `json:"allowMissingToken,omitempty"`
+}
+
+// RequestAuthenticationBackendSpec is the type used to represent a Backend specified in the RequestAuthentication policy specification.
+type RequestAuthenticationBackendSpec struct {
+	// Name defines the name of the backend.
+	Name string `json:"name"`
+
+	// Port defines the specification for the backend's port.
+	Port PortSpec `json:"port"`
+}
+
+// JWTRule is the type used to represent how to validate the JWT issued by an issuer.
+type JWTRule struct {
+	// Issuer defines the issuer of the JWT, it must match the `iss` claim.
+	Issuer string `json:"issuer"`
+
+	// Audiences defines the list of accepted audiences, one of them must match the `aud` claim.
+	// Any audience is accepted if not specified.
+	// +optional
+	Audiences []string `json:"audiences,omitempty"`
+
+	// JWKSURI defines the URI of the JSON Web Key Set of the issuer, e.g. the jwks_uri of an OIDC provider.
+	// The key set is fetched and cached by the controller.
+	// +optional
+	JWKSURI string `json:"jwksUri,omitempty"`
+
+	// JWKS defines the inline JSON Web Key Set of the issuer, it takes precedence over JWKSURI.
+	// +optional
+	JWKS string `json:"jwks,omitempty"`
+
+	// FromHeaders defines the list of headers the JWT is extracted from.
+	// Defaults to the Authorization header with the `Bearer ` prefix if neither FromHeaders nor FromParams is specified.
+	// +optional
+	FromHeaders []JWTHeader `json:"fromHeaders,omitempty"`
+
+	// FromParams defines the list of query parameters the JWT is extracted from.
+	// +optional
+	FromParams []string `json:"fromParams,omitempty"`
+
+	// OutputClaimToHeaders defines the list of claims copied to request headers once the JWT is validated.
+	// +optional
+	OutputClaimToHeaders []JWTClaimToHeader `json:"outputClaimToHeaders,omitempty"`
+
+	// ForwardOriginalToken defines if the JWT is kept in the request forwarded to the backend.
+	// +optional
+	ForwardOriginalToken bool `json:"forwardOriginalToken,omitempty"`
+}
+
+// JWTHeader is the type used to represent a header the JWT is extracted from.
+type JWTHeader struct {
+	// Name defines the name of the header.
+	Name string `json:"name"`
+
+	// Prefix defines the prefix stripped from the header value, e.g. `Bearer `.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+}
+
+// JWTClaimToHeader is the type used to represent a claim copied to a request header.
+type JWTClaimToHeader struct {
+	// Header defines the name of the request header.
+	Header string `json:"header"`
+
+	// Claim defines the name of the claim, nested claims are separated by `.`.
+	Claim string `json:"claim"`
+}
+
+// RequestAuthenticationList defines the list of RequestAuthentication objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RequestAuthenticationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []RequestAuthentication `json:"items"`
+}
+
+// RequestAuthenticationStatus is the type used to represent the status of a RequestAuthentication resource.
+type RequestAuthenticationStatus struct {
+	// CurrentStatus defines the current status of a RequestAuthentication resource.
+	// +optional
+	CurrentStatus string `json:"currentStatus,omitempty"`
+
+	// Reason defines the reason for the current status of a RequestAuthentication resource.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
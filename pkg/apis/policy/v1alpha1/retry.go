@@ -54,7 +54,10 @@ type RetryPolicySpec struct {
 	// RetryOn defines the policies to retry on, delimited by comma.
 	RetryOn string `json:"retryOn"`
 
-	// PerTryTimeout defines the time allowed for a retry before it's considered a failed attempt.
+	// PerTryTimeout defines the time allowed for each attempt of a request,
+	// the original attempt included, before it's considered a failed attempt.
+	// A timed out attempt is answered with a 504 response, which is retried
+	// according to RetryOn within NumRetries and the budget.
 	// +optional
 	PerTryTimeout *metav1.Duration `json:"perTryTimeout"`
 
@@ -65,6 +68,70 @@ type RetryPolicySpec struct {
 	// RetryBackoffBaseInterval defines the base interval for exponential retry backoff.
 	// +optional
 	RetryBackoffBaseInterval *metav1.Duration `json:"retryBackoffBaseInterval"`
+
+	// RetryBackoffMaxInterval defines the maximum interval between retries,
+	// the interval is randomly jittered up to the exponential backoff.
+	// Defaults to 10 times RetryBackoffBaseInterval if not specified.
+	// +optional
+	RetryBackoffMaxInterval *metav1.Duration `json:"retryBackoffMaxInterval,omitempty"`
+
+	// RetriableStatusCodes defines the response status codes retried in
+	// addition to the ones specified by RetryOn, between 100 and 599.
+	// +kubebuilder:validation:items:Minimum=100
+	// +kubebuilder:validation:items:Maximum=599
+	// +optional
+	RetriableStatusCodes []uint32 `json:"retriableStatusCodes,omitempty"`
+
+	// RetriableHeaders defines the names of the response headers causing
+	// a retry when present in the response.
+	// +optional
+	RetriableHeaders []string `json:"retriableHeaders,omitempty"`
+
+	// Budget defines the limit on the retries of the requests to the
+	// destinations, preventing retries from amplifying the load.
+	// +optional
+	Budget *RetryBudgetSpec `json:"budget,omitempty"`
+
+	// Hedging defines the hedging of the idempotent requests to the
+	// destinations, the hedged attempts being counted against the budget.
+	// +optional
+	Hedging *HedgingSpec `json:"hedging,omitempty"`
+}
+
+// RetryBudgetSpec is the type used to represent the budget of the retries.
+// A retry is allowed while the ratio of the active retries to the active
+// requests is within BudgetPercent, or while the number of retries in the
+// last second is below MinRetriesPerSecond.
+type RetryBudgetSpec struct {
+	// BudgetPercent defines the maximum percentage of the active requests
+	// that can be retries.
+	// Defaults to 20 if not specified.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	BudgetPercent *uint32 `json:"budgetPercent,omitempty"`
+
+	// MinRetriesPerSecond defines the number of retries per second
+	// allowed regardless of BudgetPercent.
+	// Defaults to 10 if not specified.
+	// +optional
+	MinRetriesPerSecond *uint32 `json:"minRetriesPerSecond,omitempty"`
+}
+
+// HedgingSpec is the type used to represent the hedging of idempotent requests.
+// A GET, HEAD or OPTIONS request getting no response within Delay is also sent
+// to another endpoint while the pending attempts go on, the first response
+// being used. Each hedged attempt is allowed only within the retry budget.
+type HedgingSpec struct {
+	// Delay defines the time to wait for a response before hedging a request.
+	Delay metav1.Duration `json:"delay"`
+
+	// MaxAttempts defines the maximum number of concurrent attempts of a
+	// request, the original attempt included.
+	// Defaults to 2 if not specified.
+	// +kubebuilder:validation:Minimum=2
+	// +optional
+	MaxAttempts *uint32 `json:"maxAttempts,omitempty"`
 }
 
 // RetryList defines the list of Retry objects.
@@ -0,0 +1,87 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SidecarScope is the type used to represent a SidecarScope policy.
+// A SidecarScope policy limits the outbound configuration programmed on the sidecars
+// of the selected workloads to the destinations they are expected to reach.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:metadata:labels=app.kubernetes.io/name=flomesh.io
+// +kubebuilder:resource:shortName=sidecarscope,scope=Namespaced
+type SidecarScope struct {
+	// Object's type metadata
+	metav1.TypeMeta `json:",inline"`
+
+	// Object's metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the SidecarScope policy specification
+	// +optional
+	Spec SidecarScopeSpec `json:"spec,omitempty"`
+
+	// Status is the status of the SidecarScope configuration.
+	// +optional
+	Status SidecarScopeStatus `json:"status,omitempty"`
+}
+
+// SidecarScopeSpec is the type used to represent the SidecarScope policy specification.
+// Services in the namespace of the SidecarScope are always reachable, other services
+// are reachable only if they match any of Namespaces, Services or Hosts.
+type SidecarScopeSpec struct {
+	// WorkloadSelector defines the labels of the pods the SidecarScope policy applies to.
+	// The policy applies to all the pods in the namespace if not specified, a policy
+	// with a matching selector takes precedence over a namespace wide policy.
+	// +optional
+	WorkloadSelector *metav1.LabelSelector `json:"workloadSelector,omitempty"`
+
+	// Namespaces defines the list of namespaces whose services are reachable,
+	// "*" matches all the namespaces.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// Services defines the list of services that are reachable.
+	// +optional
+	Services []SidecarScopeServiceSpec `json:"services,omitempty"`
+
+	// Hosts defines the list of hostnames of the services that are reachable,
+	// a hostname can be the fully qualified name of a service such as
+	// `bookstore.bookstore.svc.cluster.local` or begin with a wildcard such
+	// as `*.bookstore.svc.cluster.local`.
+	// +optional
+	Hosts []string `json:"hosts,omitempty"`
+}
+
+// SidecarScopeServiceSpec is the type used to represent a service specified in the SidecarScope policy specification.
+type SidecarScopeServiceSpec struct {
+	// Name defines the name of the service.
+	Name string `json:"name"`
+
+	// Namespace defines the namespace of the service.
+	// Defaults to the namespace of the SidecarScope policy if not specified.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// SidecarScopeStatus is the type used to represent the status of a SidecarScope resource.
+type SidecarScopeStatus struct {
+	// CurrentStatus defines the current status of a SidecarScope resource.
+	// +optional
+	CurrentStatus string `json:"currentStatus,omitempty"`
+
+	// Reason defines the reason for the current status of a SidecarScope resource.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// SidecarScopeList defines the list of SidecarScope objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type SidecarScopeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []SidecarScope `json:"items"`
+}
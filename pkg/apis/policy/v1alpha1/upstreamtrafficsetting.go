@@ -54,6 +54,56 @@ type UpstreamTrafficSettingSpec struct {
 	// route level.
 	// +optional
 	HTTPRoutes []HTTPRouteSpec `json:"httpRoutes,omitempty"`
+
+	// Mirror specifies the mirroring of the HTTP requests directed
+	// to the upstream host, applied by the downstream clients to
+	// all the routes of the upstream host that do not specify their
+	// own mirroring.
+	// +optional
+	Mirror *HTTPMirrorSpec `json:"mirror,omitempty"`
+
+	// OutlierDetection specifies the passive ejection of the endpoints
+	// of the upstream host that keep failing, applied by the downstream
+	// clients to their HTTP requests directed to the upstream host.
+	// +optional
+	OutlierDetection *OutlierDetectionSpec `json:"outlierDetection,omitempty"`
+}
+
+// OutlierDetectionSpec defines the ejection of the failing endpoints of an upstream host.
+type OutlierDetectionSpec struct {
+	// Consecutive5xxErrors specifies the number of consecutive 5xx responses,
+	// connection failures included, after which an endpoint is ejected.
+	// Defaults to 5 if neither Consecutive5xxErrors nor ConsecutiveGatewayErrors
+	// is specified, 0 disables the ejection on 5xx responses.
+	// +optional
+	Consecutive5xxErrors *uint32 `json:"consecutive5xxErrors,omitempty"`
+
+	// ConsecutiveGatewayErrors specifies the number of consecutive 502, 503
+	// and 504 responses, connection failures included, after which an endpoint
+	// is ejected. 0 disables the ejection on gateway errors.
+	// +optional
+	ConsecutiveGatewayErrors *uint32 `json:"consecutiveGatewayErrors,omitempty"`
+
+	// BaseEjectionTime specifies the duration of the first ejection of an
+	// endpoint, the duration of the following ejections is multiplied by the
+	// number of times the endpoint was ejected in a row.
+	// Defaults to 30s if not specified.
+	// +optional
+	BaseEjectionTime *metav1.Duration `json:"baseEjectionTime,omitempty"`
+
+	// MaxEjectionTime specifies the maximum duration of an ejection.
+	// Defaults to 300s if not specified.
+	// +optional
+	MaxEjectionTime *metav1.Duration `json:"maxEjectionTime,omitempty"`
+
+	// MaxEjectionPercent specifies the maximum percentage of the endpoints
+	// of the upstream host that can be ejected at the same time, at least
+	// one endpoint can be ejected unless it is 0.
+	// Defaults to 10 if not specified.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxEjectionPercent *uint32 `json:"maxEjectionPercent,omitempty"`
 }
 
 // ConnectionSettingsSpec defines the connection settings for an
@@ -247,6 +297,64 @@ type HTTPRouteSpec struct {
 	// RateLimit defines the HTTP rate limiting specification for
 	// the specified HTTP route.
 	RateLimit *HTTPPerRouteRateLimitSpec `json:"rateLimit,omitempty"`
+
+	// Mirror defines the mirroring of the HTTP requests matching
+	// the specified HTTP route.
+	// +optional
+	Mirror *HTTPMirrorSpec `json:"mirror,omitempty"`
+
+	// Timeouts defines the timeouts applied to the HTTP requests
+	// matching the specified HTTP route.
+	// +optional
+	Timeouts *HTTPRouteTimeoutsSpec `json:"timeouts,omitempty"`
+}
+
+// HTTPRouteTimeoutsSpec defines the timeouts of the requests matching an HTTP route.
+// A zero duration disables the corresponding timeout.
+type HTTPRouteTimeoutsSpec struct {
+	// Request specifies the maximum duration of a request, from the moment
+	// the request is received until the response starts being received,
+	// retries included. A 504 response is returned when it is exceeded.
+	// +optional
+	Request *metav1.Duration `json:"request,omitempty"`
+
+	// Idle specifies the maximum duration a connection to the upstream host
+	// may remain idle between requests.
+	// +optional
+	Idle *metav1.Duration `json:"idle,omitempty"`
+
+	// StreamDuration specifies the maximum duration of a gRPC call, it applies
+	// in place of Request to the gRPC requests. A shorter deadline set by the
+	// client with the grpc-timeout header takes precedence.
+	// +optional
+	StreamDuration *metav1.Duration `json:"streamDuration,omitempty"`
+}
+
+// HTTPMirrorSpec defines the mirroring of HTTP requests to another service.
+// Mirrored requests are sent fire-and-forget: their responses are discarded,
+// and they neither delay nor fail the original requests.
+type HTTPMirrorSpec struct {
+	// Service is the service the requests are mirrored to.
+	// Must either be the name of a service in the namespace of the
+	// UpstreamTrafficSetting rule, or <namespace>/<name>.
+	Service string `json:"service"`
+
+	// Port is the port of the service the requests are mirrored to.
+	// Defaults to the port of the upstream host.
+	// +optional
+	Port *uint16 `json:"port,omitempty"`
+
+	// Percent defines the percentage of requests mirrored.
+	// Defaults to 100 if not specified.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Percent *uint32 `json:"percent,omitempty"`
+
+	// RequestHeadersToAdd defines the list of HTTP headers added
+	// to the mirrored requests, e.g. to tag them as shadow traffic.
+	// +optional
+	RequestHeadersToAdd []HTTPHeaderValue `json:"requestHeadersToAdd,omitempty"`
 }
 
 // HTTPPerRouteRateLimitSpec defines the rate limiting specification
@@ -22,6 +22,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -129,7 +130,7 @@ func (in *AccessControl) DeepCopyInto(out *AccessControl) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -260,6 +261,13 @@ func (in *AccessControlSpec) DeepCopy() *AccessControlSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessControlStatus) DeepCopyInto(out *AccessControlStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -273,6 +281,231 @@ func (in *AccessControlStatus) DeepCopy() *AccessControlStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationBackendSpec) DeepCopyInto(out *AuthorizationBackendSpec) {
+	*out = *in
+	out.Port = in.Port
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationBackendSpec.
+func (in *AuthorizationBackendSpec) DeepCopy() *AuthorizationBackendSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationBackendSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationClaimMatch) DeepCopyInto(out *AuthorizationClaimMatch) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationClaimMatch.
+func (in *AuthorizationClaimMatch) DeepCopy() *AuthorizationClaimMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationClaimMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationHeaderMatch) DeepCopyInto(out *AuthorizationHeaderMatch) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationHeaderMatch.
+func (in *AuthorizationHeaderMatch) DeepCopy() *AuthorizationHeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationHeaderMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationPolicy) DeepCopyInto(out *AuthorizationPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationPolicy.
+func (in *AuthorizationPolicy) DeepCopy() *AuthorizationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AuthorizationPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationPolicyList) DeepCopyInto(out *AuthorizationPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AuthorizationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationPolicyList.
+func (in *AuthorizationPolicyList) DeepCopy() *AuthorizationPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AuthorizationPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationPolicySpec) DeepCopyInto(out *AuthorizationPolicySpec) {
+	*out = *in
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]AuthorizationBackendSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AuthorizationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationPolicySpec.
+func (in *AuthorizationPolicySpec) DeepCopy() *AuthorizationPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationPolicyStatus) DeepCopyInto(out *AuthorizationPolicyStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationPolicyStatus.
+func (in *AuthorizationPolicyStatus) DeepCopy() *AuthorizationPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationRule) DeepCopyInto(out *AuthorizationRule) {
+	*out = *in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]AuthorizationSource, len(*in))
+		copy(*out, *in)
+	}
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]AuthorizationHeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Claims != nil {
+		in, out := &in.Claims, &out.Claims
+		*out = make([]AuthorizationClaimMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationRule.
+func (in *AuthorizationRule) DeepCopy() *AuthorizationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationSource) DeepCopyInto(out *AuthorizationSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationSource.
+func (in *AuthorizationSource) DeepCopy() *AuthorizationSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackendSpec) DeepCopyInto(out *BackendSpec) {
 	*out = *in
@@ -391,6 +624,37 @@ func (in *EgressGateway) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressGatewayHostRule) DeepCopyInto(out *EgressGatewayHostRule) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(EgressGatewayTLSOrigination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpstreamProxy != nil {
+		in, out := &in.UpstreamProxy, &out.UpstreamProxy
+		*out = new(EgressGatewayUpstreamProxy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressGatewayHostRule.
+func (in *EgressGatewayHostRule) DeepCopy() *EgressGatewayHostRule {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressGatewayHostRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressGatewayList) DeepCopyInto(out *EgressGatewayList) {
 	*out = *in
@@ -434,6 +698,13 @@ func (in *EgressGatewaySpec) DeepCopyInto(out *EgressGatewaySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]EgressGatewayHostRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -447,6 +718,53 @@ func (in *EgressGatewaySpec) DeepCopy() *EgressGatewaySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressGatewayTLSOrigination) DeepCopyInto(out *EgressGatewayTLSOrigination) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CACertificate != nil {
+		in, out := &in.CACertificate, &out.CACertificate
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressGatewayTLSOrigination.
+func (in *EgressGatewayTLSOrigination) DeepCopy() *EgressGatewayTLSOrigination {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressGatewayTLSOrigination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressGatewayUpstreamProxy) DeepCopyInto(out *EgressGatewayUpstreamProxy) {
+	*out = *in
+	if in.CredentialsSecret != nil {
+		in, out := &in.CredentialsSecret, &out.CredentialsSecret
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressGatewayUpstreamProxy.
+func (in *EgressGatewayUpstreamProxy) DeepCopy() *EgressGatewayUpstreamProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressGatewayUpstreamProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressList) DeepCopyInto(out *EgressList) {
 	*out = *in
@@ -618,37 +936,318 @@ func (in *EgressSpec) DeepCopy() *EgressSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GatewayBindingSubject) DeepCopyInto(out *GatewayBindingSubject) {
+func (in *FLBLimits) DeepCopyInto(out *FLBLimits) {
 	*out = *in
-	if in.Weight != nil {
-		in, out := &in.Weight, &out.Weight
-		*out = new(int)
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SyncRate != nil {
+		in, out := &in.SyncRate, &out.SyncRate
+		*out = new(int32)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayBindingSubject.
-func (in *GatewayBindingSubject) DeepCopy() *GatewayBindingSubject {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FLBLimits.
+func (in *FLBLimits) DeepCopy() *FLBLimits {
 	if in == nil {
 		return nil
 	}
-	out := new(GatewayBindingSubject)
+	out := new(FLBLimits)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HTTPCircuitBreaking) DeepCopyInto(out *HTTPCircuitBreaking) {
+func (in *FLBPolicy) DeepCopyInto(out *FLBPolicy) {
 	*out = *in
-	if in.StatTimeWindow != nil {
-		in, out := &in.StatTimeWindow, &out.StatTimeWindow
-		*out = new(metav1.Duration)
-		**out = **in
-	}
-	if in.DegradedTimeWindow != nil {
-		in, out := &in.DegradedTimeWindow, &out.DegradedTimeWindow
-		*out = new(metav1.Duration)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FLBPolicy.
+func (in *FLBPolicy) DeepCopy() *FLBPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FLBPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FLBPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FLBPolicyList) DeepCopyInto(out *FLBPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FLBPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FLBPolicyList.
+func (in *FLBPolicyList) DeepCopy() *FLBPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(FLBPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FLBPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FLBPolicySpec) DeepCopyInto(out *FLBPolicySpec) {
+	*out = *in
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.AddressPool != nil {
+		in, out := &in.AddressPool, &out.AddressPool
+		*out = new(string)
+		**out = **in
+	}
+	if in.DesiredIP != nil {
+		in, out := &in.DesiredIP, &out.DesiredIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.Algorithm != nil {
+		in, out := &in.Algorithm, &out.Algorithm
+		*out = new(FLBAlgorithm)
+		**out = **in
+	}
+	if in.XForwardedFor != nil {
+		in, out := &in.XForwardedFor, &out.XForwardedFor
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SessionSticky != nil {
+		in, out := &in.SessionSticky, &out.SessionSticky
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(FLBLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeouts != nil {
+		in, out := &in.Timeouts, &out.Timeouts
+		*out = new(FLBTimeouts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(FLBTLSConfig)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]FLBPortTags, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FLBPolicySpec.
+func (in *FLBPolicySpec) DeepCopy() *FLBPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FLBPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FLBPolicyStatus) DeepCopyInto(out *FLBPolicyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]FLBServiceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FLBPolicyStatus.
+func (in *FLBPolicyStatus) DeepCopy() *FLBPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FLBPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FLBPortTags) DeepCopyInto(out *FLBPortTags) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FLBPortTags.
+func (in *FLBPortTags) DeepCopy() *FLBPortTags {
+	if in == nil {
+		return nil
+	}
+	out := new(FLBPortTags)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FLBServiceStatus) DeepCopyInto(out *FLBServiceStatus) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FLBServiceStatus.
+func (in *FLBServiceStatus) DeepCopy() *FLBServiceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FLBServiceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FLBTLSConfig) DeepCopyInto(out *FLBTLSConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FLBTLSConfig.
+func (in *FLBTLSConfig) DeepCopy() *FLBTLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FLBTLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FLBTimeouts) DeepCopyInto(out *FLBTimeouts) {
+	*out = *in
+	if in.Read != nil {
+		in, out := &in.Read, &out.Read
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Write != nil {
+		in, out := &in.Write, &out.Write
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Idle != nil {
+		in, out := &in.Idle, &out.Idle
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FLBTimeouts.
+func (in *FLBTimeouts) DeepCopy() *FLBTimeouts {
+	if in == nil {
+		return nil
+	}
+	out := new(FLBTimeouts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayBindingSubject) DeepCopyInto(out *GatewayBindingSubject) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayBindingSubject.
+func (in *GatewayBindingSubject) DeepCopy() *GatewayBindingSubject {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayBindingSubject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPCircuitBreaking) DeepCopyInto(out *HTTPCircuitBreaking) {
+	*out = *in
+	if in.StatTimeWindow != nil {
+		in, out := &in.StatTimeWindow, &out.StatTimeWindow
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.DegradedTimeWindow != nil {
+		in, out := &in.DegradedTimeWindow, &out.DegradedTimeWindow
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 	if in.SlowTimeThreshold != nil {
@@ -777,6 +1376,37 @@ func (in *HTTPLocalRateLimitSpec) DeepCopy() *HTTPLocalRateLimitSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPMirrorSpec) DeepCopyInto(out *HTTPMirrorSpec) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(uint16)
+		**out = **in
+	}
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RequestHeadersToAdd != nil {
+		in, out := &in.RequestHeadersToAdd, &out.RequestHeadersToAdd
+		*out = make([]HTTPHeaderValue, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPMirrorSpec.
+func (in *HTTPMirrorSpec) DeepCopy() *HTTPMirrorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPMirrorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPPerRouteRateLimitSpec) DeepCopyInto(out *HTTPPerRouteRateLimitSpec) {
 	*out = *in
@@ -806,21 +1436,676 @@ func (in *HTTPRouteSpec) DeepCopyInto(out *HTTPRouteSpec) {
 		*out = new(HTTPPerRouteRateLimitSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Mirror != nil {
+		in, out := &in.Mirror, &out.Mirror
+		*out = new(HTTPMirrorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeouts != nil {
+		in, out := &in.Timeouts, &out.Timeouts
+		*out = new(HTTPRouteTimeoutsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteSpec.
+func (in *HTTPRouteSpec) DeepCopy() *HTTPRouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRouteTimeoutsSpec) DeepCopyInto(out *HTTPRouteTimeoutsSpec) {
+	*out = *in
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Idle != nil {
+		in, out := &in.Idle, &out.Idle
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.StreamDuration != nil {
+		in, out := &in.StreamDuration, &out.StreamDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteTimeoutsSpec.
+func (in *HTTPRouteTimeoutsSpec) DeepCopy() *HTTPRouteTimeoutsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRouteTimeoutsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HedgingSpec) DeepCopyInto(out *HedgingSpec) {
+	*out = *in
+	out.Delay = in.Delay
+	if in.MaxAttempts != nil {
+		in, out := &in.MaxAttempts, &out.MaxAttempts
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HedgingSpec.
+func (in *HedgingSpec) DeepCopy() *HedgingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HedgingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressBackend) DeepCopyInto(out *IngressBackend) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressBackend.
+func (in *IngressBackend) DeepCopy() *IngressBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IngressBackend) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressBackendList) DeepCopyInto(out *IngressBackendList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IngressBackend, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressBackendList.
+func (in *IngressBackendList) DeepCopy() *IngressBackendList {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressBackendList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IngressBackendList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressBackendSpec) DeepCopyInto(out *IngressBackendSpec) {
+	*out = *in
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]BackendSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]IngressSourceSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Matches != nil {
+		in, out := &in.Matches, &out.Matches
+		*out = make([]v1.TypedLocalObjectReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressBackendSpec.
+func (in *IngressBackendSpec) DeepCopy() *IngressBackendSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressBackendSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressBackendStatus) DeepCopyInto(out *IngressBackendStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressBackendStatus.
+func (in *IngressBackendStatus) DeepCopy() *IngressBackendStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressBackendStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressSourceSpec) DeepCopyInto(out *IngressSourceSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressSourceSpec.
+func (in *IngressSourceSpec) DeepCopy() *IngressSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Isolation) DeepCopyInto(out *Isolation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Isolation.
+func (in *Isolation) DeepCopy() *Isolation {
+	if in == nil {
+		return nil
+	}
+	out := new(Isolation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Isolation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IsolationList) DeepCopyInto(out *IsolationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Isolation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IsolationList.
+func (in *IsolationList) DeepCopy() *IsolationList {
+	if in == nil {
+		return nil
+	}
+	out := new(IsolationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IsolationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IsolationSpec) DeepCopyInto(out *IsolationSpec) {
+	*out = *in
+	if in.CIDR != nil {
+		in, out := &in.CIDR, &out.CIDR
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IsolationSpec.
+func (in *IsolationSpec) DeepCopy() *IsolationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IsolationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IsolationStatus) DeepCopyInto(out *IsolationStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IsolationStatus.
+func (in *IsolationStatus) DeepCopy() *IsolationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IsolationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTClaimToHeader) DeepCopyInto(out *JWTClaimToHeader) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTClaimToHeader.
+func (in *JWTClaimToHeader) DeepCopy() *JWTClaimToHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTClaimToHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTHeader) DeepCopyInto(out *JWTHeader) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTHeader.
+func (in *JWTHeader) DeepCopy() *JWTHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTRule) DeepCopyInto(out *JWTRule) {
+	*out = *in
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FromHeaders != nil {
+		in, out := &in.FromHeaders, &out.FromHeaders
+		*out = make([]JWTHeader, len(*in))
+		copy(*out, *in)
+	}
+	if in.FromParams != nil {
+		in, out := &in.FromParams, &out.FromParams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OutputClaimToHeaders != nil {
+		in, out := &in.OutputClaimToHeaders, &out.OutputClaimToHeaders
+		*out = make([]JWTClaimToHeader, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTRule.
+func (in *JWTRule) DeepCopy() *JWTRule {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalRateLimitSpec) DeepCopyInto(out *LocalRateLimitSpec) {
+	*out = *in
+	if in.TCP != nil {
+		in, out := &in.TCP, &out.TCP
+		*out = new(TCPLocalRateLimitSpec)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPLocalRateLimitSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalRateLimitSpec.
+func (in *LocalRateLimitSpec) DeepCopy() *LocalRateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalRateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshFaultAbortSpec) DeepCopyInto(out *MeshFaultAbortSpec) {
+	*out = *in
+	if in.GRPCStatus != nil {
+		in, out := &in.GRPCStatus, &out.GRPCStatus
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshFaultAbortSpec.
+func (in *MeshFaultAbortSpec) DeepCopy() *MeshFaultAbortSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshFaultAbortSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshFaultDelaySpec) DeepCopyInto(out *MeshFaultDelaySpec) {
+	*out = *in
+	out.FixedDelay = in.FixedDelay
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshFaultDelaySpec.
+func (in *MeshFaultDelaySpec) DeepCopy() *MeshFaultDelaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshFaultDelaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshFaultInjection) DeepCopyInto(out *MeshFaultInjection) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshFaultInjection.
+func (in *MeshFaultInjection) DeepCopy() *MeshFaultInjection {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshFaultInjection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshFaultInjection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshFaultInjectionDestinationSpec) DeepCopyInto(out *MeshFaultInjectionDestinationSpec) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(uint16)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshFaultInjectionDestinationSpec.
+func (in *MeshFaultInjectionDestinationSpec) DeepCopy() *MeshFaultInjectionDestinationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshFaultInjectionDestinationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshFaultInjectionList) DeepCopyInto(out *MeshFaultInjectionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MeshFaultInjection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshFaultInjectionList.
+func (in *MeshFaultInjectionList) DeepCopy() *MeshFaultInjectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshFaultInjectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshFaultInjectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshFaultInjectionSourcesSpec) DeepCopyInto(out *MeshFaultInjectionSourcesSpec) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshFaultInjectionSourcesSpec.
+func (in *MeshFaultInjectionSourcesSpec) DeepCopy() *MeshFaultInjectionSourcesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshFaultInjectionSourcesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshFaultInjectionSpec) DeepCopyInto(out *MeshFaultInjectionSpec) {
+	*out = *in
+	in.Destination.DeepCopyInto(&out.Destination)
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = new(MeshFaultInjectionSourcesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(MeshFaultDelaySpec)
+		**out = **in
+	}
+	if in.Abort != nil {
+		in, out := &in.Abort, &out.Abort
+		*out = new(MeshFaultAbortSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshFaultInjectionSpec.
+func (in *MeshFaultInjectionSpec) DeepCopy() *MeshFaultInjectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshFaultInjectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshFaultInjectionStatus) DeepCopyInto(out *MeshFaultInjectionStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshFaultInjectionStatus.
+func (in *MeshFaultInjectionStatus) DeepCopy() *MeshFaultInjectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshFaultInjectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutlierDetectionSpec) DeepCopyInto(out *OutlierDetectionSpec) {
+	*out = *in
+	if in.Consecutive5xxErrors != nil {
+		in, out := &in.Consecutive5xxErrors, &out.Consecutive5xxErrors
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ConsecutiveGatewayErrors != nil {
+		in, out := &in.ConsecutiveGatewayErrors, &out.ConsecutiveGatewayErrors
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.BaseEjectionTime != nil {
+		in, out := &in.BaseEjectionTime, &out.BaseEjectionTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxEjectionTime != nil {
+		in, out := &in.MaxEjectionTime, &out.MaxEjectionTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxEjectionPercent != nil {
+		in, out := &in.MaxEjectionPercent, &out.MaxEjectionPercent
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutlierDetectionSpec.
+func (in *OutlierDetectionSpec) DeepCopy() *OutlierDetectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OutlierDetectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortSpec) DeepCopyInto(out *PortSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortSpec.
+func (in *PortSpec) DeepCopy() *PortSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PortSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitSpec) DeepCopyInto(out *RateLimitSpec) {
+	*out = *in
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(LocalRateLimitSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteSpec.
-func (in *HTTPRouteSpec) DeepCopy() *HTTPRouteSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitSpec.
+func (in *RateLimitSpec) DeepCopy() *RateLimitSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HTTPRouteSpec)
+	out := new(RateLimitSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressBackend) DeepCopyInto(out *IngressBackend) {
+func (in *RequestAuthentication) DeepCopyInto(out *RequestAuthentication) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -829,18 +2114,18 @@ func (in *IngressBackend) DeepCopyInto(out *IngressBackend) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressBackend.
-func (in *IngressBackend) DeepCopy() *IngressBackend {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestAuthentication.
+func (in *RequestAuthentication) DeepCopy() *RequestAuthentication {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressBackend)
+	out := new(RequestAuthentication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IngressBackend) DeepCopyObject() runtime.Object {
+func (in *RequestAuthentication) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -848,13 +2133,30 @@ func (in *IngressBackend) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressBackendList) DeepCopyInto(out *IngressBackendList) {
+func (in *RequestAuthenticationBackendSpec) DeepCopyInto(out *RequestAuthenticationBackendSpec) {
+	*out = *in
+	out.Port = in.Port
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestAuthenticationBackendSpec.
+func (in *RequestAuthenticationBackendSpec) DeepCopy() *RequestAuthenticationBackendSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestAuthenticationBackendSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestAuthenticationList) DeepCopyInto(out *RequestAuthenticationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]IngressBackend, len(*in))
+		*out = make([]RequestAuthentication, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -862,18 +2164,18 @@ func (in *IngressBackendList) DeepCopyInto(out *IngressBackendList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressBackendList.
-func (in *IngressBackendList) DeepCopy() *IngressBackendList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestAuthenticationList.
+func (in *RequestAuthenticationList) DeepCopy() *RequestAuthenticationList {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressBackendList)
+	out := new(RequestAuthenticationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IngressBackendList) DeepCopyObject() runtime.Object {
+func (in *RequestAuthenticationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -881,23 +2183,16 @@ func (in *IngressBackendList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressBackendSpec) DeepCopyInto(out *IngressBackendSpec) {
+func (in *RequestAuthenticationSpec) DeepCopyInto(out *RequestAuthenticationSpec) {
 	*out = *in
 	if in.Backends != nil {
 		in, out := &in.Backends, &out.Backends
-		*out = make([]BackendSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Sources != nil {
-		in, out := &in.Sources, &out.Sources
-		*out = make([]IngressSourceSpec, len(*in))
+		*out = make([]RequestAuthenticationBackendSpec, len(*in))
 		copy(*out, *in)
 	}
-	if in.Matches != nil {
-		in, out := &in.Matches, &out.Matches
-		*out = make([]v1.TypedLocalObjectReference, len(*in))
+	if in.JWTRules != nil {
+		in, out := &in.JWTRules, &out.JWTRules
+		*out = make([]JWTRule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -905,84 +2200,93 @@ func (in *IngressBackendSpec) DeepCopyInto(out *IngressBackendSpec) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressBackendSpec.
-func (in *IngressBackendSpec) DeepCopy() *IngressBackendSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestAuthenticationSpec.
+func (in *RequestAuthenticationSpec) DeepCopy() *RequestAuthenticationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressBackendSpec)
+	out := new(RequestAuthenticationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressBackendStatus) DeepCopyInto(out *IngressBackendStatus) {
+func (in *RequestAuthenticationStatus) DeepCopyInto(out *RequestAuthenticationStatus) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressBackendStatus.
-func (in *IngressBackendStatus) DeepCopy() *IngressBackendStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestAuthenticationStatus.
+func (in *RequestAuthenticationStatus) DeepCopy() *RequestAuthenticationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressBackendStatus)
+	out := new(RequestAuthenticationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressSourceSpec) DeepCopyInto(out *IngressSourceSpec) {
+func (in *Retry) DeepCopyInto(out *Retry) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressSourceSpec.
-func (in *IngressSourceSpec) DeepCopy() *IngressSourceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Retry.
+func (in *Retry) DeepCopy() *Retry {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressSourceSpec)
+	out := new(Retry)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Retry) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Isolation) DeepCopyInto(out *Isolation) {
+func (in *RetryBudgetSpec) DeepCopyInto(out *RetryBudgetSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	if in.BudgetPercent != nil {
+		in, out := &in.BudgetPercent, &out.BudgetPercent
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MinRetriesPerSecond != nil {
+		in, out := &in.MinRetriesPerSecond, &out.MinRetriesPerSecond
+		*out = new(uint32)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Isolation.
-func (in *Isolation) DeepCopy() *Isolation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryBudgetSpec.
+func (in *RetryBudgetSpec) DeepCopy() *RetryBudgetSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Isolation)
+	out := new(RetryBudgetSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Isolation) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IsolationList) DeepCopyInto(out *IsolationList) {
+func (in *RetryList) DeepCopyInto(out *RetryList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Isolation, len(*in))
+		*out = make([]Retry, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -990,18 +2294,18 @@ func (in *IsolationList) DeepCopyInto(out *IsolationList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IsolationList.
-func (in *IsolationList) DeepCopy() *IsolationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryList.
+func (in *RetryList) DeepCopy() *RetryList {
 	if in == nil {
 		return nil
 	}
-	out := new(IsolationList)
+	out := new(RetryList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IsolationList) DeepCopyObject() runtime.Object {
+func (in *RetryList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1009,126 +2313,122 @@ func (in *IsolationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IsolationSpec) DeepCopyInto(out *IsolationSpec) {
+func (in *RetryPolicySpec) DeepCopyInto(out *RetryPolicySpec) {
 	*out = *in
-	if in.CIDR != nil {
-		in, out := &in.CIDR, &out.CIDR
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.PerTryTimeout != nil {
+		in, out := &in.PerTryTimeout, &out.PerTryTimeout
+		*out = new(metav1.Duration)
+		**out = **in
 	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IsolationSpec.
-func (in *IsolationSpec) DeepCopy() *IsolationSpec {
-	if in == nil {
-		return nil
+	if in.NumRetries != nil {
+		in, out := &in.NumRetries, &out.NumRetries
+		*out = new(uint32)
+		**out = **in
 	}
-	out := new(IsolationSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IsolationStatus) DeepCopyInto(out *IsolationStatus) {
-	*out = *in
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IsolationStatus.
-func (in *IsolationStatus) DeepCopy() *IsolationStatus {
-	if in == nil {
-		return nil
+	if in.RetryBackoffBaseInterval != nil {
+		in, out := &in.RetryBackoffBaseInterval, &out.RetryBackoffBaseInterval
+		*out = new(metav1.Duration)
+		**out = **in
 	}
-	out := new(IsolationStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LocalRateLimitSpec) DeepCopyInto(out *LocalRateLimitSpec) {
-	*out = *in
-	if in.TCP != nil {
-		in, out := &in.TCP, &out.TCP
-		*out = new(TCPLocalRateLimitSpec)
+	if in.RetryBackoffMaxInterval != nil {
+		in, out := &in.RetryBackoffMaxInterval, &out.RetryBackoffMaxInterval
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.HTTP != nil {
-		in, out := &in.HTTP, &out.HTTP
-		*out = new(HTTPLocalRateLimitSpec)
+	if in.RetriableStatusCodes != nil {
+		in, out := &in.RetriableStatusCodes, &out.RetriableStatusCodes
+		*out = make([]uint32, len(*in))
+		copy(*out, *in)
+	}
+	if in.RetriableHeaders != nil {
+		in, out := &in.RetriableHeaders, &out.RetriableHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(RetryBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hedging != nil {
+		in, out := &in.Hedging, &out.Hedging
+		*out = new(HedgingSpec)
 		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalRateLimitSpec.
-func (in *LocalRateLimitSpec) DeepCopy() *LocalRateLimitSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicySpec.
+func (in *RetryPolicySpec) DeepCopy() *RetryPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LocalRateLimitSpec)
+	out := new(RetryPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PortSpec) DeepCopyInto(out *PortSpec) {
+func (in *RetrySpec) DeepCopyInto(out *RetrySpec) {
 	*out = *in
+	out.Source = in.Source
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make([]RetrySrcDstSpec, len(*in))
+		copy(*out, *in)
+	}
+	in.RetryPolicy.DeepCopyInto(&out.RetryPolicy)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortSpec.
-func (in *PortSpec) DeepCopy() *PortSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetrySpec.
+func (in *RetrySpec) DeepCopy() *RetrySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PortSpec)
+	out := new(RetrySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RateLimitSpec) DeepCopyInto(out *RateLimitSpec) {
+func (in *RetrySrcDstSpec) DeepCopyInto(out *RetrySrcDstSpec) {
 	*out = *in
-	if in.Local != nil {
-		in, out := &in.Local, &out.Local
-		*out = new(LocalRateLimitSpec)
-		(*in).DeepCopyInto(*out)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitSpec.
-func (in *RateLimitSpec) DeepCopy() *RateLimitSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetrySrcDstSpec.
+func (in *RetrySrcDstSpec) DeepCopy() *RetrySrcDstSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RateLimitSpec)
+	out := new(RetrySrcDstSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Retry) DeepCopyInto(out *Retry) {
+func (in *SidecarScope) DeepCopyInto(out *SidecarScope) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Retry.
-func (in *Retry) DeepCopy() *Retry {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarScope.
+func (in *SidecarScope) DeepCopy() *SidecarScope {
 	if in == nil {
 		return nil
 	}
-	out := new(Retry)
+	out := new(SidecarScope)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Retry) DeepCopyObject() runtime.Object {
+func (in *SidecarScope) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1136,13 +2436,13 @@ func (in *Retry) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RetryList) DeepCopyInto(out *RetryList) {
+func (in *SidecarScopeList) DeepCopyInto(out *SidecarScopeList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Retry, len(*in))
+		*out = make([]SidecarScope, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1150,18 +2450,18 @@ func (in *RetryList) DeepCopyInto(out *RetryList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryList.
-func (in *RetryList) DeepCopy() *RetryList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarScopeList.
+func (in *SidecarScopeList) DeepCopy() *SidecarScopeList {
 	if in == nil {
 		return nil
 	}
-	out := new(RetryList)
+	out := new(SidecarScopeList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RetryList) DeepCopyObject() runtime.Object {
+func (in *SidecarScopeList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1169,71 +2469,69 @@ func (in *RetryList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RetryPolicySpec) DeepCopyInto(out *RetryPolicySpec) {
+func (in *SidecarScopeServiceSpec) DeepCopyInto(out *SidecarScopeServiceSpec) {
 	*out = *in
-	if in.PerTryTimeout != nil {
-		in, out := &in.PerTryTimeout, &out.PerTryTimeout
-		*out = new(metav1.Duration)
-		**out = **in
-	}
-	if in.NumRetries != nil {
-		in, out := &in.NumRetries, &out.NumRetries
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.RetryBackoffBaseInterval != nil {
-		in, out := &in.RetryBackoffBaseInterval, &out.RetryBackoffBaseInterval
-		*out = new(metav1.Duration)
-		**out = **in
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicySpec.
-func (in *RetryPolicySpec) DeepCopy() *RetryPolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarScopeServiceSpec.
+func (in *SidecarScopeServiceSpec) DeepCopy() *SidecarScopeServiceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RetryPolicySpec)
+	out := new(SidecarScopeServiceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RetrySpec) DeepCopyInto(out *RetrySpec) {
+func (in *SidecarScopeSpec) DeepCopyInto(out *SidecarScopeSpec) {
 	*out = *in
-	out.Source = in.Source
-	if in.Destinations != nil {
-		in, out := &in.Destinations, &out.Destinations
-		*out = make([]RetrySrcDstSpec, len(*in))
+	if in.WorkloadSelector != nil {
+		in, out := &in.WorkloadSelector, &out.WorkloadSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]SidecarScopeServiceSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	in.RetryPolicy.DeepCopyInto(&out.RetryPolicy)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetrySpec.
-func (in *RetrySpec) DeepCopy() *RetrySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarScopeSpec.
+func (in *SidecarScopeSpec) DeepCopy() *SidecarScopeSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RetrySpec)
+	out := new(SidecarScopeSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RetrySrcDstSpec) DeepCopyInto(out *RetrySrcDstSpec) {
+func (in *SidecarScopeStatus) DeepCopyInto(out *SidecarScopeStatus) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetrySrcDstSpec.
-func (in *RetrySrcDstSpec) DeepCopy() *RetrySrcDstSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarScopeStatus.
+func (in *SidecarScopeStatus) DeepCopy() *SidecarScopeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RetrySrcDstSpec)
+	out := new(SidecarScopeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1459,6 +2757,16 @@ func (in *UpstreamTrafficSettingSpec) DeepCopyInto(out *UpstreamTrafficSettingSp
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Mirror != nil {
+		in, out := &in.Mirror, &out.Mirror
+		*out = new(HTTPMirrorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutlierDetection != nil {
+		in, out := &in.OutlierDetection, &out.OutlierDetection
+		*out = new(OutlierDetectionSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -62,16 +62,26 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&AccessCertList{},
 		&AccessControl{},
 		&AccessControlList{},
+		&AuthorizationPolicy{},
+		&AuthorizationPolicyList{},
 		&Egress{},
 		&EgressGateway{},
 		&EgressGatewayList{},
 		&EgressList{},
+		&FLBPolicy{},
+		&FLBPolicyList{},
 		&IngressBackend{},
 		&IngressBackendList{},
 		&Isolation{},
 		&IsolationList{},
+		&MeshFaultInjection{},
+		&MeshFaultInjectionList{},
+		&RequestAuthentication{},
+		&RequestAuthenticationList{},
 		&Retry{},
 		&RetryList{},
+		&SidecarScope{},
+		&SidecarScopeList{},
 		&TrafficWarmup{},
 		&TrafficWarmupList{},
 		&UpstreamTrafficSetting{},
@@ -182,10 +182,8 @@ func (mc *MeshCatalog) getStrictAccessControlTrafficPolicy(svc service.MeshServi
 				}
 				endpoints := mc.listEndpointsForService(sourceMeshSvc)
 				if len(endpoints) == 0 {
-					aclWithStatus.Status = policyV1alpha1.AccessControlStatus{
-						CurrentStatus: "error",
-						Reason:        fmt.Sprintf("endpoints not found for service %s/%s", source.Namespace, source.Name),
-					}
+					aclWithStatus.Status.CurrentStatus = "error"
+					aclWithStatus.Status.Reason = fmt.Sprintf("endpoints not found for service %s/%s", source.Namespace, source.Name)
 					if _, err := mc.kubeController.UpdateStatus(&aclWithStatus); err != nil {
 						log.Error().Err(err).Msg("Error updating status for AccessControl")
 					}
@@ -6,6 +6,7 @@ import (
 	"github.com/flomesh-io/fsm/pkg/certificate"
 	"github.com/flomesh-io/fsm/pkg/configurator"
 	"github.com/flomesh-io/fsm/pkg/endpoint"
+	"github.com/flomesh-io/fsm/pkg/jwks"
 	"github.com/flomesh-io/fsm/pkg/k8s"
 	"github.com/flomesh-io/fsm/pkg/messaging"
 	"github.com/flomesh-io/fsm/pkg/multicluster"
@@ -43,6 +44,7 @@ func NewMeshCatalog(kubeController k8s.Controller,
 		certManager:            certManager,
 		kubeController:         kubeController,
 	}
+	meshCatalog.jwksCache = jwks.NewCache(msgBroker, jwks.DefaultRefreshInterval, meshCatalog.listJWKSURIs, stop)
 
 	meshCataloger = meshCatalog
 
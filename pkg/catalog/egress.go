@@ -40,6 +40,7 @@ func (mc *MeshCatalog) GetEgressTrafficPolicy(serviceIdentity identity.ServiceId
 	var trafficMatches []*trafficpolicy.TrafficMatch
 	var clusterConfigs []*trafficpolicy.EgressClusterConfig
 	portToRouteConfigMap := make(map[int][]*trafficpolicy.EgressHTTPRouteConfig)
+	policiesPerDestination := make(map[string][]string)
 	egressResources := mc.policyController.ListEgressPoliciesForSourceIdentity(serviceIdentity.ToK8sServiceAccount())
 
 	for _, egress := range egressResources {
@@ -53,6 +54,8 @@ func (mc *MeshCatalog) GetEgressTrafficPolicy(serviceIdentity identity.ServiceId
 		sourceMTLS := mc.getEgressSourceMTLS(egress, serviceIdentity.ToK8sServiceAccount())
 
 		for _, portSpec := range egress.Spec.Ports {
+			addEgressPolicyForDestinations(policiesPerDestination, egress, portSpec)
+
 			switch strings.ToLower(portSpec.Protocol) {
 			case constants.ProtocolHTTP:
 				// ---
@@ -135,9 +138,37 @@ func (mc *MeshCatalog) GetEgressTrafficPolicy(serviceIdentity identity.ServiceId
 		HTTPRouteConfigsPerPort: portToRouteConfigMap,
 		TrafficMatches:          trafficMatches,
 		ClustersConfigs:         clusterConfigs,
+		PoliciesPerDestination:  policiesPerDestination,
 	}, nil
 }
 
+// addEgressPolicyForDestinations records the given Egress policy as allowing the destinations of the given port,
+// a policy scoped to hosts allows only those hosts on the port while a policy without hosts allows any host on the port
+func addEgressPolicyForDestinations(policiesPerDestination map[string][]string, egressPolicy *policyv1alpha1.Egress, portSpec policyv1alpha1.PortSpec) {
+	policyName := fmt.Sprintf("%s/%s", egressPolicy.Namespace, egressPolicy.Name)
+
+	var destinations []string
+	if len(egressPolicy.Spec.Hosts) == 0 {
+		destinations = append(destinations, fmt.Sprintf("%d", portSpec.Number))
+	}
+	for _, host := range egressPolicy.Spec.Hosts {
+		destinations = append(destinations, fmt.Sprintf("%s:%d", host, portSpec.Number))
+	}
+
+	for _, destination := range destinations {
+		exists := false
+		for _, name := range policiesPerDestination[destination] {
+			if name == policyName {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			policiesPerDestination[destination] = append(policiesPerDestination[destination], policyName)
+		}
+	}
+}
+
 func (mc *MeshCatalog) getEgressSourceMTLS(egressPolicy *policyv1alpha1.Egress, source identity.K8sServiceAccount) *policyv1alpha1.EgressSourceMTLSSpec {
 	if egressPolicy == nil {
 		return nil
@@ -906,3 +906,86 @@ func TestGetHTTPRouteMatchesFromHTTPRouteGroup(t *testing.T) {
 		})
 	}
 }
+
+func TestAddEgressPolicyForDestinations(t *testing.T) {
+	assert := tassert.New(t)
+
+	egress1 := &policyv1alpha1.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egress-1", Namespace: "ns1"},
+		Spec: policyv1alpha1.EgressSpec{
+			Hosts: []string{"foo.com", "bar.com"},
+			Ports: []policyv1alpha1.PortSpec{
+				{Number: 80, Protocol: "http"},
+				{Number: 443, Protocol: "https"},
+			},
+		},
+	}
+	egress2 := &policyv1alpha1.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egress-2", Namespace: "ns1"},
+		Spec: policyv1alpha1.EgressSpec{
+			Hosts:       []string{"foo.com"},
+			IPAddresses: []string{"10.0.0.0/8"},
+			Ports: []policyv1alpha1.PortSpec{
+				{Number: 80, Protocol: "http"},
+				{Number: 3306, Protocol: "tcp"},
+			},
+		},
+	}
+
+	egress3 := &policyv1alpha1.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egress-3", Namespace: "ns1"},
+		Spec: policyv1alpha1.EgressSpec{
+			IPAddresses: []string{"10.0.0.0/8"},
+			Ports: []policyv1alpha1.PortSpec{
+				{Number: 3306, Protocol: "tcp"},
+			},
+		},
+	}
+
+	policiesPerDestination := make(map[string][]string)
+	for _, egress := range []*policyv1alpha1.Egress{egress1, egress2, egress3} {
+		for _, portSpec := range egress.Spec.Ports {
+			addEgressPolicyForDestinations(policiesPerDestination, egress, portSpec)
+		}
+	}
+	// Adding the same policy twice must not duplicate it
+	addEgressPolicyForDestinations(policiesPerDestination, egress1, egress1.Spec.Ports[0])
+
+	assert.Equal(map[string][]string{
+		"foo.com:80":   {"ns1/egress-1", "ns1/egress-2"},
+		"bar.com:80":   {"ns1/egress-1"},
+		"foo.com:443":  {"ns1/egress-1"},
+		"bar.com:443":  {"ns1/egress-1"},
+		"foo.com:3306": {"ns1/egress-2"},
+		"3306":         {"ns1/egress-3"},
+	}, policiesPerDestination)
+}
+
+func TestAddEgressPolicyForDestinationsSamePort(t *testing.T) {
+	assert := tassert.New(t)
+
+	egressFoo := &policyv1alpha1.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egress-foo", Namespace: "ns1"},
+		Spec: policyv1alpha1.EgressSpec{
+			Hosts: []string{"foo.com"},
+			Ports: []policyv1alpha1.PortSpec{{Number: 443, Protocol: "https"}},
+		},
+	}
+	egressBar := &policyv1alpha1.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egress-bar", Namespace: "ns2"},
+		Spec: policyv1alpha1.EgressSpec{
+			Hosts: []string{"bar.com"},
+			Ports: []policyv1alpha1.PortSpec{{Number: 443, Protocol: "https"}},
+		},
+	}
+
+	policiesPerDestination := make(map[string][]string)
+	addEgressPolicyForDestinations(policiesPerDestination, egressFoo, egressFoo.Spec.Ports[0])
+	addEgressPolicyForDestinations(policiesPerDestination, egressBar, egressBar.Spec.Ports[0])
+
+	// Each host is attributed only to the policy allowing it, no policy allows any host on the port
+	assert.Equal(map[string][]string{
+		"foo.com:443": {"ns1/egress-foo"},
+		"bar.com:443": {"ns2/egress-bar"},
+	}, policiesPerDestination)
+}
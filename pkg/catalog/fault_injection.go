@@ -0,0 +1,45 @@
+package catalog
+
+import (
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/trafficpolicy"
+)
+
+// applyRouteFaults sets the faults injected into the requests matching the routes of the given
+// outbound traffic policy from the given MeshFaultInjection policy
+func applyRouteFaults(outboundTrafficPolicy *trafficpolicy.OutboundTrafficPolicy, fault *policyv1alpha1.MeshFaultInjection) {
+	routeFault := newRouteFault(fault)
+	if routeFault == nil {
+		return
+	}
+	for _, route := range outboundTrafficPolicy.Routes {
+		route.Fault = routeFault
+	}
+}
+
+// newRouteFault returns the faults injected as specified by the given MeshFaultInjection policy,
+// nil if no fault is injected
+func newRouteFault(fault *policyv1alpha1.MeshFaultInjection) *trafficpolicy.HTTPRouteFault {
+	if fault == nil {
+		return nil
+	}
+
+	routeFault := &trafficpolicy.HTTPRouteFault{}
+	if delay := fault.Spec.Delay; delay != nil && delay.Percent > 0 && delay.FixedDelay.Duration > 0 {
+		routeFault.Delay = &trafficpolicy.HTTPFaultDelay{
+			Percent:    delay.Percent,
+			FixedDelay: delay.FixedDelay.Duration,
+		}
+	}
+	if abort := fault.Spec.Abort; abort != nil && abort.Percent > 0 {
+		routeFault.Abort = &trafficpolicy.HTTPFaultAbort{
+			Percent:    abort.Percent,
+			HTTPStatus: abort.HTTPStatus,
+			GRPCStatus: abort.GRPCStatus,
+		}
+	}
+	if routeFault.Delay == nil && routeFault.Abort == nil {
+		return nil
+	}
+	return routeFault
+}
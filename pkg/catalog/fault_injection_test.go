@@ -0,0 +1,50 @@
+package catalog
+
+import (
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/trafficpolicy"
+)
+
+func TestApplyRouteFaults(t *testing.T) {
+	assert := tassert.New(t)
+
+	grpcStatus := uint32(14)
+	fault := &policyv1alpha1.MeshFaultInjection{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "httpbin"},
+		Spec: policyv1alpha1.MeshFaultInjectionSpec{
+			Destination: policyv1alpha1.MeshFaultInjectionDestinationSpec{Name: "httpbin"},
+			Delay:       &policyv1alpha1.MeshFaultDelaySpec{Percent: 50, FixedDelay: metav1.Duration{Duration: time.Second}},
+			Abort:       &policyv1alpha1.MeshFaultAbortSpec{Percent: 0, HTTPStatus: 503, GRPCStatus: &grpcStatus},
+		},
+	}
+
+	outboundTrafficPolicy := newTestOutboundTrafficPolicy("/api", ".*")
+
+	applyRouteFaults(outboundTrafficPolicy, nil)
+	for _, route := range outboundTrafficPolicy.Routes {
+		assert.Nil(route.Fault)
+	}
+
+	// the abort injected into no request is dropped
+	applyRouteFaults(outboundTrafficPolicy, fault)
+	for _, route := range outboundTrafficPolicy.Routes {
+		assert.Equal(&trafficpolicy.HTTPRouteFault{
+			Delay: &trafficpolicy.HTTPFaultDelay{Percent: 50, FixedDelay: time.Second},
+		}, route.Fault)
+	}
+
+	fault.Spec.Delay.FixedDelay.Duration = 0
+	fault.Spec.Abort.Percent = 100
+	assert.Equal(&trafficpolicy.HTTPRouteFault{
+		Abort: &trafficpolicy.HTTPFaultAbort{Percent: 100, HTTPStatus: 503, GRPCStatus: &grpcStatus},
+	}, newRouteFault(fault))
+
+	fault.Spec.Abort = nil
+	assert.Nil(newRouteFault(fault))
+}
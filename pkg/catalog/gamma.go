@@ -0,0 +1,423 @@
+package catalog
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/flomesh-io/fsm/pkg/constants"
+	"github.com/flomesh-io/fsm/pkg/identity"
+	"github.com/flomesh-io/fsm/pkg/service"
+	"github.com/flomesh-io/fsm/pkg/trafficpolicy"
+)
+
+// getGammaRouteMatches returns the route matches built from the Gateway API HTTPRoutes and GRPCRoutes attached
+// to the upstream service by a Service parentRef (GAMMA).
+//
+// Consumer routes, which live in the namespace of the downstream, take precedence over producer routes,
+// which live in the namespace of the upstream service. Routes in any other namespace are ignored.
+func (mc *MeshCatalog) getGammaRouteMatches(downstreamSvcAccount identity.K8sServiceAccount, meshSvc service.MeshService) []*trafficpolicy.HTTPRouteMatchWithWeightedClusters {
+	if meshSvc.Protocol != constants.ProtocolHTTP && meshSvc.Protocol != constants.ProtocolGRPC {
+		return nil
+	}
+
+	httpRoutes := gammaRoutesInScope(downstreamSvcAccount.Namespace, meshSvc.Namespace, mc.meshSpec.ListHTTPRoutes(meshSvc))
+	var grpcRoutes []*gwv1.GRPCRoute
+	if meshSvc.Protocol == constants.ProtocolGRPC {
+		grpcRoutes = gammaRoutesInScope(downstreamSvcAccount.Namespace, meshSvc.Namespace, mc.meshSpec.ListGRPCRoutes(meshSvc))
+	}
+
+	var routeMatches []*trafficpolicy.HTTPRouteMatchWithWeightedClusters
+	for _, route := range httpRoutes {
+		for _, rule := range route.Spec.Rules {
+			backendRefs := make([]gwv1.BackendRef, 0, len(rule.BackendRefs))
+			for _, bk := range rule.BackendRefs {
+				backendRefs = append(backendRefs, bk.BackendRef)
+			}
+
+			upstreamClusters := mc.getGammaUpstreamClusters(constants.GatewayAPIHTTPRouteKind, route.Namespace, meshSvc, backendRefs)
+			if len(upstreamClusters) == 0 {
+				log.Warn().Msgf("HTTPRoute %s/%s has a rule without valid backends for %s, ignoring it", route.Namespace, route.Name, meshSvc)
+				continue
+			}
+
+			routeMatches = append(routeMatches, &trafficpolicy.HTTPRouteMatchWithWeightedClusters{
+				UpstreamClusters: upstreamClusters,
+				RouteMatches:     toHTTPRouteMatches(rule.Matches),
+				HasSplitMatches:  true,
+				Filters:          toHTTPRouteFilters(rule.Filters),
+				Timeouts:         toHTTPRouteTimeouts(rule.Timeouts),
+			})
+		}
+	}
+
+	for _, route := range grpcRoutes {
+		for _, rule := range route.Spec.Rules {
+			backendRefs := make([]gwv1.BackendRef, 0, len(rule.BackendRefs))
+			for _, bk := range rule.BackendRefs {
+				backendRefs = append(backendRefs, bk.BackendRef)
+			}
+
+			upstreamClusters := mc.getGammaUpstreamClusters(constants.GatewayAPIGRPCRouteKind, route.Namespace, meshSvc, backendRefs)
+			if len(upstreamClusters) == 0 {
+				log.Warn().Msgf("GRPCRoute %s/%s has a rule without valid backends for %s, ignoring it", route.Namespace, route.Name, meshSvc)
+				continue
+			}
+
+			routeMatches = append(routeMatches, &trafficpolicy.HTTPRouteMatchWithWeightedClusters{
+				UpstreamClusters: upstreamClusters,
+				RouteMatches:     toGRPCRouteMatches(rule.Matches),
+				HasSplitMatches:  true,
+				Filters:          toGRPCRouteFilters(rule.Filters),
+			})
+		}
+	}
+
+	return routeMatches
+}
+
+// gammaRoutesInScope returns the consumer routes if there is any, otherwise the producer routes
+func gammaRoutesInScope[T interface{ GetNamespace() string }](downstreamNamespace, serviceNamespace string, routes []T) []T {
+	var consumerRoutes, producerRoutes []T
+	for _, route := range routes {
+		switch route.GetNamespace() {
+		case serviceNamespace:
+			producerRoutes = append(producerRoutes, route)
+		case downstreamNamespace:
+			consumerRoutes = append(consumerRoutes, route)
+		}
+	}
+
+	if len(consumerRoutes) > 0 {
+		return consumerRoutes
+	}
+	return producerRoutes
+}
+
+// getGammaUpstreamClusters returns the weighted clusters of the Service backends of a route rule,
+// backends with zero weight or an unknown port are skipped
+// getGammaUpstreamClusters returns the upstream clusters of the backends of a route rule, a backend in
+// another namespace than the route's is only resolved if a ReferenceGrant in its namespace allows it
+func (mc *MeshCatalog) getGammaUpstreamClusters(routeKind, routeNamespace string, meshSvc service.MeshService, backendRefs []gwv1.BackendRef) []service.WeightedCluster {
+	var upstreamClusters []service.WeightedCluster
+	var referenceGrants []*gwv1beta1.ReferenceGrant
+	for _, bk := range backendRefs {
+		if bk.Group != nil && string(*bk.Group) != constants.KubernetesCoreGroup {
+			continue
+		}
+		if bk.Kind != nil && string(*bk.Kind) != constants.KubernetesServiceKind {
+			continue
+		}
+
+		weight := 1
+		if bk.Weight != nil {
+			weight = int(*bk.Weight)
+		}
+		if weight <= 0 {
+			continue
+		}
+
+		backendSvc := service.MeshService{
+			Namespace: routeNamespace,
+			Name:      string(bk.Name),
+			Port:      meshSvc.Port,
+		}
+		if bk.Namespace != nil && string(*bk.Namespace) != routeNamespace {
+			if referenceGrants == nil {
+				referenceGrants = mc.meshSpec.ListReferenceGrants()
+			}
+			if !isBackendRefGranted(referenceGrants, routeKind, routeNamespace, string(*bk.Namespace), string(bk.Name)) {
+				log.Warn().Msgf("%s in namespace %s is not allowed to refer to backend %s/%s by any ReferenceGrant, ignoring it",
+					routeKind, routeNamespace, *bk.Namespace, bk.Name)
+				continue
+			}
+			backendSvc.Namespace = string(*bk.Namespace)
+		}
+		if bk.Port != nil {
+			backendSvc.Port = uint16(*bk.Port)
+		}
+
+		targetPort, err := mc.getTargetPortForServicePort(backendSvc, types.NamespacedName{Namespace: backendSvc.Namespace, Name: backendSvc.Name})
+		if err != nil {
+			log.Warn().Err(err).Msgf("Error getting target port of backend %s for %s", backendSvc, meshSvc)
+			continue
+		}
+		backendSvc.TargetPort = targetPort
+
+		upstreamClusters = append(upstreamClusters, service.WeightedCluster{
+			ClusterName: service.ClusterName(backendSvc.SidecarClusterName()),
+			Weight:      weight,
+		})
+	}
+
+	return upstreamClusters
+}
+
+// isBackendRefGranted returns true if a ReferenceGrant in the namespace of the backend service allows
+// the routes of the given kind in the route namespace to refer to it
+func isBackendRefGranted(referenceGrants []*gwv1beta1.ReferenceGrant, routeKind, routeNamespace, backendNamespace, backendName string) bool {
+	for _, refGrant := range referenceGrants {
+		if refGrant.Namespace != backendNamespace {
+			continue
+		}
+
+		fromAllowed := false
+		for _, from := range refGrant.Spec.From {
+			if string(from.Group) == gwv1.GroupName && string(from.Kind) == routeKind && string(from.Namespace) == routeNamespace {
+				fromAllowed = true
+				break
+			}
+		}
+		if !fromAllowed {
+			continue
+		}
+
+		for _, to := range refGrant.Spec.To {
+			if string(to.Group) != constants.KubernetesCoreGroup || string(to.Kind) != constants.KubernetesServiceKind {
+				continue
+			}
+			if to.Name == nil || len(*to.Name) == 0 || string(*to.Name) == backendName {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func toHTTPRouteMatches(matches []gwv1.HTTPRouteMatch) []trafficpolicy.HTTPRouteMatch {
+	if len(matches) == 0 {
+		return []trafficpolicy.HTTPRouteMatch{trafficpolicy.WildCardRouteMatch}
+	}
+
+	var routeMatches []trafficpolicy.HTTPRouteMatch
+	for _, match := range matches {
+		if len(match.QueryParams) > 0 {
+			log.Warn().Msgf("Query param matches are not supported by sidecar routing, ignoring match %v", match)
+			continue
+		}
+
+		routeMatch := trafficpolicy.WildCardRouteMatch
+		if match.Path != nil && match.Path.Value != nil {
+			value := *match.Path.Value
+			matchType := gwv1.PathMatchPathPrefix
+			if match.Path.Type != nil {
+				matchType = *match.Path.Type
+			}
+
+			switch matchType {
+			case gwv1.PathMatchExact:
+				routeMatch.Path = value
+				routeMatch.PathMatchType = trafficpolicy.PathMatchExact
+			case gwv1.PathMatchPathPrefix:
+				if value != "/" {
+					routeMatch.Path = value
+					routeMatch.PathMatchType = trafficpolicy.PathMatchPrefix
+				}
+			case gwv1.PathMatchRegularExpression:
+				routeMatch.Path = value
+				routeMatch.PathMatchType = trafficpolicy.PathMatchRegex
+			}
+		}
+
+		if match.Method != nil {
+			routeMatch.Methods = []string{string(*match.Method)}
+		}
+
+		if len(match.Headers) > 0 {
+			routeMatch.Headers = make(map[string]string)
+			for _, header := range match.Headers {
+				exact := header.Type == nil || *header.Type == gwv1.HeaderMatchExact
+				routeMatch.Headers[strings.ToLower(string(header.Name))] = toHeaderRegex(header.Value, exact)
+			}
+		}
+
+		routeMatches = append(routeMatches, routeMatch)
+	}
+
+	return routeMatches
+}
+
+func toGRPCRouteMatches(matches []gwv1.GRPCRouteMatch) []trafficpolicy.HTTPRouteMatch {
+	if len(matches) == 0 {
+		return []trafficpolicy.HTTPRouteMatch{trafficpolicy.WildCardRouteMatch}
+	}
+
+	var routeMatches []trafficpolicy.HTTPRouteMatch
+	for _, match := range matches {
+		routeMatch := trafficpolicy.WildCardRouteMatch
+
+		if m := match.Method; m != nil && (m.Service != nil || m.Method != nil) {
+			exact := m.Type == nil || *m.Type == gwv1.GRPCMethodMatchExact
+			svc, method := "[^/]+", "[^/]+"
+			if m.Service != nil {
+				svc = toPathRegex(*m.Service, exact)
+			}
+			if m.Method != nil {
+				method = toPathRegex(*m.Method, exact)
+			}
+
+			if exact && m.Service != nil && m.Method != nil {
+				routeMatch.Path = fmt.Sprintf("/%s/%s", *m.Service, *m.Method)
+				routeMatch.PathMatchType = trafficpolicy.PathMatchExact
+			} else {
+				routeMatch.Path = fmt.Sprintf("^/%s/%s$", svc, method)
+				routeMatch.PathMatchType = trafficpolicy.PathMatchRegex
+			}
+		}
+
+		if len(match.Headers) > 0 {
+			routeMatch.Headers = make(map[string]string)
+			for _, header := range match.Headers {
+				exact := header.Type == nil || *header.Type == gwv1.GRPCHeaderMatchExact
+				routeMatch.Headers[strings.ToLower(string(header.Name))] = toHeaderRegex(header.Value, exact)
+			}
+		}
+
+		routeMatches = append(routeMatches, routeMatch)
+	}
+
+	return routeMatches
+}
+
+func toHeaderRegex(value string, exact bool) string {
+	if exact {
+		return fmt.Sprintf("^%s$", regexp.QuoteMeta(value))
+	}
+	return value
+}
+
+func toPathRegex(value string, exact bool) string {
+	if exact {
+		return regexp.QuoteMeta(value)
+	}
+	return fmt.Sprintf("(?:%s)", value)
+}
+
+func toHTTPRouteFilters(filters []gwv1.HTTPRouteFilter) *trafficpolicy.HTTPRouteFilters {
+	if len(filters) == 0 {
+		return nil
+	}
+
+	routeFilters := &trafficpolicy.HTTPRouteFilters{}
+	for _, filter := range filters {
+		switch filter.Type {
+		case gwv1.HTTPRouteFilterRequestHeaderModifier:
+			routeFilters.RequestHeaderModifier = toHTTPHeaderModifier(filter.RequestHeaderModifier)
+		case gwv1.HTTPRouteFilterResponseHeaderModifier:
+			routeFilters.ResponseHeaderModifier = toHTTPHeaderModifier(filter.ResponseHeaderModifier)
+		case gwv1.HTTPRouteFilterURLRewrite:
+			routeFilters.URLRewrite = toHTTPURLRewrite(filter.URLRewrite)
+		default:
+			log.Warn().Msgf("Filter %s is not supported by sidecar routing, ignoring it", filter.Type)
+		}
+	}
+
+	return routeFilters
+}
+
+func toGRPCRouteFilters(filters []gwv1.GRPCRouteFilter) *trafficpolicy.HTTPRouteFilters {
+	if len(filters) == 0 {
+		return nil
+	}
+
+	routeFilters := &trafficpolicy.HTTPRouteFilters{}
+	for _, filter := range filters {
+		switch filter.Type {
+		case gwv1.GRPCRouteFilterRequestHeaderModifier:
+			routeFilters.RequestHeaderModifier = toHTTPHeaderModifier(filter.RequestHeaderModifier)
+		case gwv1.GRPCRouteFilterResponseHeaderModifier:
+			routeFilters.ResponseHeaderModifier = toHTTPHeaderModifier(filter.ResponseHeaderModifier)
+		default:
+			log.Warn().Msgf("Filter %s is not supported by sidecar routing, ignoring it", filter.Type)
+		}
+	}
+
+	return routeFilters
+}
+
+func toHTTPHeaderModifier(filter *gwv1.HTTPHeaderFilter) *trafficpolicy.HTTPHeaderModifier {
+	if filter == nil {
+		return nil
+	}
+
+	modifier := &trafficpolicy.HTTPHeaderModifier{}
+	if len(filter.Set) > 0 {
+		modifier.Set = make(map[string]string)
+		for _, h := range filter.Set {
+			modifier.Set[strings.ToLower(string(h.Name))] = h.Value
+		}
+	}
+	if len(filter.Add) > 0 {
+		modifier.Add = make(map[string]string)
+		for _, h := range filter.Add {
+			modifier.Add[strings.ToLower(string(h.Name))] = h.Value
+		}
+	}
+	for _, name := range filter.Remove {
+		modifier.Remove = append(modifier.Remove, strings.ToLower(name))
+	}
+
+	return modifier
+}
+
+func toHTTPURLRewrite(filter *gwv1.HTTPURLRewriteFilter) *trafficpolicy.HTTPURLRewrite {
+	if filter == nil {
+		return nil
+	}
+
+	rewrite := &trafficpolicy.HTTPURLRewrite{}
+	if filter.Hostname != nil {
+		hostname := string(*filter.Hostname)
+		rewrite.Hostname = &hostname
+	}
+	if filter.Path != nil {
+		switch filter.Path.Type {
+		case gwv1.FullPathHTTPPathModifier:
+			rewrite.ReplaceFullPath = filter.Path.ReplaceFullPath
+		case gwv1.PrefixMatchHTTPPathModifier:
+			rewrite.ReplacePrefixMatch = filter.Path.ReplacePrefixMatch
+		}
+	}
+
+	return rewrite
+}
+
+func toHTTPRouteTimeouts(timeouts *gwv1.HTTPRouteTimeouts) *trafficpolicy.HTTPRouteTimeouts {
+	if timeouts == nil {
+		return nil
+	}
+
+	routeTimeouts := &trafficpolicy.HTTPRouteTimeouts{
+		Request:        parseGatewayDuration(timeouts.Request),
+		BackendRequest: parseGatewayDuration(timeouts.BackendRequest),
+	}
+	if routeTimeouts.Request == nil && routeTimeouts.BackendRequest == nil {
+		return nil
+	}
+
+	return routeTimeouts
+}
+
+// parseGatewayDuration parses a Gateway API duration, a zero duration disables the timeout
+func parseGatewayDuration(duration *gwv1.Duration) *time.Duration {
+	if duration == nil {
+		return nil
+	}
+
+	d, err := time.ParseDuration(string(*duration))
+	if err != nil {
+		log.Warn().Err(err).Msgf("Invalid duration %s, ignoring it", *duration)
+		return nil
+	}
+	if d <= 0 {
+		return nil
+	}
+
+	return &d
+}
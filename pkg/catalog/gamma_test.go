@@ -0,0 +1,326 @@
+package catalog
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	mapset "github.com/deckarep/golang-set"
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	configv1alpha3 "github.com/flomesh-io/fsm/pkg/apis/config/v1alpha3"
+	"github.com/flomesh-io/fsm/pkg/configurator"
+	"github.com/flomesh-io/fsm/pkg/constants"
+	"github.com/flomesh-io/fsm/pkg/endpoint"
+	"github.com/flomesh-io/fsm/pkg/identity"
+	"github.com/flomesh-io/fsm/pkg/k8s"
+	"github.com/flomesh-io/fsm/pkg/policy"
+	"github.com/flomesh-io/fsm/pkg/service"
+	"github.com/flomesh-io/fsm/pkg/smi"
+	"github.com/flomesh-io/fsm/pkg/trafficpolicy"
+)
+
+func TestToHTTPRouteMatches(t *testing.T) {
+	exact := gwv1.PathMatchExact
+	prefix := gwv1.PathMatchPathPrefix
+	get := gwv1.HTTPMethodGet
+
+	testCases := []struct {
+		name     string
+		matches  []gwv1.HTTPRouteMatch
+		expected []trafficpolicy.HTTPRouteMatch
+	}{
+		{
+			name:     "no match defaults to wildcard",
+			matches:  nil,
+			expected: []trafficpolicy.HTTPRouteMatch{trafficpolicy.WildCardRouteMatch},
+		},
+		{
+			name: "exact path with method and header",
+			matches: []gwv1.HTTPRouteMatch{
+				{
+					Path:    &gwv1.HTTPPathMatch{Type: &exact, Value: ptr.To("/books")},
+					Method:  &get,
+					Headers: []gwv1.HTTPHeaderMatch{{Name: "X-Version", Value: "v1.2"}},
+				},
+			},
+			expected: []trafficpolicy.HTTPRouteMatch{
+				{
+					Path:          "/books",
+					PathMatchType: trafficpolicy.PathMatchExact,
+					Methods:       []string{"GET"},
+					Headers:       map[string]string{"x-version": `^v1\.2$`},
+				},
+			},
+		},
+		{
+			name: "root prefix is wildcard",
+			matches: []gwv1.HTTPRouteMatch{
+				{Path: &gwv1.HTTPPathMatch{Type: &prefix, Value: ptr.To("/")}},
+				{Path: &gwv1.HTTPPathMatch{Type: &prefix, Value: ptr.To("/api")}},
+			},
+			expected: []trafficpolicy.HTTPRouteMatch{
+				trafficpolicy.WildCardRouteMatch,
+				{
+					Path:          "/api",
+					PathMatchType: trafficpolicy.PathMatchPrefix,
+					Methods:       []string{"*"},
+				},
+			},
+		},
+		{
+			name: "query param matches are ignored",
+			matches: []gwv1.HTTPRouteMatch{
+				{QueryParams: []gwv1.HTTPQueryParamMatch{{Name: "v", Value: "1"}}},
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			assert.Equal(tc.expected, toHTTPRouteMatches(tc.matches))
+		})
+	}
+}
+
+func TestToGRPCRouteMatches(t *testing.T) {
+	regex := gwv1.GRPCMethodMatchRegularExpression
+
+	testCases := []struct {
+		name         string
+		matches      []gwv1.GRPCRouteMatch
+		expectedPath string
+		expectedType trafficpolicy.PathMatchType
+	}{
+		{
+			name: "exact service and method",
+			matches: []gwv1.GRPCRouteMatch{
+				{Method: &gwv1.GRPCMethodMatch{Service: ptr.To("helloworld.Greeter"), Method: ptr.To("SayHello")}},
+			},
+			expectedPath: "/helloworld.Greeter/SayHello",
+			expectedType: trafficpolicy.PathMatchExact,
+		},
+		{
+			name: "exact service only",
+			matches: []gwv1.GRPCRouteMatch{
+				{Method: &gwv1.GRPCMethodMatch{Service: ptr.To("helloworld.Greeter")}},
+			},
+			expectedPath: `^/helloworld\.Greeter/[^/]+$`,
+			expectedType: trafficpolicy.PathMatchRegex,
+		},
+		{
+			name: "regular expression method",
+			matches: []gwv1.GRPCRouteMatch{
+				{Method: &gwv1.GRPCMethodMatch{Type: &regex, Method: ptr.To("Say.*")}},
+			},
+			expectedPath: `^/[^/]+/(?:Say.*)$`,
+			expectedType: trafficpolicy.PathMatchRegex,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			actual := toGRPCRouteMatches(tc.matches)
+			assert.Len(actual, 1)
+			assert.Equal(tc.expectedPath, actual[0].Path)
+			assert.Equal(tc.expectedType, actual[0].PathMatchType)
+		})
+	}
+}
+
+func TestGammaRoutesInScope(t *testing.T) {
+	assert := tassert.New(t)
+
+	producer := &gwv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "server", Name: "producer"}}
+	consumer := &gwv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "client", Name: "consumer"}}
+	other := &gwv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "other"}}
+	routes := []*gwv1.HTTPRoute{producer, consumer, other}
+
+	assert.Equal([]*gwv1.HTTPRoute{consumer}, gammaRoutesInScope("client", "server", routes))
+	assert.Equal([]*gwv1.HTTPRoute{producer}, gammaRoutesInScope("another", "server", routes))
+	assert.Nil(gammaRoutesInScope("another", "none", routes))
+}
+
+func TestGetGammaUpstreamClustersCrossNamespace(t *testing.T) {
+	meshSvc := service.MeshService{Name: "s1", Namespace: "ns1", Port: 8080, TargetPort: 80, Protocol: "http"}
+	backendRefs := []gwv1.BackendRef{
+		{BackendObjectReference: gwv1.BackendObjectReference{Name: "s2", Namespace: ptr.To(gwv1.Namespace("ns2"))}},
+	}
+	remoteCluster := service.WeightedCluster{
+		ClusterName: service.ClusterName(service.MeshService{Name: "s2", Namespace: "ns2", Port: 8080, TargetPort: 80}.SidecarClusterName()),
+		Weight:      1,
+	}
+
+	referenceGrant := func(namespace, fromKind, fromNamespace, toName string) *gwv1beta1.ReferenceGrant {
+		refGrant := &gwv1beta1.ReferenceGrant{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "grant"},
+			Spec: gwv1beta1.ReferenceGrantSpec{
+				From: []gwv1beta1.ReferenceGrantFrom{{Group: gwv1.GroupName, Kind: gwv1.Kind(fromKind), Namespace: gwv1.Namespace(fromNamespace)}},
+				To:   []gwv1beta1.ReferenceGrantTo{{Group: constants.KubernetesCoreGroup, Kind: constants.KubernetesServiceKind}},
+			},
+		}
+		if len(toName) > 0 {
+			refGrant.Spec.To[0].Name = ptr.To(gwv1.ObjectName(toName))
+		}
+		return refGrant
+	}
+
+	testCases := []struct {
+		name            string
+		referenceGrants []*gwv1beta1.ReferenceGrant
+		expected        []service.WeightedCluster
+	}{
+		{
+			name:     "no reference grant",
+			expected: nil,
+		},
+		{
+			name:            "reference grant in the route namespace",
+			referenceGrants: []*gwv1beta1.ReferenceGrant{referenceGrant("ns1", constants.GatewayAPIHTTPRouteKind, "ns1", "")},
+			expected:        nil,
+		},
+		{
+			name:            "reference grant from another namespace",
+			referenceGrants: []*gwv1beta1.ReferenceGrant{referenceGrant("ns2", constants.GatewayAPIHTTPRouteKind, "ns3", "")},
+			expected:        nil,
+		},
+		{
+			name:            "reference grant for another kind of route",
+			referenceGrants: []*gwv1beta1.ReferenceGrant{referenceGrant("ns2", constants.GatewayAPIGRPCRouteKind, "ns1", "")},
+			expected:        nil,
+		},
+		{
+			name:            "reference grant to another service",
+			referenceGrants: []*gwv1beta1.ReferenceGrant{referenceGrant("ns2", constants.GatewayAPIHTTPRouteKind, "ns1", "s3")},
+			expected:        nil,
+		},
+		{
+			name:            "reference grant to all the services",
+			referenceGrants: []*gwv1beta1.ReferenceGrant{referenceGrant("ns2", constants.GatewayAPIHTTPRouteKind, "ns1", "")},
+			expected:        []service.WeightedCluster{remoteCluster},
+		},
+		{
+			name:            "reference grant to the service",
+			referenceGrants: []*gwv1beta1.ReferenceGrant{referenceGrant("ns2", constants.GatewayAPIHTTPRouteKind, "ns1", "s2")},
+			expected:        []service.WeightedCluster{remoteCluster},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockKubeController := k8s.NewMockController(mockCtrl)
+			mockMeshSpec := smi.NewMockMeshSpec(mockCtrl)
+			mc := MeshCatalog{kubeController: mockKubeController, meshSpec: mockMeshSpec}
+
+			mockMeshSpec.EXPECT().ListReferenceGrants().Return(tc.referenceGrants).Times(1)
+			mockKubeController.EXPECT().GetTargetPortForServicePort(
+				types.NamespacedName{Namespace: "ns2", Name: "s2"}, meshSvc.Port).Return(uint16(80), nil).AnyTimes()
+
+			actual := mc.getGammaUpstreamClusters(constants.GatewayAPIHTTPRouteKind, "ns1", meshSvc, backendRefs)
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func TestToHTTPRouteTimeouts(t *testing.T) {
+	assert := tassert.New(t)
+
+	assert.Nil(toHTTPRouteTimeouts(nil))
+
+	request := gwv1.Duration("10s")
+	backend := gwv1.Duration("500ms")
+	timeouts := toHTTPRouteTimeouts(&gwv1.HTTPRouteTimeouts{Request: &request, BackendRequest: &backend})
+	assert.NotNil(timeouts)
+	assert.Equal(10*time.Second, *timeouts.Request)
+	assert.Equal(500*time.Millisecond, *timeouts.BackendRequest)
+}
+
+func TestGetOutboundMeshTrafficPolicyWithGammaRoutes(t *testing.T) {
+	assert := tassert.New(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	meshSvc := service.MeshService{Name: "s1", Namespace: "ns1", Port: 8080, TargetPort: 80, Protocol: "http"}
+	meshSvcV1 := service.MeshService{Name: "s1-v1", Namespace: "ns1", Port: 8080, TargetPort: 80, Protocol: "http"}
+	downstreamIdentity := identity.K8sServiceAccount{Namespace: "ns1", Name: "sa1"}.ToServiceIdentity()
+
+	route := &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "r1"},
+		Spec: gwv1.HTTPRouteSpec{
+			Rules: []gwv1.HTTPRouteRule{
+				{
+					Matches: []gwv1.HTTPRouteMatch{
+						{Path: &gwv1.HTTPPathMatch{Type: ptr.To(gwv1.PathMatchPathPrefix), Value: ptr.To("/v1")}},
+					},
+					BackendRefs: []gwv1.HTTPBackendRef{
+						{BackendRef: gwv1.BackendRef{BackendObjectReference: gwv1.BackendObjectReference{Name: "s1-v1"}}},
+					},
+				},
+			},
+		},
+	}
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockEndpointProvider := endpoint.NewMockProvider(mockCtrl)
+	mockServiceProvider := service.NewMockProvider(mockCtrl)
+	mockCfg := configurator.NewMockConfigurator(mockCtrl)
+	mockMeshSpec := smi.NewMockMeshSpec(mockCtrl)
+	mockPolicyController := policy.NewMockController(mockCtrl)
+
+	mc := MeshCatalog{
+		kubeController:     mockKubeController,
+		endpointsProviders: []endpoint.Provider{mockEndpointProvider},
+		serviceProviders:   []service.Provider{mockServiceProvider},
+		configurator:       mockCfg,
+		meshSpec:           mockMeshSpec,
+		policyController:   mockPolicyController,
+	}
+
+	mockCfg.EXPECT().IsPermissiveTrafficPolicyMode().Return(true).AnyTimes()
+	mockCfg.EXPECT().GetServiceAccessMode().Return(configv1alpha3.ServiceAccessModeDomain).AnyTimes()
+	mockCfg.EXPECT().GetServiceAccessNames().Return(&configv1alpha3.ServiceAccessNames{WithTrustDomain: true, MustWithNamespace: true}).AnyTimes()
+	mockCfg.EXPECT().IsEgressEnabled().Return(true).AnyTimes()
+	mockCfg.EXPECT().IsLocalDNSProxyEnabled().Return(false).AnyTimes()
+	mockCfg.EXPECT().GetFeatureFlags().Return(configv1alpha3.FeatureFlags{}).AnyTimes()
+	mockServiceProvider.EXPECT().ListServices().Return([]service.MeshService{meshSvc}).AnyTimes()
+	mockEndpointProvider.EXPECT().GetResolvableEndpointsForService(gomock.Any()).Return([]endpoint.Endpoint{{IP: net.ParseIP("10.0.1.1")}}).AnyTimes()
+	mockKubeController.EXPECT().IsMonitoredNamespace(gomock.Any()).Return(true).AnyTimes()
+	mockKubeController.EXPECT().GetTargetPortForServicePort(
+		types.NamespacedName{Namespace: meshSvcV1.Namespace, Name: meshSvcV1.Name}, meshSvcV1.Port).Return(meshSvcV1.TargetPort, nil).AnyTimes()
+	mockMeshSpec.EXPECT().ListHTTPRoutes(meshSvc).Return([]*gwv1.HTTPRoute{route}).AnyTimes()
+	mockPolicyController.EXPECT().ListIsolationPolicies().Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetSidecarScope(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetUpstreamTrafficSetting(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetMeshFaultInjection(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	actual := mc.GetOutboundMeshTrafficPolicy(downstreamIdentity, nil)
+	assert.NotNil(actual)
+
+	// Only the route backend is an upstream, the apex service is not
+	expectedClusters := mapset.NewSet(service.WeightedCluster{ClusterName: service.ClusterName(meshSvcV1.SidecarClusterName()), Weight: 1})
+	assert.Len(actual.TrafficMatches, 1)
+	assert.ElementsMatch([]service.WeightedCluster{{ClusterName: service.ClusterName(meshSvcV1.SidecarClusterName()), Weight: 1}}, actual.TrafficMatches[0].WeightedClusters)
+
+	routeConfigs := actual.HTTPRouteConfigsPerPort[int(meshSvc.Port)]
+	assert.Len(routeConfigs, 1)
+	assert.Len(routeConfigs[0].Routes, 2)
+	assert.Equal(trafficpolicy.HTTPRouteMatch{Path: "/v1", PathMatchType: trafficpolicy.PathMatchPrefix, Methods: []string{constants.WildcardHTTPMethod}}, routeConfigs[0].Routes[0].HTTPRouteMatch)
+	assert.True(expectedClusters.Equal(routeConfigs[0].Routes[0].WeightedClusters))
+
+	// Requests not matching the route hit the wildcard route without upstream clusters
+	assert.Equal(trafficpolicy.WildCardRouteMatch, routeConfigs[0].Routes[1].HTTPRouteMatch)
+	assert.Equal(0, routeConfigs[0].Routes[1].WeightedClusters.Cardinality())
+}
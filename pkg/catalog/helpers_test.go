@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	mapset "github.com/deckarep/golang-set"
 	"github.com/golang/mock/gomock"
 	access "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
 	specs "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
@@ -27,8 +28,21 @@ import (
 	"github.com/flomesh-io/fsm/pkg/service"
 	"github.com/flomesh-io/fsm/pkg/smi"
 	"github.com/flomesh-io/fsm/pkg/tests"
+	"github.com/flomesh-io/fsm/pkg/trafficpolicy"
 )
 
+// newTestOutboundTrafficPolicy returns the outbound traffic policy of the httpbin.test host with a route per path
+func newTestOutboundTrafficPolicy(paths ...string) *trafficpolicy.OutboundTrafficPolicy {
+	outboundTrafficPolicy := trafficpolicy.NewOutboundTrafficPolicy("httpbin.test", []string{"httpbin"})
+	for _, path := range paths {
+		outboundTrafficPolicy.Routes = append(outboundTrafficPolicy.Routes, &trafficpolicy.RouteWeightedClusters{
+			HTTPRouteMatch:   trafficpolicy.HTTPRouteMatch{Path: path},
+			WeightedClusters: mapset.NewSet(),
+		})
+	}
+	return outboundTrafficPolicy
+}
+
 type testParams struct {
 	permissiveMode bool
 }
@@ -138,6 +152,7 @@ func newFakeMeshCatalogForRoutes(t *testing.T, testParams testParams) *MeshCatal
 
 	mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(testParams.permissiveMode).AnyTimes()
 	mockConfigurator.EXPECT().GetConfigResyncInterval().Return(time.Duration(0)).AnyTimes()
+	mockPolicyController.EXPECT().ListRequestAuthenticationPolicies().Return(nil).AnyTimes()
 
 	mockMeshSpec.EXPECT().ListTrafficTargets().Return([]*access.TrafficTarget{&tests.TrafficTarget, &tests.BookstoreV2TrafficTarget}).AnyTimes()
 	mockMeshSpec.EXPECT().ListHTTPTrafficSpecs().Return([]*specs.HTTPRouteGroup{&tests.HTTPRouteGroup}).AnyTimes()
@@ -91,6 +91,12 @@ func (mc *MeshCatalog) GetInboundMeshTrafficPolicy(upstreamIdentity identity.Ser
 			if upstreamTrafficSetting != nil {
 				trafficMatchForUpstreamSvc.RateLimit = upstreamTrafficSetting.Spec.RateLimit
 			}
+			// Authorization rules apply to HTTP based protocols only
+			if upstreamSvc.Protocol != constants.ProtocolTCP && upstreamSvc.Protocol != constants.ProtocolTCPServerFirst {
+				if authzPolicies := mc.policyController.ListAuthorizationPolicies(upstreamSvc); len(authzPolicies) > 0 {
+					trafficMatchForUpstreamSvc.Authorization = policy.CompileAuthorizationPolicies(authzPolicies, mc.GetTrustDomain())
+				}
+			}
 			trafficMatches = append(trafficMatches, trafficMatchForUpstreamSvc)
 		}
 
@@ -2035,6 +2035,7 @@ func TestGetInboundMeshTrafficPolicy(t *testing.T) {
 			mockEndpointProvider.EXPECT().GetResolvableEndpointsForService(gomock.Any()).Return(nil).AnyTimes()
 			mockPolicyController.EXPECT().ListIsolationPolicies().Return(nil).AnyTimes()
 			mockPolicyController.EXPECT().GetUpstreamTrafficSetting(gomock.Any()).Return(tc.upstreamTrafficSetting).AnyTimes()
+			mockPolicyController.EXPECT().ListAuthorizationPolicies(gomock.Any()).Return(nil).AnyTimes()
 			mockCfg.EXPECT().IsPermissiveTrafficPolicyMode().Return(tc.permissiveMode)
 			mockCfg.EXPECT().GetServiceAccessMode().Return(configv1alpha3.ServiceAccessModeDomain).AnyTimes()
 			mockCfg.EXPECT().GetServiceAccessNames().Return(&configv1alpha3.ServiceAccessNames{WithTrustDomain: true, MustWithNamespace: true}).AnyTimes()
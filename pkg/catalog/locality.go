@@ -0,0 +1,52 @@
+package catalog
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/flomesh-io/fsm/pkg/endpoint"
+	"github.com/flomesh-io/fsm/pkg/models"
+)
+
+const (
+	defaultLocalityMinLocalCapacity    = 70
+	defaultLocalitySpilloverPercentage = 50
+)
+
+// GetLocalityZone returns the topology zone of the node the given proxy resides on if zone aware load
+// balancing is enabled, an empty string is returned otherwise or if the zone is unknown
+func (mc *MeshCatalog) GetLocalityZone(proxy models.Proxy) string {
+	if !mc.configurator.GetMeshConfig().Spec.LocalityLoadBalancing.Enable {
+		return ""
+	}
+
+	pod, err := mc.kubeController.GetPodForProxy(proxy)
+	if err != nil || pod == nil || len(pod.Spec.NodeName) == 0 {
+		return ""
+	}
+
+	node := mc.kubeController.GetNode(pod.Spec.NodeName)
+	if node == nil {
+		return ""
+	}
+
+	return node.Labels[corev1.LabelTopologyZone]
+}
+
+// ApplyLocalityLoadBalancing weights the given upstream endpoints to prefer the ones in the given zone
+func (mc *MeshCatalog) ApplyLocalityLoadBalancing(zone string, endpoints []endpoint.Endpoint) []endpoint.Endpoint {
+	if len(zone) == 0 {
+		return endpoints
+	}
+
+	spec := mc.configurator.GetMeshConfig().Spec.LocalityLoadBalancing
+	minLocalCapacity := uint32(defaultLocalityMinLocalCapacity)
+	if spec.MinLocalCapacity != nil {
+		minLocalCapacity = *spec.MinLocalCapacity
+	}
+	spilloverPercentage := uint32(defaultLocalitySpilloverPercentage)
+	if spec.SpilloverPercentage != nil {
+		spilloverPercentage = *spec.SpilloverPercentage
+	}
+
+	return endpoint.WithLocality(endpoints, zone, minLocalCapacity, spilloverPercentage)
+}
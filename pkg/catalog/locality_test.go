@@ -0,0 +1,75 @@
+package catalog
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/flomesh-io/fsm/pkg/apis/config/v1alpha3"
+	"github.com/flomesh-io/fsm/pkg/configurator"
+	"github.com/flomesh-io/fsm/pkg/k8s"
+)
+
+func TestGetLocalityZone(t *testing.T) {
+	testCases := []struct {
+		name         string
+		enable       bool
+		pod          *corev1.Pod
+		podErr       error
+		node         *corev1.Node
+		expectedZone string
+	}{
+		{
+			name:         "zone aware load balancing disabled",
+			enable:       false,
+			expectedZone: "",
+		},
+		{
+			name:         "pod of the proxy not found",
+			enable:       true,
+			podErr:       errors.New("not found"),
+			expectedZone: "",
+		},
+		{
+			name:   "zone of the node of the proxy",
+			enable: true,
+			pod:    &corev1.Pod{Spec: corev1.PodSpec{NodeName: "node-1"}},
+			node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "node-1",
+					Labels: map[string]string{corev1.LabelTopologyZone: "zone-a"},
+				},
+			},
+			expectedZone: "zone-a",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockCfg := configurator.NewMockConfigurator(mockCtrl)
+			mockKubeController := k8s.NewMockController(mockCtrl)
+			mc := &MeshCatalog{
+				configurator:   mockCfg,
+				kubeController: mockKubeController,
+			}
+
+			mockCfg.EXPECT().GetMeshConfig().Return(v1alpha3.MeshConfig{
+				Spec: v1alpha3.MeshConfigSpec{
+					LocalityLoadBalancing: v1alpha3.LocalityLoadBalancingSpec{Enable: tc.enable},
+				},
+			}).AnyTimes()
+			mockKubeController.EXPECT().GetPodForProxy(gomock.Any()).Return(tc.pod, tc.podErr).AnyTimes()
+			mockKubeController.EXPECT().GetNode(gomock.Any()).Return(tc.node).AnyTimes()
+
+			assert.Equal(tc.expectedZone, mc.GetLocalityZone(nil))
+		})
+	}
+}
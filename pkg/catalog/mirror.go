@@ -0,0 +1,136 @@
+package catalog
+
+import (
+	"fmt"
+	"strings"
+
+	mapset "github.com/deckarep/golang-set"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/constants"
+	"github.com/flomesh-io/fsm/pkg/k8s"
+	"github.com/flomesh-io/fsm/pkg/policy"
+	"github.com/flomesh-io/fsm/pkg/service"
+	"github.com/flomesh-io/fsm/pkg/trafficpolicy"
+)
+
+// applyRouteMirrors sets the mirroring of the routes of the given outbound traffic policy from the
+// UpstreamTrafficSetting of the upstream service, and returns the services the requests are mirrored to.
+// The requests are not mirrored to the services the SidecarScope of the downstream doesn't allow to reach,
+// so that neither their routes nor their clusters are configured.
+func (mc *MeshCatalog) applyRouteMirrors(outboundTrafficPolicy *trafficpolicy.OutboundTrafficPolicy, meshSvc service.MeshService,
+	upstreamTrafficSetting *policyv1alpha1.UpstreamTrafficSetting, sidecarScope *policyv1alpha1.SidecarScope) []service.MeshService {
+	if upstreamTrafficSetting == nil {
+		return nil
+	}
+
+	var mirrorSvcs []service.MeshService
+	resolve := func(mirror *policyv1alpha1.HTTPMirrorSpec) *trafficpolicy.HTTPRouteMirror {
+		if mirror == nil {
+			return nil
+		}
+		mirrorSvc := mc.getMirrorService(meshSvc, upstreamTrafficSetting.Namespace, mirror)
+		if mirrorSvc == nil {
+			return nil
+		}
+		if sidecarScope != nil && !policy.SidecarScopeAllows(sidecarScope, *mirrorSvc) {
+			log.Debug().Msgf("Mirror service %s of upstream service %s is out of SidecarScope %s/%s, ignoring it",
+				mirrorSvc, meshSvc, sidecarScope.Namespace, sidecarScope.Name)
+			return nil
+		}
+		mirrorSvcs = append(mirrorSvcs, *mirrorSvc)
+		return newRouteMirror(*mirrorSvc, mirror)
+	}
+
+	hostMirror := resolve(upstreamTrafficSetting.Spec.Mirror)
+	routeMirrors := make(map[string]*trafficpolicy.HTTPRouteMirror)
+	for _, httpRoute := range upstreamTrafficSetting.Spec.HTTPRoutes {
+		if routeMirror := resolve(httpRoute.Mirror); routeMirror != nil {
+			routeMirrors[httpRoute.Path] = routeMirror
+		}
+	}
+
+	for _, route := range outboundTrafficPolicy.Routes {
+		if routeMirror, ok := routeMirrors[route.HTTPRouteMatch.Path]; ok {
+			route.Mirror = routeMirror
+		} else {
+			route.Mirror = hostMirror
+		}
+	}
+	return mirrorSvcs
+}
+
+// addMirrorClusterConfigs adds the cluster configs of the given mirror services missing from the given cluster configs
+func (mc *MeshCatalog) addMirrorClusterConfigs(clusterConfigs []*trafficpolicy.MeshClusterConfig, mirrorSvcs []service.MeshService) []*trafficpolicy.MeshClusterConfig {
+	if len(mirrorSvcs) == 0 {
+		return clusterConfigs
+	}
+
+	clusterNames := mapset.NewSet()
+	for _, clusterConfig := range clusterConfigs {
+		clusterNames.Add(clusterConfig.Name)
+	}
+	for _, mirrorSvc := range mirrorSvcs {
+		mirrorSvc := mirrorSvc // To prevent loop variable memory aliasing in for loop
+		if !clusterNames.Add(mirrorSvc.SidecarClusterName()) {
+			continue
+		}
+		clusterConfigs = append(clusterConfigs, &trafficpolicy.MeshClusterConfig{
+			Name:                            mirrorSvc.SidecarClusterName(),
+			Service:                         mirrorSvc,
+			EnableSidecarActiveHealthChecks: mc.configurator.GetFeatureFlags().EnableSidecarActiveHealthChecks,
+			UpstreamTrafficSetting:          mc.policyController.GetUpstreamTrafficSetting(policy.UpstreamTrafficSettingGetOpt{MeshService: &mirrorSvc}),
+		})
+	}
+	return clusterConfigs
+}
+
+// getMirrorService returns the mesh service the requests to the given upstream service are mirrored to,
+// nil if the service does not exist or does not expose the mirrored port
+func (mc *MeshCatalog) getMirrorService(meshSvc service.MeshService, namespace string, mirror *policyv1alpha1.HTTPMirrorSpec) *service.MeshService {
+	name := mirror.Service
+	if segs := strings.Split(mirror.Service, "/"); len(segs) == 2 {
+		namespace, name = segs[0], segs[1]
+	}
+	port := meshSvc.Port
+	if mirror.Port != nil {
+		port = *mirror.Port
+	}
+
+	svc := mc.kubeController.GetService(service.MeshService{Namespace: namespace, Name: name})
+	if svc == nil {
+		log.Warn().Msgf("Mirror service %s/%s of upstream service %s not found", namespace, name, meshSvc)
+		return nil
+	}
+	for _, mirrorSvc := range k8s.ServiceToMeshServices(mc.kubeController, mc.configurator.GetMeshConfig().Spec.Connector.Lb, svc) {
+		if mirrorSvc.Port == port && mirrorSvc.TargetPort > 0 {
+			if mirrorSvc.Protocol == constants.ProtocolTCP || mirrorSvc.Protocol == constants.ProtocolTCPServerFirst {
+				log.Warn().Msgf("Mirror service %s of upstream service %s is not an HTTP service", mirrorSvc, meshSvc)
+				return nil
+			}
+			return &mirrorSvc
+		}
+	}
+	log.Warn().Msgf("Mirror service %s/%s of upstream service %s does not expose port %d", namespace, name, meshSvc, port)
+	return nil
+}
+
+// newRouteMirror returns the mirroring of requests to the given mesh service
+func newRouteMirror(mirrorSvc service.MeshService, mirror *policyv1alpha1.HTTPMirrorSpec) *trafficpolicy.HTTPRouteMirror {
+	routeMirror := &trafficpolicy.HTTPRouteMirror{
+		ClusterName: service.ClusterName(mirrorSvc.SidecarClusterName()),
+		// the inbound routes of the mirror service match its own host names
+		Host:    fmt.Sprintf("%s.%s:%d", mirrorSvc.Name, mirrorSvc.Namespace, mirrorSvc.Port),
+		Percent: 100,
+	}
+	if mirror.Percent != nil {
+		routeMirror.Percent = *mirror.Percent
+	}
+	if len(mirror.RequestHeadersToAdd) > 0 {
+		routeMirror.RequestHeadersToAdd = make(map[string]string, len(mirror.RequestHeadersToAdd))
+		for _, header := range mirror.RequestHeadersToAdd {
+			routeMirror.RequestHeadersToAdd[strings.ToLower(header.Name)] = header.Value
+		}
+	}
+	return routeMirror
+}
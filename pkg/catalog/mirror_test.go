@@ -0,0 +1,105 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	configv1alpha3 "github.com/flomesh-io/fsm/pkg/apis/config/v1alpha3"
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/configurator"
+	"github.com/flomesh-io/fsm/pkg/k8s"
+	"github.com/flomesh-io/fsm/pkg/policy"
+	"github.com/flomesh-io/fsm/pkg/service"
+	"github.com/flomesh-io/fsm/pkg/trafficpolicy"
+)
+
+func TestApplyRouteMirrors(t *testing.T) {
+	assert := tassert.New(t)
+	mockCtrl := gomock.NewController(t)
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockCfg := configurator.NewMockConfigurator(mockCtrl)
+	mockPolicyController := policy.NewMockController(mockCtrl)
+	mc := &MeshCatalog{
+		kubeController:   mockKubeController,
+		configurator:     mockCfg,
+		policyController: mockPolicyController,
+	}
+
+	newService := func(namespace, name string, port int32, targetPort int32) {
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "http", Port: port}}},
+		}
+		endpoints := &corev1.Endpoints{
+			Subsets: []corev1.EndpointSubset{{Ports: []corev1.EndpointPort{{Name: "http", Port: targetPort}}}},
+		}
+		mockKubeController.EXPECT().GetService(service.MeshService{Namespace: namespace, Name: name}).Return(svc).AnyTimes()
+		mockKubeController.EXPECT().GetEndpoints(service.MeshService{Namespace: namespace, Name: name, Port: uint16(port), Protocol: "http"}).Return(endpoints, nil).AnyTimes()
+	}
+	newService("test", "httpbin-v2", 14001, 8080)
+	newService("other", "httpbin-v3", 8000, 9000)
+	mockKubeController.EXPECT().GetService(service.MeshService{Namespace: "test", Name: "missing"}).Return(nil).AnyTimes()
+	mockCfg.EXPECT().GetMeshConfig().Return(configv1alpha3.MeshConfig{}).AnyTimes()
+	mockCfg.EXPECT().GetFeatureFlags().Return(configv1alpha3.FeatureFlags{}).AnyTimes()
+	mockPolicyController.EXPECT().GetUpstreamTrafficSetting(gomock.Any()).Return(nil).AnyTimes()
+
+	upstreamSvc := service.MeshService{Namespace: "test", Name: "httpbin", Port: 14001, TargetPort: 14001, Protocol: "http"}
+	upstreamTrafficSetting := &policyv1alpha1.UpstreamTrafficSetting{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "httpbin"},
+		Spec: policyv1alpha1.UpstreamTrafficSettingSpec{
+			Host: "httpbin.test.svc.cluster.local",
+			Mirror: &policyv1alpha1.HTTPMirrorSpec{
+				Service:             "httpbin-v2",
+				Percent:             ptr.To(uint32(10)),
+				RequestHeadersToAdd: []policyv1alpha1.HTTPHeaderValue{{Name: "X-Shadow", Value: "true"}},
+			},
+			HTTPRoutes: []policyv1alpha1.HTTPRouteSpec{
+				{Path: "/api", Mirror: &policyv1alpha1.HTTPMirrorSpec{Service: "other/httpbin-v3", Port: ptr.To(uint16(8000))}},
+				{Path: "/missing", Mirror: &policyv1alpha1.HTTPMirrorSpec{Service: "missing"}},
+			},
+		},
+	}
+
+	outboundTrafficPolicy := newTestOutboundTrafficPolicy("/api", "/missing", ".*")
+
+	mirrorSvcs := mc.applyRouteMirrors(outboundTrafficPolicy, upstreamSvc, upstreamTrafficSetting, nil)
+	hostMirror := &trafficpolicy.HTTPRouteMirror{
+		ClusterName:         "test/httpbin-v2|8080",
+		Host:                "httpbin-v2.test:14001",
+		Percent:             10,
+		RequestHeadersToAdd: map[string]string{"x-shadow": "true"},
+	}
+	assert.Equal(&trafficpolicy.HTTPRouteMirror{
+		ClusterName: "other/httpbin-v3|9000",
+		Host:        "httpbin-v3.other:8000",
+		Percent:     100,
+	}, outboundTrafficPolicy.Routes[0].Mirror)
+	// an unresolved route mirror falls back to the host mirror
+	assert.Equal(hostMirror, outboundTrafficPolicy.Routes[1].Mirror)
+	assert.Equal(hostMirror, outboundTrafficPolicy.Routes[2].Mirror)
+	assert.Len(mirrorSvcs, 2)
+
+	// only the missing clusters are added
+	clusterConfigs := []*trafficpolicy.MeshClusterConfig{{Name: "test/httpbin-v2|8080"}}
+	clusterConfigs = mc.addMirrorClusterConfigs(clusterConfigs, mirrorSvcs)
+	assert.Len(clusterConfigs, 2)
+	assert.Equal("other/httpbin-v3|9000", clusterConfigs[1].Name)
+	assert.Equal(mirrorSvcs[1], clusterConfigs[1].Service)
+
+	// the mirror services out of the SidecarScope are ignored
+	sidecarScope := &policyv1alpha1.SidecarScope{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "scope"}}
+	outboundTrafficPolicy = newTestOutboundTrafficPolicy("/api", "/missing", ".*")
+	mirrorSvcs = mc.applyRouteMirrors(outboundTrafficPolicy, upstreamSvc, upstreamTrafficSetting, sidecarScope)
+	assert.Equal(hostMirror, outboundTrafficPolicy.Routes[0].Mirror)
+	assert.Len(mirrorSvcs, 1)
+	assert.Equal("httpbin-v2", mirrorSvcs[0].Name)
+	clusterConfigs = mc.addMirrorClusterConfigs(nil, mirrorSvcs)
+	assert.Len(clusterConfigs, 1)
+	assert.Equal("test/httpbin-v2|8080", clusterConfigs[0].Name)
+}
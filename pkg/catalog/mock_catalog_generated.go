@@ -12,6 +12,7 @@ import (
 	endpoint "github.com/flomesh-io/fsm/pkg/endpoint"
 	identity "github.com/flomesh-io/fsm/pkg/identity"
 	k8s "github.com/flomesh-io/fsm/pkg/k8s"
+	models "github.com/flomesh-io/fsm/pkg/models"
 	service "github.com/flomesh-io/fsm/pkg/service"
 	trafficpolicy "github.com/flomesh-io/fsm/pkg/trafficpolicy"
 	cidr "github.com/flomesh-io/fsm/pkg/utils/cidr"
@@ -42,6 +43,20 @@ func (m *MockMeshCataloger) EXPECT() *MockMeshCatalogerMockRecorder {
 	return m.recorder
 }
 
+// ApplyLocalityLoadBalancing mocks base method.
+func (m *MockMeshCataloger) ApplyLocalityLoadBalancing(arg0 string, arg1 []endpoint.Endpoint) []endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyLocalityLoadBalancing", arg0, arg1)
+	ret0, _ := ret[0].([]endpoint.Endpoint)
+	return ret0
+}
+
+// ApplyLocalityLoadBalancing indicates an expected call of ApplyLocalityLoadBalancing.
+func (mr *MockMeshCatalogerMockRecorder) ApplyLocalityLoadBalancing(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyLocalityLoadBalancing", reflect.TypeOf((*MockMeshCataloger)(nil).ApplyLocalityLoadBalancing), arg0, arg1)
+}
+
 // GetAccessControlTrafficPolicy mocks base method.
 func (m *MockMeshCataloger) GetAccessControlTrafficPolicy(arg0 service.MeshService) (*trafficpolicy.AccessControlTrafficPolicy, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +72,21 @@ func (mr *MockMeshCatalogerMockRecorder) GetAccessControlTrafficPolicy(arg0 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessControlTrafficPolicy", reflect.TypeOf((*MockMeshCataloger)(nil).GetAccessControlTrafficPolicy), arg0)
 }
 
+// GetRequestAuthenticationTrafficPolicy mocks base method.
+func (m *MockMeshCataloger) GetRequestAuthenticationTrafficPolicy(arg0 service.MeshService) (*trafficpolicy.RequestAuthenticationTrafficPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestAuthenticationTrafficPolicy", arg0)
+	ret0, _ := ret[0].(*trafficpolicy.RequestAuthenticationTrafficPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRequestAuthenticationTrafficPolicy indicates an expected call of GetRequestAuthenticationTrafficPolicy.
+func (mr *MockMeshCatalogerMockRecorder) GetRequestAuthenticationTrafficPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestAuthenticationTrafficPolicy", reflect.TypeOf((*MockMeshCataloger)(nil).GetRequestAuthenticationTrafficPolicy), arg0)
+}
+
 // GetEgressGatewayPolicy mocks base method.
 func (m *MockMeshCataloger) GetEgressGatewayPolicy() (*trafficpolicy.EgressGatewayPolicy, error) {
 	m.ctrl.T.Helper()
@@ -175,17 +205,17 @@ func (mr *MockMeshCatalogerMockRecorder) GetKubeController() *gomock.Call {
 }
 
 // GetOutboundMeshTrafficPolicy mocks base method.
-func (m *MockMeshCataloger) GetOutboundMeshTrafficPolicy(arg0 identity.ServiceIdentity) *trafficpolicy.OutboundMeshTrafficPolicy {
+func (m *MockMeshCataloger) GetOutboundMeshTrafficPolicy(arg0 identity.ServiceIdentity, arg1 map[string]string) *trafficpolicy.OutboundMeshTrafficPolicy {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOutboundMeshTrafficPolicy", arg0)
+	ret := m.ctrl.Call(m, "GetOutboundMeshTrafficPolicy", arg0, arg1)
 	ret0, _ := ret[0].(*trafficpolicy.OutboundMeshTrafficPolicy)
 	return ret0
 }
 
 // GetOutboundMeshTrafficPolicy indicates an expected call of GetOutboundMeshTrafficPolicy.
-func (mr *MockMeshCatalogerMockRecorder) GetOutboundMeshTrafficPolicy(arg0 interface{}) *gomock.Call {
+func (mr *MockMeshCatalogerMockRecorder) GetOutboundMeshTrafficPolicy(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutboundMeshTrafficPolicy", reflect.TypeOf((*MockMeshCataloger)(nil).GetOutboundMeshTrafficPolicy), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutboundMeshTrafficPolicy", reflect.TypeOf((*MockMeshCataloger)(nil).GetOutboundMeshTrafficPolicy), arg0, arg1)
 }
 
 // GetPluginChains mocks base method.
@@ -244,6 +274,20 @@ func (mr *MockMeshCatalogerMockRecorder) GetRetryPolicy(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRetryPolicy", reflect.TypeOf((*MockMeshCataloger)(nil).GetRetryPolicy), arg0, arg1)
 }
 
+// GetLocalityZone mocks base method.
+func (m *MockMeshCataloger) GetLocalityZone(arg0 models.Proxy) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocalityZone", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetLocalityZone indicates an expected call of GetLocalityZone.
+func (mr *MockMeshCatalogerMockRecorder) GetLocalityZone(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocalityZone", reflect.TypeOf((*MockMeshCataloger)(nil).GetLocalityZone), arg0)
+}
+
 // GetTrafficWarmupPolicy mocks base method.
 func (m *MockMeshCataloger) GetTrafficWarmupPolicy(arg0 service.MeshService) *v1alpha3.TrafficWarmupSpec {
 	m.ctrl.T.Helper()
@@ -30,21 +30,32 @@ import (
 //  2. In SMI mode, builds outbound mesh traffic policies to reach every upstream service corresponding
 //     to every upstream service account that this downstream is authorized to access using SMI TrafficTarget
 //     policies.
-//  3. Process TraficSplit policies and update the weights for the upstream services based on the policies.
+//  3. Process Gateway API HTTPRoute/GRPCRoute policies attached to the upstream services (GAMMA), or
+//     TraficSplit policies if there is none, and update the routes and weights for the upstream services based on the policies.
+//     Requests to a service with GAMMA routes that do not match any of the routes are rejected with a 404.
+//  4. If a SidecarScope policy applies to the downstream pod with the given labels, upstream services not reachable
+//     within the scope are pruned, so are their clusters and DNS resolvable entries.
+//  5. Requests are mirrored as specified by the UpstreamTrafficSetting policies of the upstream services, the
+//     clusters of the services the requests are mirrored to are added if missing. The route timeouts of the
+//     UpstreamTrafficSetting policies apply unless set by the Gateway API routes.
+//  6. Faults are injected into the requests to the upstream services as specified by the MeshFaultInjection policies
+//     applied to the downstream pod with the given labels.
 //
 // The route configurations are consolidated per port, such that upstream services using the same port are a part
 // of the same route configuration. This is required to avoid route conflicts that can occur when the same hostname
 // needs to be routed differently based on the port used.
-func (mc *MeshCatalog) GetOutboundMeshTrafficPolicy(downstreamIdentity identity.ServiceIdentity) *trafficpolicy.OutboundMeshTrafficPolicy {
+func (mc *MeshCatalog) GetOutboundMeshTrafficPolicy(downstreamIdentity identity.ServiceIdentity, podLabels map[string]string) *trafficpolicy.OutboundMeshTrafficPolicy {
 	var trafficMatches []*trafficpolicy.TrafficMatch
 	var clusterConfigs []*trafficpolicy.MeshClusterConfig
 	routeConfigPerPort := make(map[int][]*trafficpolicy.OutboundTrafficPolicy)
 	downstreamSvcAccount := downstreamIdentity.ToK8sServiceAccount()
 	servicesResolvableSet := make(map[string][]interface{})
+	sidecarScope := mc.policyController.GetSidecarScope(downstreamSvcAccount.Namespace, podLabels)
 
 	var egressPolicy *trafficpolicy.EgressTrafficPolicy
 	var egressPolicyGetted bool
 	var egressEnabled bool
+	var mirrorSvcs []service.MeshService
 
 	// For each service, build the traffic policies required to access it.
 	// It is important to aggregate HTTP route configs by the service's port.
@@ -52,6 +63,9 @@ func (mc *MeshCatalog) GetOutboundMeshTrafficPolicy(downstreamIdentity identity.
 		if meshSvc.TargetPort == 0 {
 			continue
 		}
+		if sidecarScope != nil && !policy.SidecarScopeAllows(sidecarScope, meshSvc) {
+			continue
+		}
 		meshSvc := meshSvc // To prevent loop variable memory aliasing in for loop
 
 		egressEnabled, egressPolicyGetted, egressPolicy = mc.enableEgressSrviceForIdentity(downstreamIdentity, egressPolicyGetted, egressPolicy, meshSvc)
@@ -95,15 +109,17 @@ func (mc *MeshCatalog) GetOutboundMeshTrafficPolicy(downstreamIdentity identity.
 
 		// ---
 		// Create the cluster config for this upstream service
+		upstreamTrafficSetting := mc.policyController.GetUpstreamTrafficSetting(policy.UpstreamTrafficSettingGetOpt{MeshService: &meshSvc})
 		clusterConfigForServicePort := &trafficpolicy.MeshClusterConfig{
 			Name:                            meshSvc.SidecarClusterName(),
 			Service:                         meshSvc,
 			EnableSidecarActiveHealthChecks: mc.configurator.GetFeatureFlags().EnableSidecarActiveHealthChecks,
-			UpstreamTrafficSetting:          mc.policyController.GetUpstreamTrafficSetting(policy.UpstreamTrafficSettingGetOpt{MeshService: &meshSvc}),
+			UpstreamTrafficSetting:          upstreamTrafficSetting,
 		}
 		clusterConfigs = append(clusterConfigs, clusterConfigForServicePort)
 
 		hasTrafficSplitWildCard := false
+		gammaRouted := false
 		var routeMatches []*trafficpolicy.HTTPRouteMatchWithWeightedClusters
 		// Check if there is a traffic split corresponding to this service.
 		// The upstream clusters are to be derived from the traffic split backends
@@ -114,8 +130,12 @@ func (mc *MeshCatalog) GetOutboundMeshTrafficPolicy(downstreamIdentity identity.
 		} else {
 			splitSvc.Namespace = meshSvc.Namespace
 		}
-		trafficSplits := mc.meshSpec.ListTrafficSplits(smi.WithTrafficSplitApexService(splitSvc))
-		if len(trafficSplits) > 0 {
+		if gammaRouteMatches := mc.getGammaRouteMatches(downstreamSvcAccount, meshSvc); len(gammaRouteMatches) > 0 {
+			// Program routes to the backends specified in the Gateway API routes attached to this service,
+			// requests not matching any of these routes are rejected with a 404
+			routeMatches = gammaRouteMatches
+			gammaRouted = true
+		} else if trafficSplits := mc.meshSpec.ListTrafficSplits(smi.WithTrafficSplitApexService(splitSvc)); len(trafficSplits) > 0 {
 			// Program routes to the backends specified in the traffic split
 			for _, split := range trafficSplits {
 				routeMatch := new(trafficpolicy.HTTPRouteMatchWithWeightedClusters)
@@ -173,7 +193,7 @@ func (mc *MeshCatalog) GetOutboundMeshTrafficPolicy(downstreamIdentity identity.
 				if route.Path == constants.RegexMatchAll {
 					hasWildCardRoute = true
 				}
-				if err := outboundTrafficPolicy.AddRoute(route, retryPolicy, routeMatch.UpstreamClusters...); err != nil {
+				if err := outboundTrafficPolicy.AddRouteWithFilters(route, retryPolicy, routeMatch.Filters, routeMatch.Timeouts, routeMatch.UpstreamClusters...); err != nil {
 					log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrAddingRouteToOutboundTrafficPolicy)).
 						Msgf("Error adding route to outbound mesh HTTP traffic policy for destination %s", meshSvc)
 					continue
@@ -181,16 +201,26 @@ func (mc *MeshCatalog) GetOutboundMeshTrafficPolicy(downstreamIdentity identity.
 			}
 		}
 		if !hasWildCardRoute {
-			upstreamClusters := mc.getWildCardRouteUpstreamClusters(hasTrafficSplitWildCard, routeMatches)
+			// Requests not matching any GAMMA route hit a wildcard route without upstream clusters,
+			// which the sidecar answers with a 404
+			var upstreamClusters []service.WeightedCluster
+			if !gammaRouted {
+				upstreamClusters = mc.getWildCardRouteUpstreamClusters(hasTrafficSplitWildCard, routeMatches)
+			}
 			if err := outboundTrafficPolicy.AddRoute(trafficpolicy.WildCardRouteMatch, retryPolicy, upstreamClusters...); err != nil {
 				log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrAddingRouteToOutboundTrafficPolicy)).
 					Msgf("Error adding route to outbound mesh HTTP traffic policy for destination %s", meshSvc)
 				continue
 			}
 		}
+		applyRouteTimeouts(outboundTrafficPolicy, upstreamTrafficSetting)
+		applyRouteFaults(outboundTrafficPolicy, mc.policyController.GetMeshFaultInjection(meshSvc, downstreamSvcAccount.Namespace, podLabels))
+		mirrorSvcs = append(mirrorSvcs, mc.applyRouteMirrors(outboundTrafficPolicy, meshSvc, upstreamTrafficSetting, sidecarScope)...)
 		routeConfigPerPort[int(meshSvc.Port)] = append(routeConfigPerPort[int(meshSvc.Port)], outboundTrafficPolicy)
 	}
 
+	clusterConfigs = mc.addMirrorClusterConfigs(clusterConfigs, mirrorSvcs)
+
 	return &trafficpolicy.OutboundMeshTrafficPolicy{
 		TrafficMatches:          trafficMatches,
 		ClustersConfigs:         clusterConfigs,
@@ -608,6 +608,8 @@ func TestGetOutboundMeshTrafficPolicy(t *testing.T) {
 			mockMeshSpec.EXPECT().ListTrafficTargets().Return(trafficTargets).AnyTimes()
 			mockServiceProvider.EXPECT().GetID().Return("test").AnyTimes()
 			mockEndpointProvider.EXPECT().GetID().Return("test").AnyTimes()
+			mockMeshSpec.EXPECT().ListHTTPRoutes(gomock.Any()).Return(nil).AnyTimes()
+			mockMeshSpec.EXPECT().ListGRPCRoutes(gomock.Any()).Return(nil).AnyTimes()
 			// Mock conditional traffic split for service
 			mockMeshSpec.EXPECT().ListTrafficSplits(gomock.Any()).DoAndReturn(
 				func(options ...smi.TrafficSplitListOption) []*split.TrafficSplit {
@@ -646,6 +648,8 @@ func TestGetOutboundMeshTrafficPolicy(t *testing.T) {
 				}).AnyTimes()
 
 			mockPolicyController.EXPECT().ListIsolationPolicies().Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetSidecarScope(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetMeshFaultInjection(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 			// Mock calls to UpstreamTrafficSetting lookups
 			mockPolicyController.EXPECT().GetUpstreamTrafficSetting(gomock.Any()).DoAndReturn(
@@ -663,7 +667,7 @@ func TestGetOutboundMeshTrafficPolicy(t *testing.T) {
 					return nil
 				}).AnyTimes()
 
-			actual := mc.GetOutboundMeshTrafficPolicy(downstreamIdentity)
+			actual := mc.GetOutboundMeshTrafficPolicy(downstreamIdentity, nil)
 			assert.NotNil(actual)
 
 			// Verify expected fields
@@ -0,0 +1,65 @@
+package catalog
+
+import (
+	"github.com/flomesh-io/fsm/pkg/jwks"
+	"github.com/flomesh-io/fsm/pkg/service"
+	"github.com/flomesh-io/fsm/pkg/trafficpolicy"
+)
+
+// GetRequestAuthenticationTrafficPolicy returns the request authentication traffic policy for the given mesh service,
+// the JSON Web Key Set of each JWT rule is resolved inline so that the sidecars don't need to fetch it.
+// A rule whose JWKS can't be resolved gets an empty key set, so that the requests are rejected rather than let through.
+func (mc *MeshCatalog) GetRequestAuthenticationTrafficPolicy(svc service.MeshService) (*trafficpolicy.RequestAuthenticationTrafficPolicy, error) {
+	authnPolicy := mc.policyController.GetRequestAuthenticationPolicy(svc)
+	if authnPolicy == nil {
+		log.Trace().Msgf("Did not find RequestAuthentication policy for service %s", svc)
+		return nil, nil
+	}
+
+	policy := &trafficpolicy.RequestAuthenticationTrafficPolicy{
+		Port:              uint32(svc.TargetPort),
+		AllowMissingToken: authnPolicy.Spec.AllowMissingToken,
+	}
+
+	for _, rule := range authnPolicy.Spec.JWTRules {
+		rule := *rule.DeepCopy()
+
+		switch {
+		case len(rule.JWKS) > 0:
+			keySet, err := jwks.Parse(rule.JWKS)
+			if err != nil {
+				log.Error().Err(err).Msgf("Invalid JWKS of issuer %s in RequestAuthentication %s/%s, rejecting its tokens", rule.Issuer, authnPolicy.Namespace, authnPolicy.Name)
+				keySet = jwks.EmptyKeySet
+			}
+			rule.JWKS = keySet
+		case len(rule.JWKSURI) > 0 && mc.jwksCache != nil:
+			// The key set is fetched in the background if not cached yet, the proxies are updated once it is
+			keySet, err := mc.jwksCache.Get(rule.JWKSURI)
+			if err != nil {
+				log.Warn().Err(err).Msgf("JWKS of issuer %s in RequestAuthentication %s/%s is not resolved, rejecting its tokens", rule.Issuer, authnPolicy.Namespace, authnPolicy.Name)
+				keySet = jwks.EmptyKeySet
+			}
+			rule.JWKS = keySet
+		default:
+			log.Warn().Msgf("No JWKS of issuer %s is resolved in RequestAuthentication %s/%s, rejecting its tokens", rule.Issuer, authnPolicy.Namespace, authnPolicy.Name)
+			rule.JWKS = jwks.EmptyKeySet
+		}
+
+		policy.JWTRules = append(policy.JWTRules, rule)
+	}
+
+	return policy, nil
+}
+
+// listJWKSURIs returns the URIs of the key sets referenced by the RequestAuthentication policies
+func (mc *MeshCatalog) listJWKSURIs() []string {
+	var uris []string
+	for _, authnPolicy := range mc.policyController.ListRequestAuthenticationPolicies() {
+		for _, rule := range authnPolicy.Spec.JWTRules {
+			if len(rule.JWKS) == 0 && len(rule.JWKSURI) > 0 {
+				uris = append(uris, rule.JWKSURI)
+			}
+		}
+	}
+	return uris
+}
@@ -0,0 +1,79 @@
+package catalog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/jwks"
+	"github.com/flomesh-io/fsm/pkg/policy"
+	"github.com/flomesh-io/fsm/pkg/service"
+)
+
+func TestGetRequestAuthenticationTrafficPolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	svc := service.MeshService{Name: "s1", Namespace: "ns1", Port: 8080, TargetPort: 80, Protocol: "http"}
+
+	testCases := []struct {
+		name         string
+		rule         policyv1alpha1.JWTRule
+		expectedJWKS string
+	}{
+		{
+			name:         "inline JWKS",
+			rule:         policyv1alpha1.JWTRule{Issuer: "idp", JWKS: `{"keys": [{"kty": "RSA", "kid": "v1", "e": "AQAB", "n": "AQAB"}]}`},
+			expectedJWKS: `{"keys":[{"e":"AQAB","kid":"v1","kty":"RSA","n":"AQAB"}]}`,
+		},
+		{
+			name:         "invalid inline JWKS",
+			rule:         policyv1alpha1.JWTRule{Issuer: "idp", JWKS: `{"keys": []}`},
+			expectedJWKS: jwks.EmptyKeySet,
+		},
+		{
+			name:         "unresolved JWKS URI",
+			rule:         policyv1alpha1.JWTRule{Issuer: "idp", JWKSURI: server.URL + "/jwks"},
+			expectedJWKS: jwks.EmptyKeySet,
+		},
+		{
+			name:         "no JWKS",
+			rule:         policyv1alpha1.JWTRule{Issuer: "idp"},
+			expectedJWKS: jwks.EmptyKeySet,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			mockPolicyController := policy.NewMockController(mockCtrl)
+
+			mc := MeshCatalog{
+				policyController: mockPolicyController,
+				jwksCache:        jwks.NewCache(nil, 0, nil, nil),
+			}
+
+			mockPolicyController.EXPECT().GetRequestAuthenticationPolicy(svc).Return(&policyv1alpha1.RequestAuthentication{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "authn"},
+				Spec: policyv1alpha1.RequestAuthenticationSpec{
+					JWTRules: []policyv1alpha1.JWTRule{tc.rule},
+				},
+			})
+
+			actual, err := mc.GetRequestAuthenticationTrafficPolicy(svc)
+			assert.NoError(err)
+			assert.Equal(uint32(svc.TargetPort), actual.Port)
+			// the rule is kept so that its tokens are verified against its key set, even if it is empty
+			assert.Len(actual.JWTRules, 1)
+			assert.Equal(tc.expectedJWKS, actual.JWTRules[0].JWKS)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package catalog
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/trafficpolicy"
+)
+
+// applyRouteTimeouts sets the timeouts of the routes of the given outbound traffic policy from the
+// UpstreamTrafficSetting of the upstream service, the timeouts set by Gateway API routes take precedence
+func applyRouteTimeouts(outboundTrafficPolicy *trafficpolicy.OutboundTrafficPolicy, upstreamTrafficSetting *policyv1alpha1.UpstreamTrafficSetting) {
+	if upstreamTrafficSetting == nil {
+		return
+	}
+
+	routeTimeouts := make(map[string]*policyv1alpha1.HTTPRouteTimeoutsSpec)
+	for _, httpRoute := range upstreamTrafficSetting.Spec.HTTPRoutes {
+		if httpRoute.Timeouts != nil {
+			routeTimeouts[httpRoute.Path] = httpRoute.Timeouts
+		}
+	}
+	if len(routeTimeouts) == 0 {
+		return
+	}
+
+	for _, route := range outboundTrafficPolicy.Routes {
+		spec, ok := routeTimeouts[route.HTTPRouteMatch.Path]
+		if !ok {
+			continue
+		}
+
+		// the timeouts may be shared with other routes
+		timeouts := &trafficpolicy.HTTPRouteTimeouts{}
+		if route.Timeouts != nil {
+			*timeouts = *route.Timeouts
+		}
+		if timeouts.Request == nil {
+			timeouts.Request = toTimeout(spec.Request)
+		}
+		if timeouts.Idle == nil {
+			timeouts.Idle = toTimeout(spec.Idle)
+		}
+		if timeouts.StreamDuration == nil {
+			timeouts.StreamDuration = toTimeout(spec.StreamDuration)
+		}
+		if *timeouts != (trafficpolicy.HTTPRouteTimeouts{}) {
+			route.Timeouts = timeouts
+		}
+	}
+}
+
+// toTimeout returns the timeout of the given duration, a zero duration disables the timeout
+func toTimeout(duration *metav1.Duration) *time.Duration {
+	if duration == nil || duration.Duration <= 0 {
+		return nil
+	}
+	d := duration.Duration
+	return &d
+}
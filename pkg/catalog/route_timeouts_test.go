@@ -0,0 +1,51 @@
+package catalog
+
+import (
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/trafficpolicy"
+)
+
+func TestApplyRouteTimeouts(t *testing.T) {
+	assert := tassert.New(t)
+
+	second, minute := time.Second, time.Minute
+	gatewayTimeouts := &trafficpolicy.HTTPRouteTimeouts{Request: &second}
+	upstreamTrafficSetting := &policyv1alpha1.UpstreamTrafficSetting{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "httpbin"},
+		Spec: policyv1alpha1.UpstreamTrafficSettingSpec{
+			Host: "httpbin.test.svc.cluster.local",
+			HTTPRoutes: []policyv1alpha1.HTTPRouteSpec{
+				{Path: "/api", Timeouts: &policyv1alpha1.HTTPRouteTimeoutsSpec{
+					Request:        &metav1.Duration{Duration: 5 * time.Second},
+					Idle:           &metav1.Duration{Duration: time.Minute},
+					StreamDuration: &metav1.Duration{Duration: 0},
+				}},
+				{Path: "/disabled", Timeouts: &policyv1alpha1.HTTPRouteTimeoutsSpec{
+					Request: &metav1.Duration{Duration: 0},
+				}},
+			},
+		},
+	}
+
+	outboundTrafficPolicy := newTestOutboundTrafficPolicy("/api", "/disabled", ".*")
+	outboundTrafficPolicy.Routes[0].Timeouts = gatewayTimeouts
+	outboundTrafficPolicy.Routes[2].Timeouts = gatewayTimeouts
+
+	applyRouteTimeouts(outboundTrafficPolicy, upstreamTrafficSetting)
+
+	// the Gateway API request timeout takes precedence
+	assert.Equal(&trafficpolicy.HTTPRouteTimeouts{Request: &second, Idle: &minute}, outboundTrafficPolicy.Routes[0].Timeouts)
+	assert.Nil(outboundTrafficPolicy.Routes[1].Timeouts)
+	assert.Equal(gatewayTimeouts, outboundTrafficPolicy.Routes[2].Timeouts)
+	// the shared timeouts are left untouched
+	assert.Nil(gatewayTimeouts.Idle)
+
+	applyRouteTimeouts(outboundTrafficPolicy, nil)
+	assert.Equal(gatewayTimeouts, outboundTrafficPolicy.Routes[2].Timeouts)
+}
@@ -13,8 +13,10 @@ import (
 	"github.com/flomesh-io/fsm/pkg/configurator"
 	"github.com/flomesh-io/fsm/pkg/endpoint"
 	"github.com/flomesh-io/fsm/pkg/identity"
+	"github.com/flomesh-io/fsm/pkg/jwks"
 	"github.com/flomesh-io/fsm/pkg/k8s"
 	"github.com/flomesh-io/fsm/pkg/logger"
+	"github.com/flomesh-io/fsm/pkg/models"
 	"github.com/flomesh-io/fsm/pkg/multicluster"
 	"github.com/flomesh-io/fsm/pkg/plugin"
 	"github.com/flomesh-io/fsm/pkg/policy"
@@ -52,6 +54,9 @@ type MeshCatalog struct {
 	// multiclusterController implements the functionality related to the resources part of the flomesh.io
 	// API group, such a serviceimport.
 	multiclusterController multicluster.Controller
+
+	// jwksCache caches the JSON Web Key Sets referenced by the RequestAuthentication policies
+	jwksCache *jwks.Cache
 }
 
 // MeshCataloger is the mechanism by which the Service Mesh controller discovers all sidecar proxies connected to the catalog.
@@ -78,6 +83,9 @@ type MeshCataloger interface {
 	// GetAccessControlTrafficPolicy returns the access control traffic policy for the given mesh service
 	GetAccessControlTrafficPolicy(service.MeshService) (*trafficpolicy.AccessControlTrafficPolicy, error)
 
+	// GetRequestAuthenticationTrafficPolicy returns the request authentication traffic policy for the given mesh service
+	GetRequestAuthenticationTrafficPolicy(service.MeshService) (*trafficpolicy.RequestAuthenticationTrafficPolicy, error)
+
 	// ListInboundTrafficTargetsWithRoutes returns a list traffic target objects composed of its routes for the given destination service identity
 	ListInboundTrafficTargetsWithRoutes(identity.ServiceIdentity) ([]trafficpolicy.TrafficTargetWithRoutes, error)
 
@@ -93,8 +101,8 @@ type MeshCataloger interface {
 	// GetKubeController returns the kube controller instance handling the current cluster
 	GetKubeController() k8s.Controller
 
-	// GetOutboundMeshTrafficPolicy returns the outbound mesh traffic policy for the given downstream identity
-	GetOutboundMeshTrafficPolicy(identity.ServiceIdentity) *trafficpolicy.OutboundMeshTrafficPolicy
+	// GetOutboundMeshTrafficPolicy returns the outbound mesh traffic policy for the given downstream identity and pod labels
+	GetOutboundMeshTrafficPolicy(identity.ServiceIdentity, map[string]string) *trafficpolicy.OutboundMeshTrafficPolicy
 
 	// GetInboundMeshTrafficPolicy returns the inbound mesh traffic policy for the given upstream identity and services
 	GetInboundMeshTrafficPolicy(identity.ServiceIdentity, []service.MeshService) *trafficpolicy.InboundMeshTrafficPolicy
@@ -104,6 +112,12 @@ type MeshCataloger interface {
 
 	GetTrafficWarmupPolicy(svc service.MeshService) *configv1alpha3.TrafficWarmupSpec
 
+	// GetLocalityZone returns the topology zone of the given proxy if zone aware load balancing is enabled
+	GetLocalityZone(proxy models.Proxy) string
+
+	// ApplyLocalityLoadBalancing weights the given upstream endpoints to prefer the ones in the given zone
+	ApplyLocalityLoadBalancing(zone string, endpoints []endpoint.Endpoint) []endpoint.Endpoint
+
 	// GetExportTrafficPolicy returns the export policy for the given mesh service
 	GetExportTrafficPolicy(svc service.MeshService) (*trafficpolicy.ServiceExportTrafficPolicy, error)
 
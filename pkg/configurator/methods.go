@@ -32,6 +32,12 @@ const (
 
 	// maxCertKeyBitSize is the maximum certificate key bit size
 	maxCertKeyBitSize = 4096
+
+	// defaultConfigUpdateDebounceWindow is the default sliding window used to coalesce proxy update events
+	defaultConfigUpdateDebounceWindow = 2 * time.Second
+
+	// defaultConfigUpdateMaxDelay is the default max amount of time a proxy update event can be coalesced
+	defaultConfigUpdateMaxDelay = 10 * time.Second
 )
 
 // The functions in this file implement the configurator.Configurator interface
@@ -381,6 +387,38 @@ func (c *Client) GetConfigResyncInterval() time.Duration {
 	return duration
 }
 
+// GetConfigUpdateDebounceWindow returns the sliding window used to coalesce proxy update events,
+// and a default in case of unspecified or invalid duration
+func (c *Client) GetConfigUpdateDebounceWindow() time.Duration {
+	durationStr := c.getMeshConfig().Spec.Sidecar.ConfigUpdateDebounceWindow
+	if len(durationStr) == 0 {
+		return defaultConfigUpdateDebounceWindow
+	}
+	window, err := time.ParseDuration(durationStr)
+	if err != nil || window < 0 {
+		log.Warn().Msgf("Invalid config update debounce window %s, using default %v", durationStr, defaultConfigUpdateDebounceWindow)
+		return defaultConfigUpdateDebounceWindow
+	}
+	return window
+}
+
+// GetConfigUpdateMaxDelay returns the max amount of time a proxy update event can be coalesced,
+// and a default in case of unspecified or invalid duration. It is never shorter than the debounce window.
+func (c *Client) GetConfigUpdateMaxDelay() time.Duration {
+	maxDelay := defaultConfigUpdateMaxDelay
+	if durationStr := c.getMeshConfig().Spec.Sidecar.ConfigUpdateMaxDelay; len(durationStr) > 0 {
+		if delay, err := time.ParseDuration(durationStr); err != nil || delay < 0 {
+			log.Warn().Msgf("Invalid config update max delay %s, using default %v", durationStr, defaultConfigUpdateMaxDelay)
+		} else {
+			maxDelay = delay
+		}
+	}
+	if window := c.GetConfigUpdateDebounceWindow(); maxDelay < window {
+		return window
+	}
+	return maxDelay
+}
+
 // GetProxyResources returns the `Resources` configured for proxies, if any
 func (c *Client) GetProxyResources() corev1.ResourceRequirements {
 	return c.getMeshConfig().Spec.Sidecar.Resources
@@ -309,6 +309,25 @@ func TestCreateUpdateConfig(t *testing.T) {
 				assert.Equal(interval, time.Duration(0))
 			},
 		},
+		{
+			name:                  "GetConfigUpdateWindow",
+			initialMeshConfigData: &configv1alpha3.MeshConfigSpec{},
+			checkCreate: func(assert *tassert.Assertions, cfg Configurator) {
+				assert.Equal(2*time.Second, cfg.GetConfigUpdateDebounceWindow())
+				assert.Equal(10*time.Second, cfg.GetConfigUpdateMaxDelay())
+			},
+			updatedMeshConfigData: &configv1alpha3.MeshConfigSpec{
+				Sidecar: configv1alpha3.SidecarSpec{
+					ConfigUpdateDebounceWindow: "5s",
+					ConfigUpdateMaxDelay:       "1s",
+				},
+			},
+			checkUpdate: func(assert *tassert.Assertions, cfg Configurator) {
+				assert.Equal(5*time.Second, cfg.GetConfigUpdateDebounceWindow())
+				// The max delay is never shorter than the debounce window
+				assert.Equal(5*time.Second, cfg.GetConfigUpdateMaxDelay())
+			},
+		},
 		{
 			name:                  "GetMaxDataplaneConnections",
 			initialMeshConfigData: &configv1alpha3.MeshConfigSpec{},
@@ -93,6 +93,34 @@ func (mr *MockConfiguratorMockRecorder) GetConfigResyncInterval() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigResyncInterval", reflect.TypeOf((*MockConfigurator)(nil).GetConfigResyncInterval))
 }
 
+// GetConfigUpdateDebounceWindow mocks base method.
+func (m *MockConfigurator) GetConfigUpdateDebounceWindow() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfigUpdateDebounceWindow")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GetConfigUpdateDebounceWindow indicates an expected call of GetConfigUpdateDebounceWindow.
+func (mr *MockConfiguratorMockRecorder) GetConfigUpdateDebounceWindow() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigUpdateDebounceWindow", reflect.TypeOf((*MockConfigurator)(nil).GetConfigUpdateDebounceWindow))
+}
+
+// GetConfigUpdateMaxDelay mocks base method.
+func (m *MockConfigurator) GetConfigUpdateMaxDelay() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfigUpdateMaxDelay")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GetConfigUpdateMaxDelay indicates an expected call of GetConfigUpdateMaxDelay.
+func (mr *MockConfiguratorMockRecorder) GetConfigUpdateMaxDelay() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigUpdateMaxDelay", reflect.TypeOf((*MockConfigurator)(nil).GetConfigUpdateMaxDelay))
+}
+
 // GetFLBSecretName mocks base method.
 func (m *MockConfigurator) GetFLBSecretName() string {
 	m.ctrl.T.Helper()
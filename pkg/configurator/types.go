@@ -167,6 +167,12 @@ type Configurator interface {
 	// If error or non-parsable value, returns 0 duration
 	GetConfigResyncInterval() time.Duration
 
+	// GetConfigUpdateDebounceWindow returns the sliding window used to coalesce proxy update events
+	GetConfigUpdateDebounceWindow() time.Duration
+
+	// GetConfigUpdateMaxDelay returns the max amount of time a proxy update event can be coalesced
+	GetConfigUpdateMaxDelay() time.Duration
+
 	// GetProxyResources returns the `Resources` configured for proxies, if any
 	GetProxyResources() corev1.ResourceRequirements
 
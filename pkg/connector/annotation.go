@@ -38,6 +38,10 @@ const (
 	// AnnotationCloudServiceInheritedClusterID defines cloud service cluster id annotation
 	AnnotationCloudServiceInheritedClusterID = "flomesh.io/cloud-service-inherited-cluster-id"
 
+	// AnnotationCloudServiceNativeDNSNames defines the comma separated native DNS names
+	// the cloud service is resolvable by from within the mesh
+	AnnotationCloudServiceNativeDNSNames = "flomesh.io/cloud-service-native-dns-names"
+
 	// AnnotationMeshEndpointAddr defines mesh endpoint addr annotation
 	AnnotationMeshEndpointAddr = "flomesh.io/cloud-endpoint-addr"
 )
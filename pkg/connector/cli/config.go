@@ -82,6 +82,8 @@ type config struct {
 		withGateway   bool
 		multiGateways bool
 
+		nativeDNS ctv1.C2KNativeDNS
+
 		nacos2kCfg struct {
 			clusterSet []string
 			groupSet   []string
@@ -793,6 +795,18 @@ func (c *config) GetC2KMultiGateways() bool {
 	return c.c2kCfg.multiGateways
 }
 
+func (c *config) EnableC2KNativeDNS() bool {
+	c.flock.RLock()
+	defer c.flock.RUnlock()
+	return c.c2kCfg.nativeDNS.Enable
+}
+
+func (c *config) GetC2KNativeDNSDomain() string {
+	c.flock.RLock()
+	defer c.flock.RUnlock()
+	return c.c2kCfg.nativeDNS.Domain
+}
+
 func (c *config) GetNacos2KClusterSet() []string {
 	c.flock.RLock()
 	defer c.flock.RUnlock()
@@ -1001,6 +1015,7 @@ func (c *client) initNacosConnectorConfig(spec ctv1.NacosSpec) {
 	c.c2kCfg.metadataStrategy = spec.SyncToK8S.MetadataStrategy
 	c.c2kCfg.withGateway = spec.SyncToK8S.WithGateway.Enable
 	c.c2kCfg.multiGateways = spec.SyncToK8S.WithGateway.MultiGateways
+	c.c2kCfg.nativeDNS = spec.SyncToK8S.NativeDNS
 	if len(spec.SyncToK8S.ClusterSet) == 0 {
 		c.c2kCfg.nacos2kCfg.clusterSet = []string{connector.NACOS_DEFAULT_CLUSTER}
 	} else {
@@ -1156,6 +1171,7 @@ func (c *client) initConsulConnectorConfig(spec ctv1.ConsulSpec) {
 	c.c2kCfg.metadataStrategy = spec.SyncToK8S.MetadataStrategy
 	c.c2kCfg.withGateway = spec.SyncToK8S.WithGateway.Enable
 	c.c2kCfg.multiGateways = spec.SyncToK8S.WithGateway.MultiGateways
+	c.c2kCfg.nativeDNS = spec.SyncToK8S.NativeDNS
 
 	if spec.SyncToK8S.ConversionStrategy != nil {
 		c.c2kCfg.enableConversions = spec.SyncToK8S.ConversionStrategy.Enable
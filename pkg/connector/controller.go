@@ -79,6 +79,9 @@ type ConnectController interface {
 	GetC2KWithGateway() bool
 	GetC2KMultiGateways() bool
 
+	EnableC2KNativeDNS() bool
+	GetC2KNativeDNSDomain() string
+
 	GetNacos2KClusterSet() []string
 	GetNacos2KGroupSet() []string
 
@@ -117,6 +117,7 @@ func (s *CtoKSource) aggregateMeta(svcMetaMap map[connector.KubeSvcName]*connect
 	}
 	endpointMeta.Address = *instance.MicroService.EndpointAddress()
 	endpointMeta.Native.ClusterId = instance.ClusterId
+	endpointMeta.Native.Group = instance.Group
 	endpointMeta.Native.ViaGatewayMode = ctv1.Forward
 	if viaGatewayModeIf, ok := instance.Meta[connector.CloudViaGatewayMode]; ok {
 		if viaGatewayMode, str := viaGatewayModeIf.(string); str {
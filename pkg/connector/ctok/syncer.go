@@ -15,6 +15,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -391,8 +392,13 @@ func (s *CtoKSyncer) crudList() (createSvcs []*syncCreate, deleteSvcs []connecto
 					continue
 				}
 			}
+			svcAnnotations := annotations
 			if !strings.EqualFold(string(k8sSvcName), string(kubeSvcName)) {
 				extendServices[k8sSvcName] = cloudSvcName
+			} else if nativeDNSNames := s.nativeDNSNames(cloudSvcName, svcMeta); len(nativeDNSNames) > 0 {
+				// only the primary service answers to the native DNS names
+				svcAnnotations = maps.Clone(annotations)
+				svcAnnotations[connector.AnnotationCloudServiceNativeDNSNames] = nativeDNSNames
 			}
 
 			// If this is an already registered service, then update it
@@ -400,7 +406,7 @@ func (s *CtoKSyncer) crudList() (createSvcs []*syncCreate, deleteSvcs []connecto
 				updateSvc := *svc
 				updateSvc.Spec = *serviceSpec
 				updateSvc.Labels = maps.Clone(labels)
-				updateSvc.Annotations = maps.Clone(annotations)
+				updateSvc.Annotations = maps.Clone(svcAnnotations)
 				if svcMeta.HealthCheck {
 					updateSvc.Annotations[connector.AnnotationCloudHealthCheckService] = True
 					updateSvc.Annotations[connector.AnnotationServiceSyncK8sToFgw] = False
@@ -420,7 +426,7 @@ func (s *CtoKSyncer) crudList() (createSvcs []*syncCreate, deleteSvcs []connecto
 				ObjectMeta: metav1.ObjectMeta{
 					Name:        string(k8sSvcName),
 					Labels:      maps.Clone(labels),
-					Annotations: maps.Clone(annotations),
+					Annotations: maps.Clone(svcAnnotations),
 				},
 				Spec: *serviceSpec,
 			}
@@ -531,6 +537,60 @@ func (s *CtoKSyncer) existPort(svc *corev1.Service, port connector.MicroServiceP
 	return false
 }
 
+// nativeDNSNames returns the comma separated names the cloud service is known by
+// in its registry's own DNS, or an empty string if native DNS names are disabled.
+func (s *CtoKSyncer) nativeDNSNames(cloudSvcName connector.CloudSvcName, svcMeta *connector.MicroSvcMeta) string {
+	if !s.controller.EnableC2KNativeDNS() {
+		return ""
+	}
+
+	svcName := strings.ToLower(string(cloudSvcName))
+	domain := strings.ToLower(strings.Trim(s.controller.GetC2KNativeDNSDomain(), "."))
+
+	var names []string
+	switch s.discClient.MicroServiceProvider() {
+	case ctv1.ConsulDiscoveryService:
+		if len(domain) == 0 {
+			domain = "consul"
+		}
+		names = append(names, fmt.Sprintf("%s.service.%s", svcName, domain))
+	case ctv1.NacosDiscoveryService:
+		if len(domain) == 0 {
+			domain = "nacos"
+		}
+		// the service is named after the groups its endpoints are registered in
+		groups := make(map[string]bool)
+		for _, endpointMeta := range svcMeta.Endpoints {
+			if group := dnsLabel(endpointMeta.Native.Group); len(group) > 0 && !groups[group] {
+				groups[group] = true
+				names = append(names, fmt.Sprintf("%s.%s.%s", svcName, group, domain))
+			}
+		}
+		sort.Strings(names)
+	}
+
+	return strings.Join(names, ",")
+}
+
+// dnsLabel converts the given name to a DNS-1123 label, lower casing it and replacing
+// the characters a label cannot hold with '-', e.g. DEFAULT_GROUP becomes default-group.
+func dnsLabel(name string) string {
+	label := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r - 'A' + 'a'
+		default:
+			return '-'
+		}
+	}, name)
+	if len(label) > validation.DNS1123LabelMaxLength {
+		label = label[:validation.DNS1123LabelMaxLength]
+	}
+	return strings.Trim(label, "-")
+}
+
 // namespace returns the K8S namespace to setup the resource watchers in.
 func (s *CtoKSyncer) namespace() string {
 	if deriveNamespace := s.controller.GetDeriveNamespace(); len(deriveNamespace) > 0 {
@@ -73,6 +73,7 @@ type AgentService struct {
 	ID           string
 	InstanceId   string
 	ClusterId    string
+	Group        string
 	MicroService MicroService
 	Weights      AgentWeights
 	Ports        map[MicroServicePort]MicroServicePort
@@ -148,7 +149,9 @@ func (as *AgentService) FromNacos(ins *nacos.Instance) {
 		return
 	}
 	as.ID = ins.InstanceId
-	as.MicroService.Service = strings.ToLower(strings.Split(ins.ServiceName, constant.SERVICE_INFO_SPLITER)[1])
+	svcInfoSegs := strings.Split(ins.ServiceName, constant.SERVICE_INFO_SPLITER)
+	as.Group = svcInfoSegs[0]
+	as.MicroService.Service = strings.ToLower(svcInfoSegs[1])
 	as.InstanceId = ins.InstanceId
 	as.MicroService.Protocol().SetVar(ProtocolHTTP)
 	as.MicroService.Endpoint().Set(MicroServiceAddress(ins.Ip), MicroServicePort(ins.Port))
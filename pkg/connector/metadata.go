@@ -35,6 +35,7 @@ type MicroEndpointMeta struct {
 	Native struct {
 		ClusterSet     string               `json:"clusterSet,omitempty"`
 		ClusterId      string               `json:"clusterId,omitempty"`
+		Group          string               `json:"group,omitempty"`
 		ViaGatewayHTTP string               `json:"viaGatewayHttp,omitempty"`
 		ViaGatewayGRPC string               `json:"viaGatewayGrpc,omitempty"`
 		ViaGatewayMode ctv1.WithGatewayMode `json:"viaGatewayMode,omitempty"`
@@ -305,6 +305,15 @@ const (
 	EgressGatewayModeSock5 = "sock5"
 )
 
+// Egress Gateway host rules
+const (
+	// EgressGatewayRulesSecretName is the name of the secret holding the rendered host rules of egress gateway
+	EgressGatewayRulesSecretName = "fsm-egress-gateway-rules" // #nosec G101: Potential hardcoded credentials
+
+	// EgressGatewayRulesSecretKey is the key of the rendered host rules in the egress gateway rules secret
+	EgressGatewayRulesSecretKey = "rules.json"
+)
+
 // Annotations used for sidecar
 const (
 	// SidecarResourceLimitsAnnotationPrefix is the key of the annotation used to indicate sidecar resource limits annotation prefix
@@ -365,6 +374,15 @@ const (
 	// FSMControllerSMIVersionPath is the path at which FSM controller servers SMI version info
 	FSMControllerSMIVersionPath = "/smi/version"
 
+	// FSMControllerEgressAuditPath is the path at which FSM controller serves the egress destinations audited by sidecars
+	FSMControllerEgressAuditPath = "/egress/audit"
+
+	// FSMControllerServiceDependenciesPath is the path at which FSM controller serves the services called by workloads
+	FSMControllerServiceDependenciesPath = "/mesh/dependencies"
+
+	// FSMControllerConfigPropagationPath is the path at which FSM controller serves the propagation of policy changes to proxies
+	FSMControllerConfigPropagationPath = "/proxy/sync-status"
+
 	// MetricsPath is the path at which FSM controller serves metrics
 	MetricsPath = "/metrics"
 
@@ -464,6 +482,9 @@ const (
 	// KubernetesServiceKind is the kind name of Service used in Kubernetes Core API
 	KubernetesServiceKind = "Service"
 
+	// KubernetesNamespaceKind is the kind name of Namespace used in Kubernetes Core API
+	KubernetesNamespaceKind = "Namespace"
+
 	// KubernetesSecretKind is the kind name of Secret used in Kubernetes Core API
 	KubernetesSecretKind = "Secret"
 
@@ -529,6 +550,9 @@ const (
 
 	// GatewayDNSModifierKind is the kind name of DNSModifier used in Flomesh API
 	GatewayDNSModifierKind = "DNSModifier"
+
+	// GatewayJWTAuthKind is the kind name of JWTAuth used in Flomesh API
+	GatewayJWTAuthKind = "JWTAuth"
 )
 
 // Gateway API Annotations and Labels
@@ -562,6 +586,9 @@ const (
 
 	// GatewayController is the name of the FSM gateway controller
 	GatewayController = "flomesh.io/gateway-controller"
+
+	// MeshController is the name of the FSM mesh controller, which manages the routes attached to Services (GAMMA)
+	MeshController = "flomesh.io/mesh-controller"
 )
 
 // GatewayAPI Resources Indexer constants
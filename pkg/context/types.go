@@ -22,6 +22,7 @@ import (
 	"github.com/flomesh-io/fsm/pkg/certificate"
 	"github.com/flomesh-io/fsm/pkg/configurator"
 	"github.com/flomesh-io/fsm/pkg/messaging"
+	"github.com/flomesh-io/fsm/pkg/propagation"
 )
 
 // ControllerCtxKey the pointer is the key that a ControllerContext returns itself for.
@@ -31,17 +32,18 @@ var ControllerCtxKey int
 type ControllerContext struct {
 	context.Context
 
-	ProxyServerPort   uint32
-	ProxyServiceCert  *certificate.Certificate
-	FsmNamespace      string
-	FsmServiceAccount string
-	KubeConfig        *rest.Config
-	Configurator      configurator.Configurator
-	MeshCatalog       catalog.MeshCataloger
-	CertManager       *certificate.Manager
-	MsgBroker         *messaging.Broker
-	CancelFunc        func()
-	Stop              chan struct{}
+	ProxyServerPort    uint32
+	ProxyServiceCert   *certificate.Certificate
+	FsmNamespace       string
+	FsmServiceAccount  string
+	KubeConfig         *rest.Config
+	Configurator       configurator.Configurator
+	MeshCatalog        catalog.MeshCataloger
+	CertManager        *certificate.Manager
+	MsgBroker          *messaging.Broker
+	PropagationTracker *propagation.Tracker
+	CancelFunc         func()
+	Stop               chan struct{}
 
 	// Merge with FSM ControllerContext to simplify the code
 	client.Client
@@ -45,7 +45,9 @@ import (
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	mcsv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/multicluster/v1alpha1"
 	"github.com/flomesh-io/fsm/pkg/configurator"
@@ -66,6 +68,7 @@ type reconciler struct {
 	mu       sync.Mutex
 	server   *cp.ControlPlaneServer
 	webhook  whtypes.Register
+	health   map[string]*clusterHealth
 }
 
 func (r *reconciler) NeedLeaderElection() bool {
@@ -84,6 +87,7 @@ func NewReconciler(ctx *fctx.ControllerContext, webhook whtypes.Register) contro
 		stopCh:   utils.RegisterOSExitHandlers(),
 		server:   cp.NewControlPlaneServer(ctx.Configurator, ctx.MsgBroker),
 		webhook:  webhook,
+		health:   make(map[string]*clusterHealth),
 	}
 
 	go r.server.Run(r.stopCh)
@@ -124,8 +128,11 @@ func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	if cluster.DeletionTimestamp != nil {
 		r.destroyConnector(cluster)
+		return ctrl.Result{}, nil
 	}
 
+	status := cluster.Status.DeepCopy()
+
 	mc := r.fctx.Configurator
 
 	result, err := r.deriveCodebases(cluster, mc)
@@ -135,37 +142,74 @@ func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	key := cluster.Key()
 	log.Debug().Msgf("Cluster key is %s", key)
+	interval, timeout, failureThreshold, gracePeriod := healthCheckSettings(cluster.Spec.HealthCheck)
+	health := r.getHealth(key)
+
 	bg, exists := r.server.GetBackground(key)
 	if exists && bg.Context.Hash != clusterHash(cluster) {
 		log.Debug().Msgf("Background context of cluster [%s] exists, ", key)
 		// exists and the spec changed, then stop it and start a new one
-		if result, err = r.recreateConnector(ctx, bg, cluster, mc); err != nil {
-			return result, err
-		}
+		err = r.recreateConnector(bg, cluster)
 	} else if !exists {
 		// doesn't exist, just create a new one
-		if result, err = r.createConnector(ctx, cluster, mc); err != nil {
-			return result, err
+		err = r.createConnector(cluster)
+	} else {
+		log.Debug().Msgf("The connector %s already exists and the spec doesn't change", key)
+	}
+
+	if err == nil {
+		// the connector stops if it fails to run
+		if bg, exists = r.server.GetBackground(key); !exists {
+			err = fmt.Errorf("connector of cluster %s is stopped", key)
 		}
+	}
+
+	if err != nil {
+		// reconnect with backoff, the connector is recreated in next round
+		health.reconnects++
+		r.failedJoinClusterSet(cluster, err.Error())
+		r.failedHealthCheck(cluster, health, err, failureThreshold, gracePeriod)
+
+		return ctrl.Result{RequeueAfter: reconnectBackoff(interval, health.reconnects)}, r.updateStatus(ctx, cluster, status)
+	}
+
+	health.reconnects = 0
+	r.successJoinClusterSet(cluster)
+
+	if err := bg.Connector.CheckHealth(timeout); err != nil {
+		r.failedHealthCheck(cluster, health, err, failureThreshold, gracePeriod)
 	} else {
-		log.Warn().Msgf("The connector %s already exists and the spec doesn't change", key)
+		if health.withdrawn {
+			// recreate the connector to import the services exported by the cluster again
+			if err := r.recreateConnector(bg, cluster); err != nil {
+				return ctrl.Result{RequeueAfter: interval}, err
+			}
+			health.withdrawn = false
+			if bg, exists = r.server.GetBackground(key); !exists {
+				return ctrl.Result{RequeueAfter: interval}, nil
+			}
+		}
+
+		r.successHealthCheck(cluster, bg, health)
 	}
 
-	return ctrl.Result{}, nil
+	if err := r.updateStatus(ctx, cluster, status); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: interval}, nil
 }
 
 func clusterHash(cluster *mcsv1alpha1.Cluster) string {
 	return utils.SimpleHash(
 		struct {
-			spec            mcsv1alpha1.ClusterSpec
-			resourceVersion string
-			generation      int64
-			uuid            string
+			spec       mcsv1alpha1.ClusterSpec
+			generation int64
+			uuid       string
 		}{
-			spec:            cluster.Spec,
-			resourceVersion: cluster.ResourceVersion,
-			generation:      cluster.Generation,
-			uuid:            string(cluster.UID),
+			spec:       cluster.Spec,
+			generation: cluster.Generation,
+			uuid:       string(cluster.UID),
 		},
 	)
 }
@@ -186,20 +230,20 @@ func (r *reconciler) deriveCodebases(cluster *mcsv1alpha1.Cluster, _ configurato
 	return ctrl.Result{}, nil
 }
 
-func (r *reconciler) createConnector(ctx context.Context, cluster *mcsv1alpha1.Cluster, mc configurator.Configurator) (ctrl.Result, error) {
+func (r *reconciler) createConnector(cluster *mcsv1alpha1.Cluster) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	return r.newConnector(ctx, cluster, mc)
+	return r.newConnector(cluster)
 }
 
-func (r *reconciler) recreateConnector(ctx context.Context, _ *remote.Background, cluster *mcsv1alpha1.Cluster, mc configurator.Configurator) (ctrl.Result, error) {
+func (r *reconciler) recreateConnector(_ *remote.Background, cluster *mcsv1alpha1.Cluster) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	r.server.DestroyBackground(cluster.Key())
 
-	return r.newConnector(ctx, cluster, mc)
+	return r.newConnector(cluster)
 }
 
 func (r *reconciler) destroyConnector(cluster *mcsv1alpha1.Cluster) {
@@ -208,40 +252,35 @@ func (r *reconciler) destroyConnector(cluster *mcsv1alpha1.Cluster) {
 
 	key := cluster.Key()
 	r.server.DestroyBackground(key)
+	delete(r.health, key)
 }
 
-func (r *reconciler) newConnector(ctx context.Context, cluster *mcsv1alpha1.Cluster, mc configurator.Configurator) (ctrl.Result, error) {
+func (r *reconciler) newConnector(cluster *mcsv1alpha1.Cluster) error {
 	key := cluster.Key()
 
-	kubeconfig, result, err := getKubeConfig(cluster)
+	kubeconfig, _, err := getKubeConfig(cluster)
 	if err != nil {
 		log.Error().Msgf("Failed to get kubeconfig for cluster %q: %s", cluster.Key(), err)
-		return result, err
+		return err
 	}
 
 	background, err := remote.NewBackground(cluster, kubeconfig, r.fctx.Configurator, r.fctx.MsgBroker)
 	if err != nil {
-		return ctrl.Result{}, err
+		return err
 	}
 
 	r.server.AddBackground(key, background)
 
-	success := true
-	errorMsg := ""
 	go func() {
 		if err := background.Run(); err != nil {
-			success = false
-			errorMsg = err.Error()
 			log.Error().Msgf("Failed to run connector for cluster %q: %s", cluster.Key(), err)
+			r.mu.Lock()
+			defer r.mu.Unlock()
 			r.server.DestroyBackground(key)
 		}
 	}()
 
-	if success {
-		return r.successJoinClusterSet(ctx, cluster, mc)
-	}
-
-	return r.failedJoinClusterSet(ctx, cluster, errorMsg)
+	return nil
 }
 
 func getKubeConfig(cluster *mcsv1alpha1.Cluster) (*rest.Config, ctrl.Result, error) {
@@ -262,7 +301,7 @@ func getKubeConfig(cluster *mcsv1alpha1.Cluster) (*rest.Config, ctrl.Result, err
 	return kubeconfig, ctrl.Result{}, nil
 }
 
-func (r *reconciler) successJoinClusterSet(ctx context.Context, cluster *mcsv1alpha1.Cluster, _ configurator.Configurator) (ctrl.Result, error) {
+func (r *reconciler) successJoinClusterSet(cluster *mcsv1alpha1.Cluster) {
 	metautil.SetStatusCondition(&cluster.Status.Conditions, metav1.Condition{
 		Type:               string(mcsv1alpha1.ClusterManaged),
 		Status:             metav1.ConditionTrue,
@@ -271,15 +310,9 @@ func (r *reconciler) successJoinClusterSet(ctx context.Context, cluster *mcsv1al
 		Reason:             "Success",
 		Message:            fmt.Sprintf("Cluster %s joined ClusterSet successfully.", cluster.Key()),
 	})
-
-	if err := r.fctx.Status().Update(ctx, cluster); err != nil {
-		return ctrl.Result{}, err
-	}
-
-	return ctrl.Result{}, nil
 }
 
-func (r *reconciler) failedJoinClusterSet(ctx context.Context, cluster *mcsv1alpha1.Cluster, err string) (ctrl.Result, error) {
+func (r *reconciler) failedJoinClusterSet(cluster *mcsv1alpha1.Cluster, err string) {
 	metautil.SetStatusCondition(&cluster.Status.Conditions, metav1.Condition{
 		Type:               string(mcsv1alpha1.ClusterManaged),
 		Status:             metav1.ConditionFalse,
@@ -288,12 +321,6 @@ func (r *reconciler) failedJoinClusterSet(ctx context.Context, cluster *mcsv1alp
 		Reason:             "Failed",
 		Message:            fmt.Sprintf("Cluster %s failed to join ClusterSet: %s.", cluster.Key(), err),
 	})
-
-	if err := r.fctx.Status().Update(ctx, cluster); err != nil {
-		return ctrl.Result{}, err
-	}
-
-	return ctrl.Result{}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -308,7 +335,8 @@ func (r *reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	}
 
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&mcsv1alpha1.Cluster{}).
+		// status is updated in every round of health check, only the spec changes trigger the reconciliation
+		For(&mcsv1alpha1.Cluster{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Owns(&corev1.Secret{}).
 		Owns(&appv1.Deployment{}).
 		Complete(r)
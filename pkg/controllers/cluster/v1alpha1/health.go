@@ -0,0 +1,202 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) since 2021,  flomesh.io Authors.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metautil "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mcsv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/multicluster/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/mcs/remote"
+)
+
+const (
+	defaultHealthCheckInterval         = 10 * time.Second
+	defaultHealthCheckTimeout          = 3 * time.Second
+	defaultHealthCheckFailureThreshold = 3
+	defaultHealthCheckGracePeriod      = 60 * time.Second
+
+	// maxReconnectBackoff is the max interval between two attempts to reconnect a cluster
+	maxReconnectBackoff = 5 * time.Minute
+)
+
+// clusterHealth is the health state of a managed cluster
+type clusterHealth struct {
+	// failures is the number of consecutive failed health checks
+	failures int32
+	// reconnects is the number of consecutive failed attempts to connect the cluster
+	reconnects int
+	// unreachableSince is the time the cluster became unreachable
+	unreachableSince time.Time
+	// withdrawn is true if the endpoints exported by the cluster are withdrawn from the other clusters
+	withdrawn bool
+}
+
+func (r *reconciler) getHealth(key string) *clusterHealth {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	health, ok := r.health[key]
+	if !ok {
+		health = &clusterHealth{}
+		r.health[key] = health
+	}
+
+	return health
+}
+
+func (r *reconciler) successHealthCheck(cluster *mcsv1alpha1.Cluster, bg *remote.Background, health *clusterHealth) {
+	health.failures = 0
+	health.unreachableSince = time.Time{}
+
+	now := metav1.Now()
+	cluster.Status.LastSyncTime = &now
+	cluster.Status.ImportedServices = int32(bg.Connector.CountServiceImports())
+
+	metautil.SetStatusCondition(&cluster.Status.Conditions, metav1.Condition{
+		Type:               string(mcsv1alpha1.ClusterReachable),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: cluster.Generation,
+		LastTransitionTime: now,
+		Reason:             "HealthCheckPassed",
+		Message:            fmt.Sprintf("API server and gateway of cluster %s are reachable.", cluster.Key()),
+	})
+	metautil.SetStatusCondition(&cluster.Status.Conditions, metav1.Condition{
+		Type:               string(mcsv1alpha1.ClusterReady),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: cluster.Generation,
+		LastTransitionTime: now,
+		Reason:             "Ready",
+		Message:            fmt.Sprintf("Cluster %s is ready.", cluster.Key()),
+	})
+}
+
+func (r *reconciler) failedHealthCheck(cluster *mcsv1alpha1.Cluster, health *clusterHealth, err error, failureThreshold int32, gracePeriod time.Duration) {
+	health.failures++
+	log.Warn().Msgf("Health check of cluster %q failed %d time(s): %s", cluster.Key(), health.failures, err)
+
+	// tolerate the transient failures
+	if health.failures < failureThreshold {
+		return
+	}
+
+	now := metav1.Now()
+	if health.unreachableSince.IsZero() {
+		health.unreachableSince = now.Time
+		r.recorder.Eventf(cluster, corev1.EventTypeWarning, "Unreachable", "Cluster %s is unreachable: %s", cluster.Key(), err)
+	}
+
+	metautil.SetStatusCondition(&cluster.Status.Conditions, metav1.Condition{
+		Type:               string(mcsv1alpha1.ClusterReachable),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: cluster.Generation,
+		LastTransitionTime: now,
+		Reason:             "HealthCheckFailed",
+		Message:            err.Error(),
+	})
+	metautil.SetStatusCondition(&cluster.Status.Conditions, metav1.Condition{
+		Type:               string(mcsv1alpha1.ClusterReady),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: cluster.Generation,
+		LastTransitionTime: now,
+		Reason:             "Unreachable",
+		Message:            fmt.Sprintf("Cluster %s has been unreachable since %s.", cluster.Key(), health.unreachableSince.Format(time.RFC3339)),
+	})
+
+	if health.withdrawn || now.Sub(health.unreachableSince) < gracePeriod {
+		return
+	}
+
+	// the cluster stays unreachable beyond the grace period, stop routing traffic to it
+	if err := r.server.WithdrawClusterEndpoints(cluster.Key()); err != nil {
+		log.Error().Msgf("Failed to withdraw endpoints of cluster %q: %s", cluster.Key(), err)
+		return
+	}
+	health.withdrawn = true
+	r.recorder.Eventf(cluster, corev1.EventTypeWarning, "EndpointsWithdrawn", "Endpoints exported by cluster %s are withdrawn as it's unreachable for more than %s", cluster.Key(), gracePeriod)
+}
+
+// updateStatus writes the status of the cluster only if it's changed, the LastSyncTime refreshed
+// in every round of health check alone doesn't count as a change
+func (r *reconciler) updateStatus(ctx context.Context, cluster *mcsv1alpha1.Cluster, previous *mcsv1alpha1.ClusterStatus) error {
+	if !clusterStatusChanged(previous, &cluster.Status) {
+		return nil
+	}
+
+	return r.fctx.Status().Update(ctx, cluster)
+}
+
+func clusterStatusChanged(previous, current *mcsv1alpha1.ClusterStatus) bool {
+	p, c := previous.DeepCopy(), current.DeepCopy()
+	p.LastSyncTime, c.LastSyncTime = nil, nil
+
+	return !equality.Semantic.DeepEqual(p, c)
+}
+
+func healthCheckSettings(hc *mcsv1alpha1.ClusterHealthCheck) (interval, timeout time.Duration, failureThreshold int32, gracePeriod time.Duration) {
+	interval = defaultHealthCheckInterval
+	timeout = defaultHealthCheckTimeout
+	failureThreshold = defaultHealthCheckFailureThreshold
+	gracePeriod = defaultHealthCheckGracePeriod
+
+	if hc == nil {
+		return
+	}
+	if hc.Interval != nil && hc.Interval.Duration > 0 {
+		interval = hc.Interval.Duration
+	}
+	if hc.Timeout != nil && hc.Timeout.Duration > 0 {
+		timeout = hc.Timeout.Duration
+	}
+	if hc.FailureThreshold != nil && *hc.FailureThreshold > 0 {
+		failureThreshold = *hc.FailureThreshold
+	}
+	if hc.GracePeriod != nil && hc.GracePeriod.Duration >= 0 {
+		gracePeriod = hc.GracePeriod.Duration
+	}
+
+	return
+}
+
+// reconnectBackoff returns the interval before the next attempt to reconnect a cluster,
+// it's doubled for every failed attempt and capped by maxReconnectBackoff
+func reconnectBackoff(interval time.Duration, attempts int) time.Duration {
+	backoff := interval
+	for i := 1; i < attempts && backoff < maxReconnectBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > maxReconnectBackoff {
+		return maxReconnectBackoff
+	}
+
+	return backoff
+}
@@ -0,0 +1,63 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+	metautil "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mcsv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/multicluster/v1alpha1"
+)
+
+func TestClusterStatusChanged(t *testing.T) {
+	assert := tassert.New(t)
+
+	earlier := metav1.NewTime(time.Now().Add(-time.Minute))
+	previous := &mcsv1alpha1.ClusterStatus{
+		LastSyncTime:     &earlier,
+		ImportedServices: 2,
+	}
+	metautil.SetStatusCondition(&previous.Conditions, metav1.Condition{
+		Type:               string(mcsv1alpha1.ClusterReady),
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: earlier,
+		Reason:             "Ready",
+	})
+
+	// only the LastSyncTime is refreshed
+	current := previous.DeepCopy()
+	now := metav1.Now()
+	current.LastSyncTime = &now
+	metautil.SetStatusCondition(&current.Conditions, metav1.Condition{
+		Type:               string(mcsv1alpha1.ClusterReady),
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: now,
+		Reason:             "Ready",
+	})
+	assert.False(clusterStatusChanged(previous, current))
+
+	// the number of imported services is changed
+	current.ImportedServices = 3
+	assert.True(clusterStatusChanged(previous, current))
+
+	// the condition is changed
+	current = previous.DeepCopy()
+	metautil.SetStatusCondition(&current.Conditions, metav1.Condition{
+		Type:               string(mcsv1alpha1.ClusterReady),
+		Status:             metav1.ConditionFalse,
+		LastTransitionTime: now,
+		Reason:             "Unreachable",
+	})
+	assert.True(clusterStatusChanged(previous, current))
+}
+
+func TestReconnectBackoff(t *testing.T) {
+	assert := tassert.New(t)
+
+	assert.Equal(10*time.Second, reconnectBackoff(10*time.Second, 1))
+	assert.Equal(20*time.Second, reconnectBackoff(10*time.Second, 2))
+	assert.Equal(80*time.Second, reconnectBackoff(10*time.Second, 4))
+	assert.Equal(maxReconnectBackoff, reconnectBackoff(10*time.Second, 100))
+}
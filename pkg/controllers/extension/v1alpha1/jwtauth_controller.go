@@ -0,0 +1,104 @@
+package v1alpha1
+
+import (
+	"context"
+
+	whtypes "github.com/flomesh-io/fsm/pkg/webhook/types"
+
+	whblder "github.com/flomesh-io/fsm/pkg/webhook/builder"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	extv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/extension/v1alpha1"
+	fctx "github.com/flomesh-io/fsm/pkg/context"
+	"github.com/flomesh-io/fsm/pkg/controllers"
+)
+
+type jwtAuthReconciler struct {
+	recorder record.EventRecorder
+	fctx     *fctx.ControllerContext
+	webhook  whtypes.Register
+}
+
+func (r *jwtAuthReconciler) NeedLeaderElection() bool {
+	return true
+}
+
+// NewJWTAuthReconciler returns a new JWTAuth Reconciler
+func NewJWTAuthReconciler(ctx *fctx.ControllerContext, webhook whtypes.Register) controllers.Reconciler {
+	return &jwtAuthReconciler{
+		recorder: ctx.Manager.GetEventRecorderFor("JWTAuth"),
+		fctx:     ctx,
+		webhook:  webhook,
+	}
+}
+
+// Reconcile reads that state of the cluster for a JWTAuth object and makes changes based on the state read
+func (r *jwtAuthReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	jwtAuth := &extv1alpha1.JWTAuth{}
+	err := r.fctx.Get(ctx, req.NamespacedName, jwtAuth)
+	if errors.IsNotFound(err) {
+		r.fctx.GatewayEventHandler.OnDelete(&extv1alpha1.JWTAuth{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: req.Namespace,
+				Name:      req.Name,
+			}})
+		return reconcile.Result{}, nil
+	}
+
+	if jwtAuth.DeletionTimestamp != nil {
+		r.fctx.GatewayEventHandler.OnDelete(jwtAuth)
+		return ctrl.Result{}, nil
+	}
+
+	// As JWTAuth has no status, we don't need to update it
+
+	r.fctx.GatewayEventHandler.OnAdd(jwtAuth, false)
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *jwtAuthReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := whblder.WebhookManagedBy(mgr).
+		For(&extv1alpha1.JWTAuth{}).
+		WithDefaulter(r.webhook).
+		WithValidator(r.webhook).
+		RecoverPanic(true).
+		Complete(); err != nil {
+		return err
+	}
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		For(&extv1alpha1.JWTAuth{}).
+		Complete(r); err != nil {
+		return err
+	}
+
+	return addJWTAuthIndexers(context.Background(), mgr)
+}
+
+func addJWTAuthIndexers(ctx context.Context, mgr manager.Manager) error {
+	//if err := mgr.GetFieldIndexer().IndexField(ctx, &extv1alpha1.ListenerJWTAuth{}, constants.GatewayListenerJWTAuthIndex, func(obj client.Object) []string {
+	//	jwtAuth := obj.(*extv1alpha1.ListenerJWTAuth)
+	//
+	//	var gateways []string
+	//	for _, targetRef := range jwtAuth.Spec.TargetRefs {
+	//		if string(targetRef.Kind) == constants.GatewayAPIGatewayKind &&
+	//			string(targetRef.Group) == gwv1.GroupName {
+	//			gateways = append(gateways, fmt.Sprintf("%s/%d", string(targetRef.Name), targetRef.Port))
+	//		}
+	//	}
+	//
+	//	return gateways
+	//}); err != nil {
+	//	return err
+	//}
+
+	return nil
+}
@@ -7,8 +7,6 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-
-	"github.com/flomesh-io/fsm/pkg/flb"
 )
 
 func (r *serviceReconciler) onSvcAdd(_ interface{}) {}
@@ -16,8 +14,7 @@ func (r *serviceReconciler) onSvcAdd(_ interface{}) {}
 func (r *serviceReconciler) onSvcUpdate(oldObj, newObj interface{}) {
 	log.Debug().Msgf("[FLB] Service updated")
 
-	oldSvc, ok := oldObj.(*corev1.Service)
-	if !ok {
+	if _, ok := oldObj.(*corev1.Service); !ok {
 		log.Error().Msgf("Unexpected type: %T", oldObj)
 	}
 
@@ -26,29 +23,16 @@ func (r *serviceReconciler) onSvcUpdate(oldObj, newObj interface{}) {
 		log.Error().Msgf("Unexpected type: %T", oldObj)
 	}
 
-	kubeClient := r.fctx.KubeClient
-	if flb.IsFLBEnabled(oldSvc, kubeClient) && !flb.IsFLBEnabled(newSvc, kubeClient) {
+	// the service has been programmed into FLB, but is no longer served by FLB as the
+	// annotations are changed or the FLBPolicy applied to it is detached or deleted
+	ctx := context.Background()
+	if getServiceHash(newSvc) != "" && !r.isFLBEnabled(ctx, newSvc) {
 		retriableFn := func(err error) bool {
 			return err != nil
 		}
 
 		delFn := func() error {
-			svc := newSvc.DeepCopy()
-
-			if err := r.removeServiceHash(context.Background(), svc); err != nil {
-				return err
-			}
-
-			svc.Status.LoadBalancer.Ingress = nil
-			if err := r.fctx.Status().Update(context.Background(), svc); err != nil {
-				return err
-			}
-
-			if _, err := r.deleteEntryFromFLB(context.Background(), svc); err != nil {
-				return err
-			}
-
-			return nil
+			return r.disableFLB(ctx, newSvc)
 		}
 
 		err := retry.OnError(retry.DefaultBackoff, retriableFn, delFn)
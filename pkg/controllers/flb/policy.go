@@ -0,0 +1,322 @@
+package flb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metautil "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/constants"
+	"github.com/flomesh-io/fsm/pkg/flb"
+)
+
+// isFLBEnabled checks if the service is served by FLB, either enabled by annotations or targeted by an FLBPolicy
+func (r *serviceReconciler) isFLBEnabled(ctx context.Context, svc *corev1.Service) bool {
+	if flb.IsFLBEnabled(svc, r.fctx.KubeClient) {
+		return true
+	}
+
+	if svc == nil || svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return false
+	}
+
+	return r.getPolicy(ctx, svc) != nil
+}
+
+// getPolicy returns the FLBPolicy applied to the service, or nil if the service is configured by annotations
+func (r *serviceReconciler) getPolicy(ctx context.Context, svc *corev1.Service) *policyv1alpha1.FLBPolicy {
+	policies := &policyv1alpha1.FLBPolicyList{}
+	if err := r.fctx.List(ctx, policies, client.InNamespace(svc.Namespace)); err != nil {
+		log.Error().Msgf("Failed to list FLBPolicies in namespace %s: %s", svc.Namespace, err)
+		return nil
+	}
+
+	return flb.FindServicePolicy(policies.Items, svc)
+}
+
+func (r *serviceReconciler) getPolicyParameters(svc *corev1.Service, policy *policyv1alpha1.FLBPolicy) map[string]string {
+	setting := r.settingMgr.GetSetting(svc.Namespace)
+	spec := policy.Spec
+
+	params := map[string]string{
+		flbAddressPoolHeaderName: setting.flbDefaultAddressPool,
+		flbAlgoHeaderName:        getValidAlgo(setting.flbDefaultAlgo),
+		flbTagsHeaderName:        r.filterTags(svc, toServiceTags(spec.Tags)),
+	}
+
+	if spec.AddressPool != nil && len(*spec.AddressPool) > 0 {
+		params[flbAddressPoolHeaderName] = *spec.AddressPool
+	}
+	if spec.DesiredIP != nil {
+		params[flbDesiredIPHeaderName] = *spec.DesiredIP
+	}
+	if spec.Algorithm != nil {
+		params[flbAlgoHeaderName] = getValidAlgo(string(*spec.Algorithm))
+	}
+	if spec.XForwardedFor != nil {
+		params[flbXForwardedForEnabledHeaderName] = strconv.FormatBool(*spec.XForwardedFor)
+	}
+	if spec.SessionSticky != nil {
+		params[flbSessionStickyHeaderName] = strconv.FormatBool(*spec.SessionSticky)
+	}
+	if limits := spec.Limits; limits != nil {
+		params[flbMaxConnectionsHeaderName] = formatInt32(limits.MaxConnections)
+		params[flbLimitSizeHeaderName] = formatInt32(limits.Size)
+		params[flbLimitSyncRateHeaderName] = formatInt32(limits.SyncRate)
+	}
+	if timeouts := spec.Timeouts; timeouts != nil {
+		params[flbReadTimeoutHeaderName] = formatInt32(timeouts.Read)
+		params[flbWriteTimeoutHeaderName] = formatInt32(timeouts.Write)
+		params[flbIdleTimeoutHeaderName] = formatInt32(timeouts.Idle)
+	}
+
+	if tls := spec.TLS; tls != nil {
+		mode := tls.SecretMode
+		if mode == "" {
+			mode = policyv1alpha1.FLBTLSSecretModeLocal
+		}
+
+		params[flbTLSEnabledHeaderName] = "true"
+		params[flbTLSSecretModeHeaderName] = string(mode)
+		params[flbTLSPortHeaderName] = strconv.Itoa(int(tls.Port))
+
+		switch mode {
+		case policyv1alpha1.FLBTLSSecretModeLocal:
+			params[flbTLSSecretHeaderName] = secretKey(setting, svc.Namespace, tls.SecretName)
+		case policyv1alpha1.FLBTLSSecretModeRemote:
+			params[flbTLSSecretHeaderName] = tls.SecretName
+		}
+	}
+
+	return params
+}
+
+// updatePolicyStatus records the VIPs allocated for the service or the error returned by FLB in the policy status
+func (r *serviceReconciler) updatePolicyStatus(ctx context.Context, policy *policyv1alpha1.FLBPolicy, svc *corev1.Service, addresses []string, flbErr error) {
+	if policy == nil {
+		return
+	}
+
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		latest := &policyv1alpha1.FLBPolicy{}
+		if err := r.fctx.Get(ctx, client.ObjectKeyFromObject(policy), latest); err != nil {
+			return err
+		}
+
+		status := policyv1alpha1.FLBServiceStatus{Namespace: svc.Namespace, Name: svc.Name}
+		for _, s := range latest.Status.Services {
+			if s.Namespace == svc.Namespace && s.Name == svc.Name {
+				status = s
+				break
+			}
+		}
+
+		if flbErr != nil {
+			status.LastError = flbErr.Error()
+		} else {
+			status.Addresses = addresses
+			status.LastError = ""
+		}
+
+		setServiceStatus(latest, status)
+		setProgrammedCondition(latest)
+
+		return r.fctx.Status().Update(ctx, latest)
+	}); err != nil {
+		log.Error().Msgf("Failed to update status of FLBPolicy %s/%s: %s", policy.Namespace, policy.Name, err)
+	}
+}
+
+// removePolicyStatus removes the service from the status of the policies listing it, which
+// are no longer applied to the service once it's detached
+func (r *serviceReconciler) removePolicyStatus(ctx context.Context, svc *corev1.Service) {
+	policies := &policyv1alpha1.FLBPolicyList{}
+	if err := r.fctx.List(ctx, policies, client.InNamespace(svc.Namespace)); err != nil {
+		log.Error().Msgf("Failed to list FLBPolicies in namespace %s: %s", svc.Namespace, err)
+		return
+	}
+
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		if !hasServiceStatus(policy, svc) {
+			continue
+		}
+
+		if err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+			latest := &policyv1alpha1.FLBPolicy{}
+			if err := r.fctx.Get(ctx, client.ObjectKeyFromObject(policy), latest); err != nil {
+				return client.IgnoreNotFound(err)
+			}
+
+			if !removeServiceStatus(latest, svc.Namespace, svc.Name) {
+				return nil
+			}
+			setProgrammedCondition(latest)
+
+			return r.fctx.Status().Update(ctx, latest)
+		}); err != nil {
+			log.Error().Msgf("Failed to update status of FLBPolicy %s/%s: %s", policy.Namespace, policy.Name, err)
+		}
+	}
+}
+
+// policyEventHandler enqueues the services a policy is applied to, both before and after the
+// policy changes, so that the services it's detached from are reconciled as well
+func (r *serviceReconciler) policyEventHandler() handler.EventHandler {
+	return handler.Funcs{
+		CreateFunc: func(ctx context.Context, e event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueueServices(q, r.policyServices(ctx, e.Object))
+		},
+		UpdateFunc: func(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueueServices(q, r.policyServices(ctx, e.ObjectOld))
+			enqueueServices(q, r.policyServices(ctx, e.ObjectNew))
+		},
+		DeleteFunc: func(ctx context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueueServices(q, r.policyServices(ctx, e.Object))
+		},
+		GenericFunc: func(ctx context.Context, e event.GenericEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueueServices(q, r.policyServices(ctx, e.Object))
+		},
+	}
+}
+
+func enqueueServices(q workqueue.TypedRateLimitingInterface[reconcile.Request], services map[types.NamespacedName]struct{}) {
+	for svc := range services {
+		q.Add(reconcile.Request{NamespacedName: svc})
+	}
+}
+
+// policyServices returns the LoadBalancer services targeted by the policy and the services
+// recorded in its status, which are the services the policy has been applied to
+func (r *serviceReconciler) policyServices(ctx context.Context, obj client.Object) map[types.NamespacedName]struct{} {
+	policy, ok := obj.(*policyv1alpha1.FLBPolicy)
+	if !ok {
+		log.Warn().Msgf("unexpected object type: %T", obj)
+		return nil
+	}
+
+	services := make(map[types.NamespacedName]struct{})
+	for _, s := range policy.Status.Services {
+		services[types.NamespacedName{Namespace: s.Namespace, Name: s.Name}] = struct{}{}
+	}
+
+	for _, ref := range policy.Spec.TargetRefs {
+		if string(ref.Group) != constants.KubernetesCoreGroup {
+			continue
+		}
+
+		switch string(ref.Kind) {
+		case constants.KubernetesServiceKind:
+			services[types.NamespacedName{Namespace: policy.Namespace, Name: string(ref.Name)}] = struct{}{}
+		case constants.KubernetesNamespaceKind:
+			if string(ref.Name) != policy.Namespace {
+				continue
+			}
+
+			list := &corev1.ServiceList{}
+			if err := r.fctx.List(ctx, list, client.InNamespace(policy.Namespace)); err != nil {
+				log.Warn().Msgf("failed to list services in ns %s: %s", policy.Namespace, err)
+				continue
+			}
+
+			for _, svc := range list.Items {
+				if svc.Spec.Type == corev1.ServiceTypeLoadBalancer {
+					services[types.NamespacedName{Namespace: svc.Namespace, Name: svc.Name}] = struct{}{}
+				}
+			}
+		}
+	}
+
+	return services
+}
+
+func hasServiceStatus(policy *policyv1alpha1.FLBPolicy, svc *corev1.Service) bool {
+	for _, s := range policy.Status.Services {
+		if s.Namespace == svc.Namespace && s.Name == svc.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func setServiceStatus(policy *policyv1alpha1.FLBPolicy, status policyv1alpha1.FLBServiceStatus) {
+	removeServiceStatus(policy, status.Namespace, status.Name)
+	policy.Status.Services = append(policy.Status.Services, status)
+
+	sort.Slice(policy.Status.Services, func(i, j int) bool {
+		if policy.Status.Services[i].Namespace == policy.Status.Services[j].Namespace {
+			return policy.Status.Services[i].Name < policy.Status.Services[j].Name
+		}
+		return policy.Status.Services[i].Namespace < policy.Status.Services[j].Namespace
+	})
+}
+
+func removeServiceStatus(policy *policyv1alpha1.FLBPolicy, namespace, name string) bool {
+	for i, s := range policy.Status.Services {
+		if s.Namespace == namespace && s.Name == name {
+			policy.Status.Services = append(policy.Status.Services[:i], policy.Status.Services[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// setProgrammedCondition sets the Programmed condition of the policy based on the status of its services
+func setProgrammedCondition(policy *policyv1alpha1.FLBPolicy) {
+	var failures []string
+	for _, s := range policy.Status.Services {
+		if len(s.LastError) > 0 {
+			failures = append(failures, fmt.Sprintf("%s/%s: %s", s.Namespace, s.Name, s.LastError))
+		}
+	}
+
+	condition := metav1.Condition{
+		Type:               string(policyv1alpha1.FLBPolicyConditionProgrammed),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: policy.Generation,
+		LastTransitionTime: metav1.Time{Time: time.Now()},
+		Reason:             "Programmed",
+		Message:            fmt.Sprintf("%d Service(s) programmed into FLB", len(policy.Status.Services)),
+	}
+
+	if len(failures) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "FLBAPIError"
+		condition.Message = strings.Join(failures, "; ")
+	}
+
+	metautil.SetStatusCondition(&policy.Status.Conditions, condition)
+}
+
+func toServiceTags(tags []policyv1alpha1.FLBPortTags) []serviceTag {
+	result := make([]serviceTag, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, serviceTag{Port: tag.Port, Tags: tag.Tags})
+	}
+
+	return result
+}
+
+func formatInt32(v *int32) string {
+	if v == nil {
+		return ""
+	}
+
+	return strconv.Itoa(int(*v))
+}
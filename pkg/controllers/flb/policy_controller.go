@@ -0,0 +1,178 @@
+package flb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	metautil "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	fctx "github.com/flomesh-io/fsm/pkg/context"
+	"github.com/flomesh-io/fsm/pkg/controllers"
+	"github.com/flomesh-io/fsm/pkg/flb"
+	whblder "github.com/flomesh-io/fsm/pkg/webhook/builder"
+	whtypes "github.com/flomesh-io/fsm/pkg/webhook/types"
+)
+
+// policyReconciler reconciles a FLBPolicy object
+type policyReconciler struct {
+	recorder record.EventRecorder
+	fctx     *fctx.ControllerContext
+	webhook  whtypes.Register
+}
+
+func (r *policyReconciler) NeedLeaderElection() bool {
+	return true
+}
+
+// NewPolicyReconciler returns a new reconciler for FLBPolicy
+func NewPolicyReconciler(ctx *fctx.ControllerContext, webhook whtypes.Register) controllers.Reconciler {
+	log.Info().Msgf("Creating FLB policy reconciler ...")
+
+	return &policyReconciler{
+		recorder: ctx.Manager.GetEventRecorderFor("FLB"),
+		fctx:     ctx,
+		webhook:  webhook,
+	}
+}
+
+// Reconcile sets the Accepted condition of the FLBPolicy and drops the status of Services it no longer targets,
+// the status of the targeted Services is maintained by the service reconciler
+func (r *policyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	policy := &policyv1alpha1.FLBPolicy{}
+	if err := r.fctx.Get(ctx, req.NamespacedName, policy); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if policy.DeletionTimestamp != nil {
+		return ctrl.Result{}, nil
+	}
+
+	policies := &policyv1alpha1.FLBPolicyList{}
+	if err := r.fctx.List(ctx, policies, client.InNamespace(policy.Namespace)); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	conflicts := conflictedTargets(policy, policies.Items)
+
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		latest := &policyv1alpha1.FLBPolicy{}
+		if err := r.fctx.Get(ctx, req.NamespacedName, latest); err != nil {
+			return err
+		}
+
+		condition := metav1.Condition{
+			Type:               string(policyv1alpha1.FLBPolicyConditionAccepted),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: latest.Generation,
+			LastTransitionTime: metav1.Time{Time: time.Now()},
+			Reason:             "Accepted",
+			Message:            "Policy is accepted",
+		}
+		if len(conflicts) > 0 {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "Conflicted"
+			condition.Message = fmt.Sprintf("Targets are applied by older policies: %s", strings.Join(conflicts, ", "))
+		}
+		metautil.SetStatusCondition(&latest.Status.Conditions, condition)
+
+		pruned := false
+		for _, s := range append([]policyv1alpha1.FLBServiceStatus{}, latest.Status.Services...) {
+			if !flb.PolicyTargetsNamespace(latest) && !flb.PolicyTargetsService(latest, s.Name) {
+				pruned = removeServiceStatus(latest, s.Namespace, s.Name) || pruned
+			}
+		}
+		if pruned {
+			setProgrammedCondition(latest)
+		}
+
+		return r.fctx.Status().Update(ctx, latest)
+	})
+
+	return ctrl.Result{}, err
+}
+
+// conflictedTargets returns the targets of the policy which are applied by an older policy
+func conflictedTargets(policy *policyv1alpha1.FLBPolicy, policies []policyv1alpha1.FLBPolicy) []string {
+	var conflicts []string
+
+	for _, ref := range policy.Spec.TargetRefs {
+		for i := range policies {
+			other := &policies[i]
+			if other.Name == policy.Name || !flb.IsOlderPolicy(other, policy) {
+				continue
+			}
+
+			if flb.HasTargetRef(other, ref) {
+				conflicts = append(conflicts, fmt.Sprintf("%s/%s by %s", ref.Kind, ref.Name, other.Name))
+				break
+			}
+		}
+	}
+
+	return conflicts
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *policyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := whblder.WebhookManagedBy(mgr).
+		For(&policyv1alpha1.FLBPolicy{}).
+		WithDefaulter(r.webhook).
+		WithValidator(r.webhook).
+		RecoverPanic(true).
+		Complete(); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(
+			&policyv1alpha1.FLBPolicy{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&policyv1alpha1.FLBPolicy{},
+			handler.EnqueueRequestsFromMapFunc(r.policiesInNamespace),
+			builder.WithPredicates(predicate.Funcs{
+				CreateFunc:  func(event.CreateEvent) bool { return false },
+				UpdateFunc:  func(event.UpdateEvent) bool { return false },
+				DeleteFunc:  func(event.DeleteEvent) bool { return true },
+				GenericFunc: func(event.GenericEvent) bool { return false },
+			}),
+		).
+		Complete(r)
+}
+
+// policiesInNamespace re-evaluates the conflicts of the remaining policies once a policy is deleted
+func (r *policyReconciler) policiesInNamespace(ctx context.Context, obj client.Object) []reconcile.Request {
+	policies := &policyv1alpha1.FLBPolicyList{}
+	if err := r.fctx.List(ctx, policies, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.Warn().Msgf("failed to list FLBPolicies in ns %s: %s", obj.GetNamespace(), err)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(policies.Items))
+	for _, policy := range policies.Items {
+		if policy.Name == obj.GetName() {
+			continue
+		}
+
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: policy.Namespace, Name: policy.Name},
+		})
+	}
+
+	return requests
+}
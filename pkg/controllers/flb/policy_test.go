@@ -0,0 +1,174 @@
+package flb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metautil "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/constants"
+	fctx "github.com/flomesh-io/fsm/pkg/context"
+	policyscheme "github.com/flomesh-io/fsm/pkg/gen/client/policy/clientset/versioned/scheme"
+)
+
+func newFakeClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = policyscheme.AddToScheme(scheme)
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&policyv1alpha1.FLBPolicy{}).
+		Build()
+}
+
+func newPolicy(name string, created time.Time, kind string, targets ...string) *policyv1alpha1.FLBPolicy {
+	policy := &policyv1alpha1.FLBPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "test",
+			Name:              name,
+			CreationTimestamp: metav1.Time{Time: created},
+		},
+	}
+	for _, target := range targets {
+		policy.Spec.TargetRefs = append(policy.Spec.TargetRefs, gwv1alpha2.LocalPolicyTargetReference{
+			Group: constants.KubernetesCoreGroup,
+			Kind:  gwv1alpha2.Kind(kind),
+			Name:  gwv1alpha2.ObjectName(target),
+		})
+	}
+	return policy
+}
+
+func newLoadBalancer(name string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+		Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+	}
+}
+
+func TestPolicyReconcile(t *testing.T) {
+	assert := tassert.New(t)
+
+	now := time.Now()
+	older := newPolicy("older", now.Add(-time.Hour), constants.KubernetesServiceKind, "a")
+	newer := newPolicy("newer", now, constants.KubernetesServiceKind, "a", "b")
+	newer.Status.Services = []policyv1alpha1.FLBServiceStatus{
+		{Namespace: "test", Name: "b", Addresses: []string{"10.0.0.2"}},
+		{Namespace: "test", Name: "c", LastError: "FLB API error"},
+	}
+
+	c := newFakeClient(older, newer)
+	r := &policyReconciler{fctx: &fctx.ControllerContext{Client: c}}
+
+	_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "test", Name: "older"}})
+	assert.NoError(err)
+	_, err = r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "test", Name: "newer"}})
+	assert.NoError(err)
+
+	latest := &policyv1alpha1.FLBPolicy{}
+	assert.NoError(c.Get(context.TODO(), client.ObjectKeyFromObject(older), latest))
+	accepted := metautil.FindStatusCondition(latest.Status.Conditions, string(policyv1alpha1.FLBPolicyConditionAccepted))
+	assert.NotNil(accepted)
+	assert.Equal(metav1.ConditionTrue, accepted.Status)
+
+	// the Service a is applied by the older policy, the Service c is no longer targeted
+	assert.NoError(c.Get(context.TODO(), client.ObjectKeyFromObject(newer), latest))
+	accepted = metautil.FindStatusCondition(latest.Status.Conditions, string(policyv1alpha1.FLBPolicyConditionAccepted))
+	assert.NotNil(accepted)
+	assert.Equal(metav1.ConditionFalse, accepted.Status)
+	assert.Equal("Conflicted", accepted.Reason)
+	assert.Equal([]policyv1alpha1.FLBServiceStatus{{Namespace: "test", Name: "b", Addresses: []string{"10.0.0.2"}}}, latest.Status.Services)
+	programmed := metautil.FindStatusCondition(latest.Status.Conditions, string(policyv1alpha1.FLBPolicyConditionProgrammed))
+	assert.NotNil(programmed)
+	assert.Equal(metav1.ConditionTrue, programmed.Status)
+
+	// the conflict is resolved once the older policy is deleted
+	assert.NoError(c.Delete(context.TODO(), older))
+	assert.ElementsMatch([]reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "test", Name: "newer"}}}, r.policiesInNamespace(context.TODO(), older))
+	_, err = r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "test", Name: "newer"}})
+	assert.NoError(err)
+	assert.NoError(c.Get(context.TODO(), client.ObjectKeyFromObject(newer), latest))
+	accepted = metautil.FindStatusCondition(latest.Status.Conditions, string(policyv1alpha1.FLBPolicyConditionAccepted))
+	assert.Equal(metav1.ConditionTrue, accepted.Status)
+}
+
+func TestPolicyEventHandler(t *testing.T) {
+	assert := tassert.New(t)
+
+	now := time.Now()
+	c := newFakeClient(newLoadBalancer("lb1"), newLoadBalancer("lb2"), &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "cluster-ip"},
+	})
+	r := &serviceReconciler{fctx: &fctx.ControllerContext{Client: c}}
+	h := r.policyEventHandler()
+
+	drain := func(q workqueue.TypedRateLimitingInterface[reconcile.Request]) []string {
+		var names []string
+		for q.Len() > 0 {
+			req, _ := q.Get()
+			names = append(names, req.Name)
+			q.Done(req)
+		}
+		return names
+	}
+
+	oldPolicy := newPolicy("policy", now, constants.KubernetesServiceKind, "a")
+	oldPolicy.Status.Services = []policyv1alpha1.FLBServiceStatus{{Namespace: "test", Name: "a"}, {Namespace: "test", Name: "b"}}
+	updated := newPolicy("policy", now, constants.KubernetesNamespaceKind, "test")
+	updated.Status = oldPolicy.Status
+
+	q := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+	defer q.ShutDown()
+
+	// the services the policy is detached from are enqueued along with the new targets
+	h.Update(context.TODO(), event.UpdateEvent{ObjectOld: oldPolicy, ObjectNew: updated}, q)
+	assert.ElementsMatch([]string{"a", "b", "lb1", "lb2"}, drain(q))
+
+	// the services the deleted policy was applied to are enqueued
+	deleted := updated.DeepCopy()
+	deleted.Spec.TargetRefs = oldPolicy.Spec.TargetRefs
+	h.Delete(context.TODO(), event.DeleteEvent{Object: deleted}, q)
+	assert.ElementsMatch([]string{"a", "b"}, drain(q))
+}
+
+func TestRemovePolicyStatus(t *testing.T) {
+	assert := tassert.New(t)
+
+	// the policy no longer targets the service a, but still lists it in its status
+	detached := newPolicy("detached", time.Now(), constants.KubernetesServiceKind, "b")
+	detached.Status.Services = []policyv1alpha1.FLBServiceStatus{
+		{Namespace: "test", Name: "a", Addresses: []string{"10.0.0.1"}},
+		{Namespace: "test", Name: "b", Addresses: []string{"10.0.0.2"}},
+	}
+	other := newPolicy("other", time.Now(), constants.KubernetesServiceKind, "c")
+	other.Status.Services = []policyv1alpha1.FLBServiceStatus{{Namespace: "test", Name: "c"}}
+
+	c := newFakeClient(detached, other)
+	r := &serviceReconciler{fctx: &fctx.ControllerContext{Client: c}}
+	assert.Nil(r.getPolicy(context.TODO(), newLoadBalancer("a")))
+
+	r.removePolicyStatus(context.TODO(), newLoadBalancer("a"))
+
+	latest := &policyv1alpha1.FLBPolicy{}
+	assert.NoError(c.Get(context.TODO(), client.ObjectKeyFromObject(detached), latest))
+	assert.Equal([]policyv1alpha1.FLBServiceStatus{{Namespace: "test", Name: "b", Addresses: []string{"10.0.0.2"}}}, latest.Status.Services)
+	assert.NoError(c.Get(context.TODO(), client.ObjectKeyFromObject(other), latest))
+	assert.Equal([]policyv1alpha1.FLBServiceStatus{{Namespace: "test", Name: "c"}}, latest.Status.Services)
+}
@@ -46,6 +46,7 @@ import (
 	"github.com/flomesh-io/fsm/pkg/k8s/informers"
 
 	configv1alpha3 "github.com/flomesh-io/fsm/pkg/apis/config/v1alpha3"
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
 
 	"github.com/ghodss/yaml"
 	"github.com/go-resty/resty/v2"
@@ -148,7 +149,7 @@ func (r *serviceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 				return ctrl.Result{}, nil
 			}
 
-			if flb.IsFLBEnabled(svc, r.fctx.KubeClient) {
+			if r.isFLBEnabled(ctx, svc) {
 				result, err := r.deleteEntryFromFLB(ctx, svc)
 				if err != nil {
 					return result, err
@@ -163,7 +164,7 @@ func (r *serviceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return ctrl.Result{}, err
 	}
 
-	if flb.IsFLBEnabled(svc, r.fctx.KubeClient) {
+	if r.isFLBEnabled(ctx, svc) {
 		log.Debug().Msgf("Type of service %s/%s is LoadBalancer", req.Namespace, req.Name)
 
 		//oldSvc, found := r.cache[req.NamespacedName]
@@ -192,9 +193,37 @@ func (r *serviceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return r.createOrUpdateFLBEntry(ctx, svc)
 	}
 
+	// the FLBPolicy which enabled FLB for the service has been detached or deleted, while
+	// the service itself is unchanged, it's disabled the same way as an updated service
+	r.onSvcUpdate(svc, svc)
+
 	return ctrl.Result{}, nil
 }
 
+// disableFLB removes the service from FLB and clears the VIPs assigned by FLB
+func (r *serviceReconciler) disableFLB(ctx context.Context, svc *corev1.Service) error {
+	svc = svc.DeepCopy()
+
+	if _, err := r.settingMgr.CheckSetting(svc); err != nil {
+		return err
+	}
+
+	if err := r.removeServiceHash(ctx, svc); err != nil {
+		return err
+	}
+
+	svc.Status.LoadBalancer.Ingress = nil
+	if err := r.fctx.Status().Update(ctx, svc); err != nil {
+		return err
+	}
+
+	if _, err := r.deleteEntryFromFLB(ctx, svc); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (r *serviceReconciler) deleteEntryFromFLB(ctx context.Context, svc *corev1.Service) (ctrl.Result, error) {
 	//if svc.Spec.Type == corev1.ServiceTypeLoadBalancer {
 	log.Debug().Msgf("Service %s/%s is being deleted from FLB ...", svc.Namespace, svc.Name)
@@ -210,10 +239,11 @@ func (r *serviceReconciler) deleteEntryFromFLB(ctx context.Context, svc *corev1.
 		result[svcKey] = make([]string, 0)
 	}
 
-	params := r.getFLBParameters(svc)
+	params := r.getFLBParameters(svc, r.getPolicy(ctx, svc))
 	if _, err := r.updateFLB(svc, params, result, true); err != nil {
 		return ctrl.Result{}, err
 	}
+	r.removePolicyStatus(ctx, svc)
 
 	if svc.DeletionTimestamp != nil {
 		return ctrl.Result{}, r.removeFinalizer(ctx, svc)
@@ -235,7 +265,8 @@ func (r *serviceReconciler) createOrUpdateFLBEntry(ctx context.Context, svc *cor
 
 	log.Debug().Msgf("Upstreams of Service %s/%s: %s", svc.Namespace, svc.Name, endpoints)
 
-	params := r.getFLBParameters(svc)
+	policy := r.getPolicy(ctx, svc)
+	params := r.getFLBParameters(svc, policy)
 
 	oldHash := getServiceHash(svc)
 	hash := r.computeServiceHash(svc, endpoints, params)
@@ -243,13 +274,16 @@ func (r *serviceReconciler) createOrUpdateFLBEntry(ctx context.Context, svc *cor
 	if oldHash != hash {
 		resp, err := r.updateFLB(svc, params, endpoints, false)
 		if err != nil {
+			r.updatePolicyStatus(ctx, policy, svc, nil, err)
 			return ctrl.Result{}, err
 		}
 
 		if len(resp.LBIPs) == 0 {
 			// it should always assign a VIP for the service, not matter it has endpoints or not
+			err := fmt.Errorf("FLB hasn't assigned any external IP for service %s/%s", svc.Namespace, svc.Name)
+			r.updatePolicyStatus(ctx, policy, svc, nil, err)
 			defer r.recorder.Eventf(svc, corev1.EventTypeWarning, "IPNotAssigned", "FLB hasn't assigned any external IP yet")
-			return ctrl.Result{RequeueAfter: 5 * time.Second}, fmt.Errorf("FLB hasn't assigned any external IP for service %s/%s", svc.Namespace, svc.Name)
+			return ctrl.Result{RequeueAfter: 5 * time.Second}, err
 		}
 
 		log.Debug().Msgf("External IPs assigned by FLB: %#v", resp)
@@ -257,10 +291,16 @@ func (r *serviceReconciler) createOrUpdateFLBEntry(ctx context.Context, svc *cor
 		if err := r.updateService(ctx, svc, mc, resp.LBIPs); err != nil {
 			return ctrl.Result{}, err
 		}
+		r.updatePolicyStatus(ctx, policy, svc, lbIPs(resp.LBIPs), nil)
 
 		return r.updateServiceHash(ctx, svc, hash)
 	}
 
+	// the policy is attached to a service which has been programmed into FLB already
+	if policy != nil && !hasServiceStatus(policy, svc) {
+		r.updatePolicyStatus(ctx, policy, svc, serviceIPs(svc), nil)
+	}
+
 	return ctrl.Result{}, nil
 }
 
@@ -446,7 +486,11 @@ func (r *serviceReconciler) getEndpoints(ctx context.Context, svc *corev1.Servic
 	return result, nil
 }
 
-func (r *serviceReconciler) getFLBParameters(svc *corev1.Service) map[string]string {
+func (r *serviceReconciler) getFLBParameters(svc *corev1.Service, policy *policyv1alpha1.FLBPolicy) map[string]string {
+	if policy != nil {
+		return r.getPolicyParameters(svc, policy)
+	}
+
 	setting := r.settingMgr.GetSetting(svc.Namespace)
 	if len(svc.Annotations) == 0 {
 		return map[string]string{
@@ -529,6 +573,11 @@ func (r *serviceReconciler) getTags(svc *corev1.Service) string {
 	}
 	log.Debug().Msgf("Unmarshalled tags of service %s/%s: %v", svc.Namespace, svc.Name, tags)
 
+	return r.filterTags(svc, tags)
+}
+
+// filterTags drops the tags of ports which don't exist in the service and marshals the rest to JSON
+func (r *serviceReconciler) filterTags(svc *corev1.Service, tags []serviceTag) string {
 	svcPorts := make(map[int32]bool)
 	for _, port := range svc.Spec.Ports {
 		svcPorts[port.Port] = true
@@ -738,6 +787,11 @@ func (r *serviceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 					predicate.AnnotationChangedPredicate{},
 				),
 			),
+		).
+		Watches(
+			&policyv1alpha1.FLBPolicy{},
+			r.policyEventHandler(),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
 		)
 
 	switch r.fctx.Configurator.GetFLBUpstreamMode() {
@@ -763,7 +817,7 @@ func (r *serviceReconciler) isInterestedService(obj client.Object) bool {
 		return false
 	}
 
-	return flb.IsFLBEnabled(svc, r.fctx.KubeClient)
+	return r.isFLBEnabled(context.TODO(), svc)
 }
 
 func (r *serviceReconciler) podToService(ctx context.Context, pod client.Object) []reconcile.Request {
@@ -790,7 +844,7 @@ func (r *serviceReconciler) podToService(ctx context.Context, pod client.Object)
 			continue
 		}
 
-		if !flb.IsFLBEnabled(&service, r.fctx.KubeClient) {
+		if !r.isFLBEnabled(ctx, &service) {
 			continue
 		}
 
@@ -824,7 +878,7 @@ func (r *serviceReconciler) endpointsToService(ctx context.Context, ep client.Ob
 	}
 
 	// ONLY if it's FLB interested service
-	if flb.IsFLBEnabled(svc, r.fctx.KubeClient) {
+	if r.isFLBEnabled(ctx, svc) {
 		return []reconcile.Request{
 			{
 				NamespacedName: types.NamespacedName{
@@ -852,7 +906,7 @@ func (r *serviceReconciler) servicesByNamespace(ctx context.Context, ns client.O
 
 	for _, svc := range services.Items {
 		svc := svc // fix lint GO-LOOP-REF
-		if flb.IsFLBEnabled(&svc, r.fctx.KubeClient) {
+		if r.isFLBEnabled(ctx, &svc) {
 			requests = append(requests, reconcile.Request{
 				NamespacedName: types.NamespacedName{
 					Namespace: svc.GetNamespace(),
@@ -0,0 +1,277 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) since 2021,  flomesh.io Authors.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	mcsv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/multicluster/v1alpha1"
+	fctx "github.com/flomesh-io/fsm/pkg/context"
+	"github.com/flomesh-io/fsm/pkg/controllers"
+)
+
+const (
+	defaultHealthCheckInterval           = 10 * time.Second
+	defaultHealthCheckTimeout            = 1 * time.Second
+	defaultHealthCheckUnhealthyThreshold = 3
+	defaultHealthCheckHealthyThreshold   = 2
+)
+
+// globalTrafficPolicyReconciler reconciles a GlobalTrafficPolicy object, it selects the target serving the traffic
+// in FailOver load balancing from the health of the endpoints imported from the targets, which are probed in the background
+type globalTrafficPolicyReconciler struct {
+	recorder record.EventRecorder
+	fctx     *fctx.ControllerContext
+	checker  *healthChecker
+}
+
+func (r *globalTrafficPolicyReconciler) NeedLeaderElection() bool {
+	return true
+}
+
+// NewGlobalTrafficPolicyReconciler returns a new GlobalTrafficPolicy.Reconciler
+func NewGlobalTrafficPolicyReconciler(ctx *fctx.ControllerContext) controllers.Reconciler {
+	return &globalTrafficPolicyReconciler{
+		recorder: ctx.Manager.GetEventRecorderFor("GlobalTrafficPolicy"),
+		fctx:     ctx,
+		checker:  newHealthChecker(tcpProbe),
+	}
+}
+
+func (r *globalTrafficPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	policy := &mcsv1alpha1.GlobalTrafficPolicy{}
+	if err := r.fctx.Get(ctx, req.NamespacedName, policy); err != nil {
+		if errors.IsNotFound(err) {
+			log.Info().Msgf("[GlobalTrafficPolicy] GlobalTrafficPolicy resource not found. Ignoring since object must be deleted")
+			r.checker.Forget(req.NamespacedName)
+			return ctrl.Result{}, nil
+		}
+		log.Error().Msgf("Failed to get GlobalTrafficPolicy, %v", err)
+		return ctrl.Result{}, err
+	}
+
+	if policy.DeletionTimestamp != nil {
+		r.checker.Forget(req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+
+	// Only FailOver load balancing is driven by the observed health of the targets
+	if policy.Spec.LbType != mcsv1alpha1.FailOverLbType || len(policy.Spec.Targets) == 0 {
+		r.checker.Forget(req.NamespacedName)
+		if equality.Semantic.DeepEqual(policy.Status, mcsv1alpha1.GlobalTrafficPolicyStatus{}) {
+			return ctrl.Result{}, nil
+		}
+		policy.Status = mcsv1alpha1.GlobalTrafficPolicyStatus{}
+		return ctrl.Result{}, r.fctx.Status().Update(ctx, policy)
+	}
+
+	interval, timeout, unhealthyThreshold, healthyThreshold := healthCheckSettings(policy.Spec.HealthCheck)
+
+	svcImport := &mcsv1alpha1.ServiceImport{}
+	if err := r.fctx.Get(ctx, req.NamespacedName, svcImport); err != nil {
+		if !errors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		svcImport = nil
+	}
+
+	// The policy is reconciled again once the health of any endpoint is changed
+	endpoints := importedEndpoints(svcImport)
+	health := r.checker.Watch(req.NamespacedName, endpoints, interval, timeout, unhealthyThreshold, healthyThreshold)
+
+	status := computeGlobalTrafficPolicyStatus(policy, endpoints, health, metav1.Now())
+	if equality.Semantic.DeepEqual(policy.Status, status) {
+		return ctrl.Result{}, nil
+	}
+
+	if status.ActiveCluster != policy.Status.ActiveCluster {
+		eventType := corev1.EventTypeNormal
+		if status.Reason != mcsv1alpha1.FailOverReasonPrimaryHealthy {
+			eventType = corev1.EventTypeWarning
+		}
+		r.recorder.Event(policy, eventType, string(status.Reason), status.Message)
+	}
+
+	policy.Status = status
+	if err := r.fctx.Status().Update(ctx, policy); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// computeGlobalTrafficPolicyStatus computes the health of the targets and selects the healthy target of
+// the highest priority as the active one
+func computeGlobalTrafficPolicyStatus(policy *mcsv1alpha1.GlobalTrafficPolicy, endpoints map[string][]string, health map[string]bool, now metav1.Time) mcsv1alpha1.GlobalTrafficPolicyStatus {
+	previous := make(map[string]mcsv1alpha1.TrafficTargetStatus)
+	for _, ts := range policy.Status.Targets {
+		previous[ts.ClusterKey] = ts
+	}
+
+	targets := make([]mcsv1alpha1.TrafficTargetStatus, 0, len(policy.Spec.Targets))
+	for _, target := range policy.Spec.Targets {
+		ts := mcsv1alpha1.TrafficTargetStatus{
+			ClusterKey: target.ClusterKey,
+			Endpoints:  len(endpoints[target.ClusterKey]),
+		}
+		if target.Priority != nil {
+			ts.Priority = *target.Priority
+		}
+		for _, address := range endpoints[target.ClusterKey] {
+			if health[address] {
+				ts.HealthyEndpoints++
+			}
+		}
+		ts.Healthy = ts.HealthyEndpoints > 0
+
+		if prev, ok := previous[target.ClusterKey]; ok && prev.Healthy == ts.Healthy {
+			ts.LastTransitionTime = prev.LastTransitionTime
+		} else {
+			ts.LastTransitionTime = &now
+		}
+
+		targets = append(targets, ts)
+	}
+
+	// Targets of the same priority are preferred in the listed order
+	sort.SliceStable(targets, func(i, j int) bool {
+		return targets[i].Priority < targets[j].Priority
+	})
+
+	status := mcsv1alpha1.GlobalTrafficPolicyStatus{
+		Targets:          targets,
+		LastFailOverTime: policy.Status.LastFailOverTime,
+	}
+
+	for i, ts := range targets {
+		if !ts.Healthy {
+			continue
+		}
+
+		status.ActiveCluster = ts.ClusterKey
+		if i == 0 {
+			status.Reason = mcsv1alpha1.FailOverReasonPrimaryHealthy
+			status.Message = fmt.Sprintf("Cluster %s of the highest priority is healthy", ts.ClusterKey)
+		} else {
+			var unhealthy []string
+			for _, t := range targets[:i] {
+				unhealthy = append(unhealthy, t.ClusterKey)
+			}
+			status.Reason = mcsv1alpha1.FailOverReasonFailedOver
+			status.Message = fmt.Sprintf("Failed over to cluster %s as clusters [%s] are unhealthy", ts.ClusterKey, strings.Join(unhealthy, ", "))
+		}
+		break
+	}
+
+	if len(status.ActiveCluster) == 0 {
+		status.Reason = mcsv1alpha1.FailOverReasonNoHealthyTarget
+		status.Message = "None of the clusters is healthy"
+	}
+
+	if status.ActiveCluster != policy.Status.ActiveCluster {
+		status.LastFailOverTime = &now
+	}
+
+	return status
+}
+
+// importedEndpoints returns the addresses of the endpoints imported from each cluster
+func importedEndpoints(svcImport *mcsv1alpha1.ServiceImport) map[string][]string {
+	endpoints := make(map[string][]string)
+	if svcImport == nil {
+		return endpoints
+	}
+
+	seen := make(map[string]bool)
+	for _, port := range svcImport.Spec.Ports {
+		for _, ep := range port.Endpoints {
+			address := net.JoinHostPort(ep.Target.IP, strconv.Itoa(int(ep.Target.Port)))
+			if seen[address] {
+				continue
+			}
+			seen[address] = true
+			endpoints[ep.ClusterKey] = append(endpoints[ep.ClusterKey], address)
+		}
+	}
+
+	return endpoints
+}
+
+func healthCheckSettings(hc *mcsv1alpha1.GlobalTrafficHealthCheck) (interval, timeout time.Duration, unhealthyThreshold, healthyThreshold int32) {
+	interval = defaultHealthCheckInterval
+	timeout = defaultHealthCheckTimeout
+	unhealthyThreshold = defaultHealthCheckUnhealthyThreshold
+	healthyThreshold = defaultHealthCheckHealthyThreshold
+
+	if hc == nil {
+		return
+	}
+	if hc.Interval != nil && hc.Interval.Duration > 0 {
+		interval = hc.Interval.Duration
+	}
+	if hc.Timeout != nil && hc.Timeout.Duration > 0 {
+		timeout = hc.Timeout.Duration
+	}
+	if hc.UnhealthyThreshold != nil && *hc.UnhealthyThreshold > 0 {
+		unhealthyThreshold = *hc.UnhealthyThreshold
+	}
+	if hc.HealthyThreshold != nil && *hc.HealthyThreshold > 0 {
+		healthyThreshold = *hc.HealthyThreshold
+	}
+
+	return
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *globalTrafficPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.Add(r.checker); err != nil {
+		return err
+	}
+
+	// The ServiceImport and the GlobalTrafficPolicy of a service share the same namespaced name
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&mcsv1alpha1.GlobalTrafficPolicy{}).
+		Watches(
+			&mcsv1alpha1.ServiceImport{},
+			&handler.EnqueueRequestForObject{},
+		).
+		WatchesRawSource(source.Channel(r.checker.events, &handler.EnqueueRequestForObject{})).
+		Complete(r)
+}
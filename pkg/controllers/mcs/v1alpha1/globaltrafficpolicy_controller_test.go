@@ -0,0 +1,218 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	mcsv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/multicluster/v1alpha1"
+)
+
+func TestCheckHealth(t *testing.T) {
+	assert := tassert.New(t)
+
+	var mu sync.Mutex
+	reachable := map[string]bool{"10.0.0.1:80": true, "10.0.0.2:80": true}
+	c := newHealthChecker(func(address string, _ time.Duration) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return reachable[address]
+	})
+
+	key := types.NamespacedName{Namespace: "ns", Name: "svc"}
+	endpoints := map[string][]string{"c1": {"10.0.0.1:80"}, "c2": {"10.0.0.2:80"}}
+	check := func() {
+		c.mu.Lock()
+		c.targets[key].checking = true
+		c.mu.Unlock()
+		c.CheckHealth(context.Background(), key)
+	}
+
+	// endpoints are healthy until proven otherwise
+	health := c.Watch(key, endpoints, time.Second, time.Second, 2, 2)
+	assert.Equal(map[string]bool{"10.0.0.1:80": true, "10.0.0.2:80": true}, health)
+
+	mu.Lock()
+	reachable["10.0.0.1:80"] = false
+	mu.Unlock()
+
+	// below the unhealthy threshold
+	check()
+	assert.Len(c.events, 0)
+	assert.True(c.Watch(key, endpoints, time.Second, time.Second, 2, 2)["10.0.0.1:80"])
+
+	// the unhealthy threshold is reached, the policy is enqueued
+	check()
+	assert.Len(c.events, 1)
+	e := <-c.events
+	assert.Equal(key.Name, e.Object.GetName())
+	assert.Equal(key.Namespace, e.Object.GetNamespace())
+	assert.False(c.Watch(key, endpoints, time.Second, time.Second, 2, 2)["10.0.0.1:80"])
+	assert.True(c.Watch(key, endpoints, time.Second, time.Second, 2, 2)["10.0.0.2:80"])
+
+	mu.Lock()
+	reachable["10.0.0.1:80"] = true
+	mu.Unlock()
+
+	// below the healthy threshold
+	check()
+	assert.Len(c.events, 0)
+	assert.False(c.Watch(key, endpoints, time.Second, time.Second, 2, 2)["10.0.0.1:80"])
+
+	check()
+	assert.Len(c.events, 1)
+	<-c.events
+	assert.True(c.Watch(key, endpoints, time.Second, time.Second, 2, 2)["10.0.0.1:80"])
+
+	// the next check is scheduled after the interval
+	c.mu.Lock()
+	assert.False(c.targets[key].checking)
+	assert.WithinDuration(time.Now().Add(time.Second), c.targets[key].nextCheck, 500*time.Millisecond)
+	c.mu.Unlock()
+
+	// forgotten policies are not probed
+	c.Forget(key)
+	c.CheckHealth(context.Background(), key)
+	assert.Len(c.events, 0)
+}
+
+func TestCheckHealthBoundedConcurrency(t *testing.T) {
+	assert := tassert.New(t)
+
+	var active, maxActive int32
+	c := newHealthChecker(func(string, time.Duration) bool {
+		n := atomic.AddInt32(&active, 1)
+		for {
+			m := atomic.LoadInt32(&maxActive)
+			if n <= m || atomic.CompareAndSwapInt32(&maxActive, m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		atomic.AddInt32(&active, -1)
+		return true
+	})
+
+	var addresses []string
+	for i := 0; i < 4*maxConcurrentProbes; i++ {
+		addresses = append(addresses, fmt.Sprintf("10.0.0.%d:80", i))
+	}
+	key := types.NamespacedName{Namespace: "ns", Name: "svc"}
+	c.Watch(key, map[string][]string{"c1": addresses}, time.Second, time.Second, 1, 1)
+	c.CheckHealth(context.Background(), key)
+
+	assert.LessOrEqual(atomic.LoadInt32(&maxActive), int32(maxConcurrentProbes))
+	assert.Greater(atomic.LoadInt32(&maxActive), int32(0))
+}
+
+func TestComputeGlobalTrafficPolicyStatus(t *testing.T) {
+	now := metav1.Now()
+	earlier := metav1.NewTime(now.Add(-time.Hour))
+
+	policy := func(status mcsv1alpha1.GlobalTrafficPolicyStatus) *mcsv1alpha1.GlobalTrafficPolicy {
+		return &mcsv1alpha1.GlobalTrafficPolicy{
+			Spec: mcsv1alpha1.GlobalTrafficPolicySpec{
+				LbType: mcsv1alpha1.FailOverLbType,
+				Targets: []mcsv1alpha1.TrafficTarget{
+					{ClusterKey: "c2", Priority: ptr.To(2)},
+					{ClusterKey: "c1", Priority: ptr.To(1)},
+				},
+			},
+			Status: status,
+		}
+	}
+	endpoints := map[string][]string{"c1": {"10.0.0.1:80", "10.0.0.2:80"}, "c2": {"10.0.1.1:80"}}
+
+	testCases := []struct {
+		name                 string
+		previous             mcsv1alpha1.GlobalTrafficPolicyStatus
+		health               map[string]bool
+		expectedActive       string
+		expectedReason       mcsv1alpha1.FailOverReason
+		expectedHealthy      []int
+		expectedFailOverTime *metav1.Time
+	}{
+		{
+			name:                 "primary healthy",
+			health:               map[string]bool{"10.0.0.1:80": true, "10.0.0.2:80": false, "10.0.1.1:80": true},
+			expectedActive:       "c1",
+			expectedReason:       mcsv1alpha1.FailOverReasonPrimaryHealthy,
+			expectedHealthy:      []int{1, 1},
+			expectedFailOverTime: &now,
+		},
+		{
+			name: "primary still healthy",
+			previous: mcsv1alpha1.GlobalTrafficPolicyStatus{
+				ActiveCluster:    "c1",
+				LastFailOverTime: &earlier,
+				Targets: []mcsv1alpha1.TrafficTargetStatus{
+					{ClusterKey: "c1", Healthy: true, LastTransitionTime: &earlier},
+				},
+			},
+			health:               map[string]bool{"10.0.0.1:80": true, "10.0.0.2:80": true, "10.0.1.1:80": true},
+			expectedActive:       "c1",
+			expectedReason:       mcsv1alpha1.FailOverReasonPrimaryHealthy,
+			expectedHealthy:      []int{2, 1},
+			expectedFailOverTime: &earlier,
+		},
+		{
+			name: "failed over",
+			previous: mcsv1alpha1.GlobalTrafficPolicyStatus{
+				ActiveCluster:    "c1",
+				LastFailOverTime: &earlier,
+			},
+			health:               map[string]bool{"10.0.1.1:80": true},
+			expectedActive:       "c2",
+			expectedReason:       mcsv1alpha1.FailOverReasonFailedOver,
+			expectedHealthy:      []int{0, 1},
+			expectedFailOverTime: &now,
+		},
+		{
+			name: "no healthy target",
+			previous: mcsv1alpha1.GlobalTrafficPolicyStatus{
+				ActiveCluster:    "c2",
+				LastFailOverTime: &earlier,
+			},
+			health:               map[string]bool{},
+			expectedActive:       "",
+			expectedReason:       mcsv1alpha1.FailOverReasonNoHealthyTarget,
+			expectedHealthy:      []int{0, 0},
+			expectedFailOverTime: &now,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			status := computeGlobalTrafficPolicyStatus(policy(tc.previous), endpoints, tc.health, now)
+			assert.Equal(tc.expectedActive, status.ActiveCluster)
+			assert.Equal(tc.expectedReason, status.Reason)
+			assert.Equal(tc.expectedFailOverTime, status.LastFailOverTime)
+
+			// targets are sorted by priority
+			assert.Len(status.Targets, 2)
+			assert.Equal("c1", status.Targets[0].ClusterKey)
+			assert.Equal("c2", status.Targets[1].ClusterKey)
+			for i, ts := range status.Targets {
+				assert.Equal(tc.expectedHealthy[i], ts.HealthyEndpoints)
+				assert.Equal(ts.HealthyEndpoints > 0, ts.Healthy)
+			}
+
+			// the transition time is kept while the health is unchanged
+			if len(tc.previous.Targets) > 0 {
+				assert.Equal(&earlier, status.Targets[0].LastTransitionTime)
+			} else {
+				assert.Equal(&now, status.Targets[0].LastTransitionTime)
+			}
+		})
+	}
+}
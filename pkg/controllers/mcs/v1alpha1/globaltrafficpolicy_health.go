@@ -0,0 +1,256 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) since 2021,  flomesh.io Authors.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package v1alpha1
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	mcsv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/multicluster/v1alpha1"
+)
+
+const (
+	// maxConcurrentProbes is the max number of endpoints probed at the same time across all the policies
+	maxConcurrentProbes = 32
+
+	// healthCheckTick is the interval to look for the policies due to be probed
+	healthCheckTick = time.Second
+)
+
+// healthChecker probes the endpoints imported for the GlobalTrafficPolicy policies in the background,
+// the reconciliation of a policy is triggered when the health of any of its endpoints is changed
+type healthChecker struct {
+	probe  func(address string, timeout time.Duration) bool
+	sem    chan struct{}
+	events chan event.GenericEvent
+
+	mu      sync.Mutex
+	targets map[types.NamespacedName]*healthCheckTarget
+}
+
+// healthCheckTarget is the set of endpoints probed for a policy along with the health check settings
+type healthCheckTarget struct {
+	endpoints          map[string]*endpointHealth
+	interval           time.Duration
+	timeout            time.Duration
+	unhealthyThreshold int32
+	healthyThreshold   int32
+	nextCheck          time.Time
+	checking           bool
+}
+
+// endpointHealth tracks the consecutive probe results of an endpoint
+type endpointHealth struct {
+	healthy   bool
+	successes int32
+	failures  int32
+}
+
+func newHealthChecker(probe func(address string, timeout time.Duration) bool) *healthChecker {
+	return &healthChecker{
+		probe:   probe,
+		sem:     make(chan struct{}, maxConcurrentProbes),
+		events:  make(chan event.GenericEvent, 64),
+		targets: make(map[types.NamespacedName]*healthCheckTarget),
+	}
+}
+
+// NeedLeaderElection returns true as the endpoints are probed only by the leader running the reconciler
+func (c *healthChecker) NeedLeaderElection() bool {
+	return true
+}
+
+// Start checks the health of the endpoints of the policies due to be probed until ctx is done
+func (c *healthChecker) Start(ctx context.Context) error {
+	ticker := time.NewTicker(healthCheckTick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			now := time.Now()
+			c.mu.Lock()
+			for key, target := range c.targets {
+				if target.checking || now.Before(target.nextCheck) {
+					continue
+				}
+				target.checking = true
+				go c.CheckHealth(ctx, key)
+			}
+			c.mu.Unlock()
+		}
+	}
+}
+
+// Watch sets the endpoints probed for the given policy and returns their health, the endpoints not probed yet
+// are considered healthy until proven otherwise
+func (c *healthChecker) Watch(key types.NamespacedName, endpoints map[string][]string, interval, timeout time.Duration, unhealthyThreshold, healthyThreshold int32) map[string]bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	target, ok := c.targets[key]
+	if !ok {
+		target = &healthCheckTarget{nextCheck: time.Now()}
+		c.targets[key] = target
+	}
+	target.interval = interval
+	target.timeout = timeout
+	target.unhealthyThreshold = unhealthyThreshold
+	target.healthyThreshold = healthyThreshold
+
+	tracked := make(map[string]*endpointHealth)
+	health := make(map[string]bool)
+	for _, addresses := range endpoints {
+		for _, address := range addresses {
+			eh, exists := target.endpoints[address]
+			if !exists {
+				eh = &endpointHealth{healthy: true}
+			}
+			tracked[address] = eh
+			health[address] = eh.healthy
+		}
+	}
+	target.endpoints = tracked
+
+	return health
+}
+
+// Forget stops probing the endpoints of the given policy
+func (c *healthChecker) Forget(key types.NamespacedName) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.targets, key)
+}
+
+// CheckHealth probes the endpoints of the given policy with bounded concurrency and updates their health,
+// the policy is enqueued for reconciliation if the health of any endpoint is changed
+func (c *healthChecker) CheckHealth(ctx context.Context, key types.NamespacedName) {
+	c.mu.Lock()
+	target, ok := c.targets[key]
+	if !ok {
+		c.mu.Unlock()
+		return
+	}
+	addresses := make([]string, 0, len(target.endpoints))
+	for address := range target.endpoints {
+		addresses = append(addresses, address)
+	}
+	timeout := target.timeout
+	c.mu.Unlock()
+
+	results := make(map[string]bool)
+	var resultsMu sync.Mutex
+	var wg sync.WaitGroup
+	for _, address := range addresses {
+		select {
+		case c.sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		}
+		wg.Add(1)
+		go func(address string) {
+			defer func() {
+				<-c.sem
+				wg.Done()
+			}()
+			ok := c.probe(address, timeout)
+			resultsMu.Lock()
+			results[address] = ok
+			resultsMu.Unlock()
+		}(address)
+	}
+	wg.Wait()
+
+	c.mu.Lock()
+	target, ok = c.targets[key]
+	if !ok {
+		// forgotten meanwhile
+		c.mu.Unlock()
+		return
+	}
+	target.checking = false
+	target.nextCheck = time.Now().Add(target.interval)
+
+	changed := false
+	for address, ok := range results {
+		eh, exists := target.endpoints[address]
+		if !exists {
+			// removed meanwhile
+			continue
+		}
+		if eh.observe(ok, target.unhealthyThreshold, target.healthyThreshold) {
+			changed = true
+		}
+	}
+	c.mu.Unlock()
+
+	if changed {
+		select {
+		case c.events <- event.GenericEvent{Object: &mcsv1alpha1.GlobalTrafficPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}}}:
+		case <-ctx.Done():
+		}
+	}
+}
+
+// observe records a probe result and returns true if the health of the endpoint is changed
+func (eh *endpointHealth) observe(ok bool, unhealthyThreshold, healthyThreshold int32) bool {
+	if ok {
+		eh.successes++
+		eh.failures = 0
+		if !eh.healthy && eh.successes >= healthyThreshold {
+			eh.healthy = true
+			return true
+		}
+		return false
+	}
+
+	eh.failures++
+	eh.successes = 0
+	if eh.healthy && eh.failures >= unhealthyThreshold {
+		eh.healthy = false
+		return true
+	}
+	return false
+}
+
+func tcpProbe(address string, timeout time.Duration) bool {
+	conn, err := net.DialTimeout("tcp", address, timeout)
+	if err != nil {
+		return false
+	}
+	_ = conn.Close()
+
+	return true
+}
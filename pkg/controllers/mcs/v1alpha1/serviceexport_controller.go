@@ -27,6 +27,8 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"net"
+	"strconv"
 	"time"
 
 	whtypes "github.com/flomesh-io/fsm/pkg/webhook/types"
@@ -50,6 +52,7 @@ import (
 	"github.com/flomesh-io/fsm/pkg/constants"
 	fctx "github.com/flomesh-io/fsm/pkg/context"
 	"github.com/flomesh-io/fsm/pkg/controllers"
+	mgrutils "github.com/flomesh-io/fsm/pkg/manager/utils"
 	"github.com/flomesh-io/fsm/pkg/utils"
 )
 
@@ -99,7 +102,7 @@ func (r *serviceExportReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
 			// Return and don't requeue
 			log.Info().Msgf("[ServiceExport] ServiceExport resource not found. Ignoring since object must be deleted")
-			return ctrl.Result{}, nil
+			return ctrl.Result{}, r.updateServiceExportRoutes(ctx)
 		}
 		// Error reading the object - requeue the request.
 		log.Error().Msgf("Failed to get ServiceExport, %#v", err)
@@ -134,12 +137,20 @@ func (r *serviceExportReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 
 	mc := r.fctx.Configurator
 	if mc.IsIngressEnabled() {
+		// GRPC and TCP ports are routed by SNI through the TLS port of ingress controller
+		if hasSNIRules(export) && !mc.IsIngressTLSEnabled() {
+			return r.ingressTLSDisabled(ctx, req, export)
+		}
+
 		// Find and compare path from ingress
 		ingList := &networkingv1.IngressList{}
 		if err := r.fctx.List(ctx, ingList, client.InNamespace(corev1.NamespaceAll)); err != nil {
 			return r.failedListIngresses(ctx, export, err)
 		}
 		for _, er := range export.Spec.Rules {
+			if er.IsRoutedBySNI() {
+				continue
+			}
 			for _, ing := range ingList.Items {
 				ing := ing // fix lint GO-LOOP-REF
 				// should not check against itself
@@ -156,6 +167,15 @@ func (r *serviceExportReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 			}
 		}
 
+		if err := r.updateServiceExportRoutes(ctx); err != nil {
+			return ctrl.Result{}, err
+		}
+
+		// no Ingress is needed if all the ports are routed by SNI
+		if len(ingressPaths(export)) == 0 {
+			return r.successExport(ctx, req, export)
+		}
+
 		// create Ingress for the ServiceExport
 		ing := &networkingv1.Ingress{}
 		if err := r.fctx.Get(
@@ -332,6 +352,85 @@ func (r *serviceExportReconciler) pathConflicts(ctx context.Context, export *mcs
 	return ctrl.Result{}, nil
 }
 
+func (r *serviceExportReconciler) ingressTLSDisabled(ctx context.Context, req ctrl.Request, export *mcsv1alpha1.ServiceExport) (ctrl.Result, error) {
+	metautil.SetStatusCondition(&export.Status.Conditions, metav1.Condition{
+		Type:               string(mcsv1alpha1.ServiceExportValid),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: export.Generation,
+		LastTransitionTime: metav1.Time{Time: time.Now()},
+		Reason:             "Failed",
+		Message:            fmt.Sprintf("Service %s has GRPC or TCP ports to export, which requires TLS of ingress controller enabled.", req.NamespacedName),
+	})
+
+	if err := r.fctx.Status().Update(ctx, export); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// stop processing
+	return ctrl.Result{}, nil
+}
+
+// updateServiceExportRoutes updates the routes of ingress controller for all the ports exported as GRPC or TCP
+func (r *serviceExportReconciler) updateServiceExportRoutes(ctx context.Context) error {
+	mc := r.fctx.Configurator
+	if !mc.IsIngressEnabled() || !mc.IsIngressTLSEnabled() {
+		return nil
+	}
+
+	exports := &mcsv1alpha1.ServiceExportList{}
+	if err := r.fctx.List(ctx, exports); err != nil {
+		return err
+	}
+
+	routes := make(map[string]string)
+	for _, export := range exports.Items {
+		export := export // fix lint GO-LOOP-REF
+		if !hasSNIRules(&export) {
+			continue
+		}
+
+		svc := &corev1.Service{}
+		if err := r.fctx.Get(ctx, client.ObjectKeyFromObject(&export), svc); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		if svc.DeletionTimestamp != nil || svc.Spec.Type == corev1.ServiceTypeExternalName {
+			continue
+		}
+
+		for _, rule := range export.Spec.Rules {
+			if rule.IsRoutedBySNI() {
+				routes[mcsv1alpha1.ServiceExportServerName(export.Namespace, export.Name, rule.PortNumber)] = serviceAddress(svc, rule.PortNumber)
+			}
+		}
+	}
+
+	return mgrutils.UpdateServiceExportRoutes(constants.DefaultIngressBasePath, r.fctx.RepoClient, routes)
+}
+
+func hasSNIRules(export *mcsv1alpha1.ServiceExport) bool {
+	for _, rule := range export.Spec.Rules {
+		if rule.IsRoutedBySNI() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// serviceAddress returns the address ingress controller connects to for the port of the service
+func serviceAddress(svc *corev1.Service, port int32) string {
+	host := svc.Spec.ClusterIP
+	if len(host) == 0 || host == corev1.ClusterIPNone {
+		host = fmt.Sprintf("%s.%s.svc", svc.Name, svc.Namespace)
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(port)))
+}
+
 func newIngress(export *mcsv1alpha1.ServiceExport) *networkingv1.Ingress {
 	return &networkingv1.Ingress{
 		ObjectMeta: metav1.ObjectMeta{
@@ -384,6 +483,9 @@ func ingressAnnotations(export *mcsv1alpha1.ServiceExport) map[string]string {
 func ingressPaths(export *mcsv1alpha1.ServiceExport) []networkingv1.HTTPIngressPath {
 	paths := make([]networkingv1.HTTPIngressPath, 0)
 	for _, rule := range export.Spec.Rules {
+		if rule.IsRoutedBySNI() {
+			continue
+		}
 		paths = append(paths, networkingv1.HTTPIngressPath{
 			Path:     rule.Path,
 			PathType: rule.PathType,
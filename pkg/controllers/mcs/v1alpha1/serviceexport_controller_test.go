@@ -0,0 +1,311 @@
+package v1alpha1
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metautil "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mcsv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/multicluster/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/configurator"
+	"github.com/flomesh-io/fsm/pkg/constants"
+	fctx "github.com/flomesh-io/fsm/pkg/context"
+	mcsscheme "github.com/flomesh-io/fsm/pkg/gen/client/multicluster/clientset/versioned/scheme"
+	"github.com/flomesh-io/fsm/pkg/repo"
+)
+
+// fakeRepo is a minimal pipy repo keeping the files in memory
+type fakeRepo struct {
+	mu    sync.Mutex
+	files map[string]string
+}
+
+func (f *fakeRepo) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case strings.HasPrefix(req.URL.Path, constants.DefaultPipyFileAPIPath+"/"):
+		path := strings.TrimPrefix(req.URL.Path, constants.DefaultPipyFileAPIPath)
+		switch req.Method {
+		case http.MethodGet:
+			content, ok := f.files[path]
+			if !ok {
+				content = "{}"
+			}
+			_, _ = io.WriteString(w, content)
+		case http.MethodPost:
+			body, _ := io.ReadAll(req.Body)
+			f.files[path] = string(body)
+		}
+	case strings.HasPrefix(req.URL.Path, constants.DefaultPipyRepoAPIPath+"/"):
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"version": "1"}`)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (f *fakeRepo) serviceExportRoutes() map[string]string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	routes := make(map[string]string)
+	for k, v := range gjson.Get(f.files[constants.DefaultIngressBasePath+"/config/main.json"], "serviceExports").Map() {
+		routes[k] = v.String()
+	}
+	return routes
+}
+
+func newServiceExportReconciler(t *testing.T, ingressTLSEnabled bool, objs ...client.Object) (*serviceExportReconciler, *fakeRepo) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = mcsscheme.AddToScheme(scheme)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&mcsv1alpha1.ServiceExport{}).
+		Build()
+
+	mockCtrl := gomock.NewController(t)
+	mockConfigurator := configurator.NewMockConfigurator(mockCtrl)
+	mockConfigurator.EXPECT().IsIngressEnabled().Return(true).AnyTimes()
+	mockConfigurator.EXPECT().IsIngressTLSEnabled().Return(ingressTLSEnabled).AnyTimes()
+
+	repoServer := &fakeRepo{files: make(map[string]string)}
+	server := httptest.NewServer(repoServer)
+	t.Cleanup(server.Close)
+
+	return &serviceExportReconciler{
+		fctx: &fctx.ControllerContext{
+			Client:       c,
+			Scheme:       scheme,
+			Configurator: mockConfigurator,
+			RepoClient:   repo.NewRepoClient(server.URL, "info"),
+		},
+	}, repoServer
+}
+
+func newServiceExport(rules ...mcsv1alpha1.ServiceExportRule) *mcsv1alpha1.ServiceExport {
+	return &mcsv1alpha1.ServiceExport{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "svc"},
+		Spec:       mcsv1alpha1.ServiceExportSpec{Rules: rules},
+	}
+}
+
+func newExportedService(svcType corev1.ServiceType) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "svc"},
+		Spec:       corev1.ServiceSpec{Type: svcType, ClusterIP: "10.96.0.10"},
+	}
+}
+
+func TestServiceExportReconcile(t *testing.T) {
+	pathType := networkingv1.PathTypePrefix
+	httpRule := mcsv1alpha1.ServiceExportRule{PortNumber: 80, Protocol: mcsv1alpha1.ServiceExportProtocolHTTP, Path: "/svc", PathType: &pathType}
+	tcpRule := mcsv1alpha1.ServiceExportRule{PortNumber: 5432, Protocol: mcsv1alpha1.ServiceExportProtocolTCP}
+
+	testCases := []struct {
+		name              string
+		export            *mcsv1alpha1.ServiceExport
+		service           *corev1.Service
+		ingressTLSEnabled bool
+		expectedStatus    metav1.ConditionStatus
+		expectedMessage   string
+		expectedIngress   bool
+		expectedRoutes    map[string]string
+	}{
+		{
+			name:            "service not found",
+			export:          newServiceExport(httpRule),
+			expectedStatus:  metav1.ConditionFalse,
+			expectedMessage: "Service test/svc not found",
+			expectedRoutes:  map[string]string{},
+		},
+		{
+			name:            "ExternalName service cannot be exported",
+			export:          newServiceExport(httpRule),
+			service:         newExportedService(corev1.ServiceTypeExternalName),
+			expectedStatus:  metav1.ConditionFalse,
+			expectedMessage: "cannot be exported",
+			expectedRoutes:  map[string]string{},
+		},
+		{
+			name:              "TCP port requires TLS of ingress controller",
+			export:            newServiceExport(tcpRule),
+			service:           newExportedService(corev1.ServiceTypeClusterIP),
+			ingressTLSEnabled: false,
+			expectedStatus:    metav1.ConditionFalse,
+			expectedMessage:   "requires TLS of ingress controller enabled",
+			expectedRoutes:    map[string]string{},
+		},
+		{
+			name:              "TCP port is routed by SNI without Ingress",
+			export:            newServiceExport(tcpRule),
+			service:           newExportedService(corev1.ServiceTypeClusterIP),
+			ingressTLSEnabled: true,
+			expectedStatus:    metav1.ConditionTrue,
+			expectedMessage:   "exported successfully",
+			expectedRoutes:    map[string]string{"5432.svc.test.svc.clusterset.local": "10.96.0.10:5432"},
+		},
+		{
+			name:              "HTTP and TCP ports are exported",
+			export:            newServiceExport(httpRule, tcpRule),
+			service:           newExportedService(corev1.ServiceTypeClusterIP),
+			ingressTLSEnabled: true,
+			expectedStatus:    metav1.ConditionTrue,
+			expectedMessage:   "exported successfully",
+			expectedIngress:   true,
+			expectedRoutes:    map[string]string{"5432.svc.test.svc.clusterset.local": "10.96.0.10:5432"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			objs := []client.Object{tc.export}
+			if tc.service != nil {
+				objs = append(objs, tc.service)
+			}
+			r, repoServer := newServiceExportReconciler(t, tc.ingressTLSEnabled, objs...)
+
+			key := types.NamespacedName{Namespace: "test", Name: "svc"}
+			_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: key})
+			assert.NoError(err)
+
+			export := &mcsv1alpha1.ServiceExport{}
+			assert.NoError(r.fctx.Get(context.TODO(), key, export))
+			cond := metautil.FindStatusCondition(export.Status.Conditions, string(mcsv1alpha1.ServiceExportValid))
+			if assert.NotNil(cond) {
+				assert.Equal(tc.expectedStatus, cond.Status)
+				assert.Contains(cond.Message, tc.expectedMessage)
+			}
+
+			ing := &networkingv1.Ingress{}
+			err = r.fctx.Get(context.TODO(), types.NamespacedName{Namespace: "test", Name: "svcexp-ing-svc"}, ing)
+			assert.Equal(tc.expectedIngress, err == nil)
+			if tc.expectedIngress {
+				// only the HTTP ports are routed by the Ingress
+				if assert.Len(ing.Spec.Rules, 1) && assert.Len(ing.Spec.Rules[0].HTTP.Paths, 1) {
+					assert.Equal("/svc", ing.Spec.Rules[0].HTTP.Paths[0].Path)
+				}
+			}
+
+			assert.Equal(tc.expectedRoutes, repoServer.serviceExportRoutes())
+		})
+	}
+}
+
+func TestServiceExportReconcileStatusTransitions(t *testing.T) {
+	assert := tassert.New(t)
+
+	tcpRule := mcsv1alpha1.ServiceExportRule{PortNumber: 5432, Protocol: mcsv1alpha1.ServiceExportProtocolTCP}
+	r, repoServer := newServiceExportReconciler(t, true, newServiceExport(tcpRule))
+
+	key := types.NamespacedName{Namespace: "test", Name: "svc"}
+	reconcile := func() *metav1.Condition {
+		_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: key})
+		assert.NoError(err)
+
+		export := &mcsv1alpha1.ServiceExport{}
+		assert.NoError(r.fctx.Get(context.TODO(), key, export))
+		return metautil.FindStatusCondition(export.Status.Conditions, string(mcsv1alpha1.ServiceExportValid))
+	}
+
+	// invalid until the service is created
+	cond := reconcile()
+	assert.Equal(metav1.ConditionFalse, cond.Status)
+	assert.Empty(repoServer.serviceExportRoutes())
+
+	// valid once the service exists
+	assert.NoError(r.fctx.Create(context.TODO(), newExportedService(corev1.ServiceTypeClusterIP)))
+	cond = reconcile()
+	assert.Equal(metav1.ConditionTrue, cond.Status)
+	assert.Equal(map[string]string{"5432.svc.test.svc.clusterset.local": "10.96.0.10:5432"}, repoServer.serviceExportRoutes())
+
+	// the route is removed along with the ServiceExport
+	export := &mcsv1alpha1.ServiceExport{}
+	assert.NoError(r.fctx.Get(context.TODO(), key, export))
+	assert.NoError(r.fctx.Delete(context.TODO(), export))
+	_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: key})
+	assert.NoError(err)
+	assert.Empty(repoServer.serviceExportRoutes())
+}
+
+func TestUpdateServiceExportRoutes(t *testing.T) {
+	assert := tassert.New(t)
+
+	pathType := networkingv1.PathTypePrefix
+	export := func(name string, rules ...mcsv1alpha1.ServiceExportRule) *mcsv1alpha1.ServiceExport {
+		return &mcsv1alpha1.ServiceExport{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+			Spec:       mcsv1alpha1.ServiceExportSpec{Rules: rules},
+		}
+	}
+	service := func(name string, svcType corev1.ServiceType, clusterIP string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+			Spec:       corev1.ServiceSpec{Type: svcType, ClusterIP: clusterIP},
+		}
+	}
+	grpcRule := mcsv1alpha1.ServiceExportRule{PortNumber: 9090, Protocol: mcsv1alpha1.ServiceExportProtocolGRPC}
+	tcpRule := mcsv1alpha1.ServiceExportRule{PortNumber: 5432, Protocol: mcsv1alpha1.ServiceExportProtocolTCP}
+	httpRule := mcsv1alpha1.ServiceExportRule{PortNumber: 80, Protocol: mcsv1alpha1.ServiceExportProtocolHTTP, Path: "/web", PathType: &pathType}
+
+	r, repoServer := newServiceExportReconciler(t, true,
+		// GRPC and TCP ports of the same service
+		export("db", grpcRule, tcpRule), service("db", corev1.ServiceTypeClusterIP, "10.96.0.10"),
+		// headless service is reached by its DNS name
+		export("headless", tcpRule), service("headless", corev1.ServiceTypeClusterIP, corev1.ClusterIPNone),
+		// HTTP ports are routed by path
+		export("web", httpRule), service("web", corev1.ServiceTypeClusterIP, "10.96.0.11"),
+		// ExternalName service cannot be exported
+		export("external", tcpRule), service("external", corev1.ServiceTypeExternalName, ""),
+		// service doesn't exist
+		export("missing", tcpRule),
+	)
+
+	assert.NoError(r.updateServiceExportRoutes(context.TODO()))
+	assert.Equal(map[string]string{
+		"9090.db.test.svc.clusterset.local":       "10.96.0.10:9090",
+		"5432.db.test.svc.clusterset.local":       "10.96.0.10:5432",
+		"5432.headless.test.svc.clusterset.local": "headless.test.svc:5432",
+	}, repoServer.serviceExportRoutes())
+
+	// the routes of the deleted services are removed
+	assert.NoError(r.fctx.Delete(context.TODO(), service("db", corev1.ServiceTypeClusterIP, "10.96.0.10")))
+	assert.NoError(r.updateServiceExportRoutes(context.TODO()))
+	assert.Equal(map[string]string{
+		"5432.headless.test.svc.clusterset.local": "headless.test.svc:5432",
+	}, repoServer.serviceExportRoutes())
+}
+
+func TestUpdateServiceExportRoutesIngressTLSDisabled(t *testing.T) {
+	assert := tassert.New(t)
+
+	tcpRule := mcsv1alpha1.ServiceExportRule{PortNumber: 5432, Protocol: mcsv1alpha1.ServiceExportProtocolTCP}
+	r, repoServer := newServiceExportReconciler(t, false, newServiceExport(tcpRule), newExportedService(corev1.ServiceTypeClusterIP))
+
+	// nothing is routed by SNI without TLS of ingress controller, the repo is left untouched
+	assert.NoError(r.updateServiceExportRoutes(context.TODO()))
+	assert.Empty(repoServer.files)
+}
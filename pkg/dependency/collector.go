@@ -0,0 +1,212 @@
+package dependency
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/flomesh-io/fsm/pkg/configurator"
+	"github.com/flomesh-io/fsm/pkg/k8s"
+	"github.com/flomesh-io/fsm/pkg/telemetry"
+)
+
+// NewCollector returns a collector learning the services called by workloads
+func NewCollector(kubeController k8s.Controller, cfg configurator.Configurator) *Collector {
+	return &Collector{
+		kubeController: kubeController,
+		cfg:            cfg,
+		scraper:        telemetry.NewScraper(upstreamRequestTotalMetric, upstreamSendBytesTotalMetric),
+		snapshots:      make(map[podKey]*podSnapshot),
+	}
+}
+
+// Run scrapes the sidecars periodically while dependency discovery is enabled
+func (c *Collector) Run(stop <-chan struct{}) {
+	ticker := time.NewTicker(scrapeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			log.Info().Msg("Received stop signal, exiting dependency collector")
+			return
+
+		case <-ticker.C:
+			if !c.cfg.GetMeshConfig().Spec.Observability.DependencyDiscovery.Enable {
+				continue
+			}
+			c.scrape()
+		}
+	}
+}
+
+func (c *Collector) scrape() {
+	now := time.Now()
+	c.scraper.ScrapeAll(c.kubeController.ListPods(), func(pod *corev1.Pod, samples []telemetry.Sample) {
+		requests, bytes := upstreamServices(samples)
+		c.update(podKey{namespace: pod.Namespace, name: pod.Name}, pod.Spec.ServiceAccountName, requests, bytes, now)
+	})
+	c.prune(now)
+}
+
+// update merges the counters scraped from a pod into its snapshot
+func (c *Collector) update(key podKey, serviceAccount string, requests, bytes map[Service]uint64, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	snapshot, ok := c.snapshots[key]
+	if !ok {
+		snapshot = &podSnapshot{
+			requests: telemetry.NewCounters[Service](),
+			bytes:    telemetry.NewCounters[Service](),
+		}
+		c.snapshots[key] = snapshot
+	}
+	snapshot.serviceAccount = serviceAccount
+	snapshot.requests.Update(requests, now)
+	snapshot.bytes.Update(bytes, now)
+}
+
+// prune drops the dependencies not seen within the retention period
+func (c *Collector) prune(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, snapshot := range c.snapshots {
+		noRequests := snapshot.requests.Prune(now.Add(-retention))
+		noBytes := snapshot.bytes.Prune(now.Add(-retention))
+		if noRequests && noBytes {
+			delete(c.snapshots, key)
+		}
+	}
+}
+
+// ListDependencies returns the services called by workloads aggregated per service account,
+// optionally filtered by the namespace of the workloads
+func (c *Collector) ListDependencies(namespace string) []Dependency {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	type dependencyKey struct {
+		source      Workload
+		destination Service
+	}
+
+	dependencies := make(map[dependencyKey]*Dependency)
+	get := func(source Workload, svc Service) *Dependency {
+		dk := dependencyKey{source: source, destination: svc}
+		dep, ok := dependencies[dk]
+		if !ok {
+			dep = &Dependency{Source: source, Destination: svc}
+			dependencies[dk] = dep
+		}
+		return dep
+	}
+
+	for key, snapshot := range c.snapshots {
+		if len(namespace) > 0 && key.namespace != namespace {
+			continue
+		}
+
+		source := Workload{Namespace: key.namespace, ServiceAccount: snapshot.serviceAccount}
+		snapshot.requests.Range(func(svc Service, count uint64, lastSeen time.Time) {
+			dep := get(source, svc)
+			dep.Requests += count
+			if lastSeen.After(dep.LastSeen) {
+				dep.LastSeen = lastSeen
+			}
+		})
+		snapshot.bytes.Range(func(svc Service, count uint64, lastSeen time.Time) {
+			dep := get(source, svc)
+			dep.Bytes += count
+			if lastSeen.After(dep.LastSeen) {
+				dep.LastSeen = lastSeen
+			}
+		})
+	}
+
+	list := make([]Dependency, 0, len(dependencies))
+	for _, dep := range dependencies {
+		list = append(list, *dep)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if a.Source != b.Source {
+			if a.Source.Namespace != b.Source.Namespace {
+				return a.Source.Namespace < b.Source.Namespace
+			}
+			return a.Source.ServiceAccount < b.Source.ServiceAccount
+		}
+		if a.Destination.Namespace != b.Destination.Namespace {
+			return a.Destination.Namespace < b.Destination.Namespace
+		}
+		if a.Destination.Name != b.Destination.Name {
+			return a.Destination.Name < b.Destination.Name
+		}
+		return a.Destination.TargetPort < b.Destination.TargetPort
+	})
+	return list
+}
+
+// Handler returns the HTTP handler serving the service dependencies as JSON,
+// the namespace query parameter limits the dependencies to the workloads of a namespace
+func (c *Collector) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		dependencies := c.ListDependencies(req.URL.Query().Get("namespace"))
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(dependencies); err != nil {
+			log.Error().Err(err).Msg("Error encoding service dependencies")
+		}
+	})
+}
+
+// upstreamServices returns the request and sent bytes counters of the upstream services in the given samples
+func upstreamServices(samples []telemetry.Sample) (map[Service]uint64, map[Service]uint64) {
+	requests := make(map[Service]uint64)
+	bytes := make(map[Service]uint64)
+	for _, sample := range samples {
+		svc, ok := parseClusterName(sample.Labels[clusterNameLabel])
+		if !ok || sample.Value < 0 {
+			continue
+		}
+
+		switch sample.Name {
+		case upstreamRequestTotalMetric:
+			requests[svc] += uint64(sample.Value)
+		case upstreamSendBytesTotalMetric:
+			bytes[svc] += uint64(sample.Value)
+		}
+	}
+	return requests, bytes
+}
+
+// parseClusterName returns the service of an outbound cluster named <namespace>/<name>|<port>,
+// inbound clusters suffixed by |local and clusters of other kinds are ignored
+func parseClusterName(clusterName string) (Service, bool) {
+	parts := strings.Split(clusterName, "|")
+	if len(parts) != 2 {
+		return Service{}, false
+	}
+
+	namespace, name, found := strings.Cut(parts[0], "/")
+	if !found || len(namespace) == 0 || len(name) == 0 {
+		return Service{}, false
+	}
+
+	port, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return Service{}, false
+	}
+
+	// The cluster of a headless service is named after the subdomain of its pod
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+
+	return Service{Namespace: namespace, Name: name, TargetPort: port}, true
+}
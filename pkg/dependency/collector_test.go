@@ -0,0 +1,92 @@
+package dependency
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/flomesh-io/fsm/pkg/telemetry"
+)
+
+func TestParseClusterName(t *testing.T) {
+	testCases := []struct {
+		name        string
+		clusterName string
+		expected    Service
+		expectedOK  bool
+	}{
+		{
+			name:        "outbound cluster",
+			clusterName: "bookstore/bookstore-v1|14001",
+			expected:    Service{Namespace: "bookstore", Name: "bookstore-v1", TargetPort: 14001},
+			expectedOK:  true,
+		},
+		{
+			name:        "headless service cluster",
+			clusterName: "mysql/mysql-0.mysql|3306",
+			expected:    Service{Namespace: "mysql", Name: "mysql", TargetPort: 3306},
+			expectedOK:  true,
+		},
+		{
+			name:        "inbound cluster",
+			clusterName: "bookstore/bookstore|14001|local",
+			expectedOK:  false,
+		},
+		{
+			name:        "egress cluster",
+			clusterName: "httpbin.org:80",
+			expectedOK:  false,
+		},
+		{
+			name:        "invalid port",
+			clusterName: "bookstore/bookstore|http",
+			expectedOK:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			svc, ok := parseClusterName(tc.clusterName)
+			assert.Equal(tc.expectedOK, ok)
+			assert.Equal(tc.expected, svc)
+		})
+	}
+}
+
+func TestListDependencies(t *testing.T) {
+	assert := tassert.New(t)
+
+	metrics := `sidecar_cluster_upstream_rq_total{source_workload_pod="bookbuyer-1",sidecar_cluster_name="bookstore/bookstore|14001"} 10
+sidecar_cluster_upstream_rq_total{source_workload_pod="bookbuyer-1",sidecar_cluster_name="bookstore/bookstore|14001"} 2
+sidecar_cluster_upstream_rq_total{source_workload_pod="bookbuyer-1",sidecar_cluster_name="bookstore/bookstore-v2|14001"} 0
+sidecar_cluster_upstream_rq_total{source_workload_pod="bookbuyer-1",sidecar_cluster_name="bookbuyer/bookbuyer|14001|local"} 7
+sidecar_cluster_upstream_cx_tx_bytes_total{sidecar_cluster_name="bookstore/bookstore|14001"} 2048
+`
+	samples, err := telemetry.Parse(strings.NewReader(metrics), upstreamRequestTotalMetric, upstreamSendBytesTotalMetric)
+	assert.Nil(err)
+
+	requests, bytes := upstreamServices(samples)
+	bookstore := Service{Namespace: "bookstore", Name: "bookstore", TargetPort: 14001}
+	assert.Equal(map[Service]uint64{bookstore: 12, {Namespace: "bookstore", Name: "bookstore-v2", TargetPort: 14001}: 0}, requests)
+	assert.Equal(map[Service]uint64{bookstore: 2048}, bytes)
+
+	c := &Collector{snapshots: make(map[podKey]*podSnapshot)}
+	now := time.Now()
+	c.update(podKey{namespace: "bookbuyer", name: "bookbuyer-1"}, "bookbuyer", requests, bytes, now)
+	c.update(podKey{namespace: "bookbuyer", name: "bookbuyer-2"}, "bookbuyer", map[Service]uint64{bookstore: 3}, nil, now)
+	c.update(podKey{namespace: "bookthief", name: "bookthief-1"}, "bookthief", map[Service]uint64{bookstore: 1}, nil, now)
+
+	source := Workload{Namespace: "bookbuyer", ServiceAccount: "bookbuyer"}
+	assert.Equal([]Dependency{
+		{Source: source, Destination: bookstore, Requests: 15, Bytes: 2048, LastSeen: now},
+	}, c.ListDependencies("bookbuyer"))
+	assert.Len(c.ListDependencies(""), 2)
+
+	c.prune(now.Add(retention + time.Minute))
+	assert.Empty(c.ListDependencies(""))
+	assert.Empty(c.snapshots)
+}
@@ -0,0 +1,79 @@
+// Package dependency learns the services each workload calls from the metrics of the sidecars
+// and exposes them as a service dependency graph.
+package dependency
+
+import (
+	"sync"
+	"time"
+
+	"github.com/flomesh-io/fsm/pkg/configurator"
+	"github.com/flomesh-io/fsm/pkg/k8s"
+	"github.com/flomesh-io/fsm/pkg/logger"
+	"github.com/flomesh-io/fsm/pkg/telemetry"
+)
+
+var log = logger.New("dependency")
+
+const (
+	// upstreamRequestTotalMetric is the sidecar metric counting the requests sent to an upstream cluster
+	upstreamRequestTotalMetric = "sidecar_cluster_upstream_rq_total"
+
+	// upstreamSendBytesTotalMetric is the sidecar metric counting the bytes sent to an upstream cluster
+	upstreamSendBytesTotalMetric = "sidecar_cluster_upstream_cx_tx_bytes_total"
+
+	// clusterNameLabel is the label of the upstream cluster name in sidecar metrics
+	clusterNameLabel = "sidecar_cluster_name"
+
+	// scrapeInterval is the interval at which sidecar metrics are scraped
+	scrapeInterval = 60 * time.Second
+
+	// retention is how long dependencies that are no longer seen are kept, long enough
+	// for dependencies of workloads running daily to be kept
+	retention = 7 * 24 * time.Hour
+)
+
+// Workload is a workload calling services, identified by its service account
+type Workload struct {
+	Namespace      string `json:"namespace"`
+	ServiceAccount string `json:"serviceAccount"`
+}
+
+// Service is a mesh service called by workloads, along with the target port it is called on
+// as outbound clusters are named after the target port of the service
+type Service struct {
+	Namespace  string `json:"namespace"`
+	Name       string `json:"name"`
+	TargetPort int    `json:"targetPort"`
+}
+
+// Dependency is a service called by a workload
+type Dependency struct {
+	Source      Workload  `json:"source"`
+	Destination Service   `json:"destination"`
+	Requests    uint64    `json:"requests"`
+	Bytes       uint64    `json:"bytes"`
+	LastSeen    time.Time `json:"lastSeen"`
+}
+
+// podKey identifies the pod a scraped snapshot belongs to
+type podKey struct {
+	namespace string
+	name      string
+}
+
+// podSnapshot is the upstream services called by a pod
+type podSnapshot struct {
+	serviceAccount string
+	requests       *telemetry.Counters[Service]
+	bytes          *telemetry.Counters[Service]
+}
+
+// Collector scrapes the upstream metrics of sidecars and aggregates the services called by workloads
+type Collector struct {
+	kubeController k8s.Controller
+	cfg            configurator.Configurator
+	scraper        *telemetry.Scraper
+
+	mu        sync.RWMutex
+	snapshots map[podKey]*podSnapshot
+}
@@ -0,0 +1,199 @@
+package dns
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/jonboulle/clockwork"
+	"github.com/miekg/dns"
+
+	configv1alpha3 "github.com/flomesh-io/fsm/pkg/apis/config/v1alpha3"
+	"github.com/flomesh-io/fsm/pkg/lru"
+	"github.com/flomesh-io/fsm/pkg/metricsstore"
+)
+
+const (
+	defaultCacheMaxEntries         = 10240
+	defaultCacheMaxTTL             = time.Hour
+	defaultCacheNegativeTTL        = 30 * time.Second
+	defaultCachePrefetchHits       = 10
+	defaultCachePrefetchPercentage = 10
+)
+
+// cacheEntry is a cached upstream response with its expiry metadata
+type cacheEntry struct {
+	msg         *dns.Msg
+	negative    bool
+	ttl         time.Duration
+	expireAt    time.Time
+	hits        atomic.Uint32
+	prefetching atomic.Bool
+}
+
+// Cache is a size bounded cache of upstream DNS responses respecting their TTLs
+type Cache struct {
+	entries     *expirable.LRU[string, *cacheEntry]
+	clock       clockwork.Clock
+	minTTL      time.Duration
+	maxTTL      time.Duration
+	negativeTTL time.Duration
+
+	prefetch           bool
+	prefetchHits       uint32
+	prefetchPercentage uint32
+}
+
+// NewCache returns a new Cache built from the local DNS proxy cache spec
+func NewCache(spec configv1alpha3.LocalDNSCache, clock clockwork.Clock) *Cache {
+	c := &Cache{
+		clock:              clock,
+		minTTL:             spec.MinTTL.Duration,
+		maxTTL:             spec.MaxTTL.Duration,
+		negativeTTL:        spec.NegativeTTL.Duration,
+		prefetch:           spec.Prefetch.Enable,
+		prefetchHits:       spec.Prefetch.Hits,
+		prefetchPercentage: spec.Prefetch.Percentage,
+	}
+
+	maxEntries := spec.MaxEntries
+	if maxEntries <= 0 {
+		maxEntries = defaultCacheMaxEntries
+	}
+	if c.maxTTL <= 0 {
+		c.maxTTL = defaultCacheMaxTTL
+	}
+	if c.minTTL > c.maxTTL {
+		c.minTTL = c.maxTTL
+	}
+	if c.negativeTTL <= 0 {
+		c.negativeTTL = defaultCacheNegativeTTL
+	}
+	if c.prefetchHits == 0 {
+		c.prefetchHits = defaultCachePrefetchHits
+	}
+	if c.prefetchPercentage == 0 || c.prefetchPercentage >= 100 {
+		c.prefetchPercentage = defaultCachePrefetchPercentage
+	}
+
+	// entries are validated against their own expiry on read, the LRU ttl only purges leftovers
+	c.entries = lru.New[*cacheEntry](maxEntries, max(c.maxTTL, c.negativeTTL))
+
+	return c
+}
+
+func cacheKey(view string, q dns.Question) string {
+	return fmt.Sprintf("%s/%s/%d/%d", view, strings.ToLower(q.Name), q.Qtype, q.Qclass)
+}
+
+// Get returns a copy of the cached response to req in view with the TTLs of its records
+// lowered to the remaining lifetime, and whether the entry should be prefetched.
+func (c *Cache) Get(view string, req *dns.Msg) (*dns.Msg, bool, bool) {
+	if len(req.Question) != 1 {
+		return nil, false, false
+	}
+
+	key := cacheKey(view, req.Question[0])
+	entry, ok := c.entries.Get(key)
+	if !ok {
+		metricsstore.DefaultMetricsStore.DNSCacheMissCount.Inc()
+		return nil, false, false
+	}
+
+	remaining := entry.expireAt.Sub(c.clock.Now())
+	if remaining <= 0 {
+		c.entries.Remove(key)
+		metricsstore.DefaultMetricsStore.DNSCacheMissCount.Inc()
+		metricsstore.DefaultMetricsStore.DNSCacheEntries.Set(float64(c.entries.Len()))
+		return nil, false, false
+	}
+
+	if entry.negative {
+		metricsstore.DefaultMetricsStore.DNSCacheHitCount.WithLabelValues("negative").Inc()
+	} else {
+		metricsstore.DefaultMetricsStore.DNSCacheHitCount.WithLabelValues("positive").Inc()
+	}
+
+	resp := entry.msg.Copy()
+	resp.Id = req.Id
+	ttl := uint32(remaining.Seconds())
+	for _, rrs := range [][]dns.RR{resp.Answer, resp.Ns, resp.Extra} {
+		for _, rr := range rrs {
+			if rr.Header().Rrtype == dns.TypeOPT {
+				continue
+			}
+			if rr.Header().Ttl > ttl {
+				rr.Header().Ttl = ttl
+			}
+		}
+	}
+
+	return resp, c.shouldPrefetch(entry, remaining), true
+}
+
+// shouldPrefetch returns true once for a popular entry close to expiry
+func (c *Cache) shouldPrefetch(entry *cacheEntry, remaining time.Duration) bool {
+	if !c.prefetch || entry.negative {
+		return false
+	}
+	if entry.hits.Add(1) < c.prefetchHits {
+		return false
+	}
+	if remaining > entry.ttl*time.Duration(c.prefetchPercentage)/100 {
+		return false
+	}
+	return entry.prefetching.CompareAndSwap(false, true)
+}
+
+// Set caches the upstream response to req in view if it is cacheable
+func (c *Cache) Set(view string, req, resp *dns.Msg) {
+	if len(req.Question) != 1 || resp == nil || resp.Truncated {
+		return
+	}
+
+	ttl, negative, ok := c.ttl(resp)
+	if !ok || ttl <= 0 {
+		return
+	}
+
+	entry := &cacheEntry{
+		msg:      resp.Copy(),
+		negative: negative,
+		ttl:      ttl,
+		expireAt: c.clock.Now().Add(ttl),
+	}
+	c.entries.Add(cacheKey(view, req.Question[0]), entry)
+	metricsstore.DefaultMetricsStore.DNSCacheEntries.Set(float64(c.entries.Len()))
+}
+
+// ttl computes how long resp may be cached, negative responses follow RFC 2308
+func (c *Cache) ttl(resp *dns.Msg) (time.Duration, bool, bool) {
+	switch {
+	case resp.Rcode == dns.RcodeSuccess && len(resp.Answer) > 0:
+		ttl := time.Duration(resp.Answer[0].Header().Ttl) * time.Second
+		for _, rr := range resp.Answer[1:] {
+			ttl = min(ttl, time.Duration(rr.Header().Ttl)*time.Second)
+		}
+		return min(max(ttl, c.minTTL), c.maxTTL), false, true
+
+	case resp.Rcode == dns.RcodeSuccess || resp.Rcode == dns.RcodeNameError:
+		ttl := c.negativeTTL
+		for _, rr := range resp.Ns {
+			if soa, ok := rr.(*dns.SOA); ok {
+				ttl = min(ttl, time.Duration(min(soa.Hdr.Ttl, soa.Minttl))*time.Second)
+				break
+			}
+		}
+		return ttl, true, true
+
+	default:
+		return 0, false, false
+	}
+}
+
+// Len returns the number of cached responses
+func (c *Cache) Len() int {
+	return c.entries.Len()
+}
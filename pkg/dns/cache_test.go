@@ -0,0 +1,158 @@
+package dns
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/miekg/dns"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configv1alpha3 "github.com/flomesh-io/fsm/pkg/apis/config/v1alpha3"
+)
+
+func newQuery(name string, qtype uint16) *dns.Msg {
+	m := new(dns.Msg)
+	m.SetQuestion(dns.Fqdn(name), qtype)
+	return m
+}
+
+func newAnswer(req *dns.Msg, ttl uint32) *dns.Msg {
+	m := new(dns.Msg)
+	m.SetReply(req)
+	m.Answer = append(m.Answer, &dns.A{
+		Hdr: dns.RR_Header{Name: req.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: ttl},
+		A:   net.ParseIP("10.0.0.1"),
+	})
+	return m
+}
+
+func TestCachePositive(t *testing.T) {
+	assert := tassert.New(t)
+	clock := clockwork.NewFakeClock()
+	c := NewCache(configv1alpha3.LocalDNSCache{Enable: true}, clock)
+
+	req := newQuery("www.example.com", dns.TypeA)
+	_, _, ok := c.Get("", req)
+	assert.False(ok)
+
+	c.Set("", req, newAnswer(req, 60))
+	assert.Equal(1, c.Len())
+
+	clock.Advance(20 * time.Second)
+	req.Id = 42
+	resp, _, ok := c.Get("", req)
+	assert.True(ok)
+	assert.Equal(uint16(42), resp.Id)
+	assert.Equal(uint32(40), resp.Answer[0].Header().Ttl)
+
+	// lookups are case insensitive
+	_, _, ok = c.Get("", newQuery("WWW.Example.com", dns.TypeA))
+	assert.True(ok)
+
+	// other query types are cached separately
+	_, _, ok = c.Get("", newQuery("www.example.com", dns.TypeAAAA))
+	assert.False(ok)
+
+	clock.Advance(40 * time.Second)
+	_, _, ok = c.Get("", req)
+	assert.False(ok)
+	assert.Equal(0, c.Len())
+}
+
+func TestCacheTTLBounds(t *testing.T) {
+	assert := tassert.New(t)
+	clock := clockwork.NewFakeClock()
+	c := NewCache(configv1alpha3.LocalDNSCache{
+		Enable: true,
+		MinTTL: metav1.Duration{Duration: 30 * time.Second},
+		MaxTTL: metav1.Duration{Duration: 5 * time.Minute},
+	}, clock)
+
+	short := newQuery("short.example.com", dns.TypeA)
+	c.Set("", short, newAnswer(short, 1))
+	long := newQuery("long.example.com", dns.TypeA)
+	c.Set("", long, newAnswer(long, 86400))
+
+	clock.Advance(10 * time.Second)
+	_, _, ok := c.Get("", short)
+	assert.True(ok)
+
+	clock.Advance(5 * time.Minute)
+	_, _, ok = c.Get("", long)
+	assert.False(ok)
+}
+
+func TestCacheNegative(t *testing.T) {
+	assert := tassert.New(t)
+	clock := clockwork.NewFakeClock()
+	c := NewCache(configv1alpha3.LocalDNSCache{
+		Enable:      true,
+		NegativeTTL: metav1.Duration{Duration: time.Minute},
+	}, clock)
+
+	req := newQuery("missing.example.com", dns.TypeA)
+	nx := new(dns.Msg)
+	nx.SetRcode(req, dns.RcodeNameError)
+	nx.Ns = append(nx.Ns, &dns.SOA{
+		Hdr:    dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: 3600},
+		Minttl: 10,
+	})
+	c.Set("", req, nx)
+
+	clock.Advance(5 * time.Second)
+	resp, _, ok := c.Get("", req)
+	assert.True(ok)
+	assert.Equal(dns.RcodeNameError, resp.Rcode)
+
+	clock.Advance(5 * time.Second)
+	_, _, ok = c.Get("", req)
+	assert.False(ok)
+
+	// without SOA the negative ttl applies
+	nodata := new(dns.Msg)
+	nodata.SetReply(req)
+	c.Set("", req, nodata)
+	clock.Advance(59 * time.Second)
+	_, _, ok = c.Get("", req)
+	assert.True(ok)
+
+	// server failures are never cached
+	other := newQuery("broken.example.com", dns.TypeA)
+	fail := new(dns.Msg)
+	fail.SetRcode(other, dns.RcodeServerFailure)
+	c.Set("", other, fail)
+	_, _, ok = c.Get("", other)
+	assert.False(ok)
+}
+
+func TestCachePrefetch(t *testing.T) {
+	assert := tassert.New(t)
+	clock := clockwork.NewFakeClock()
+	c := NewCache(configv1alpha3.LocalDNSCache{
+		Enable: true,
+		Prefetch: configv1alpha3.LocalDNSCachePrefetch{
+			Enable:     true,
+			Hits:       2,
+			Percentage: 20,
+		},
+	}, clock)
+
+	req := newQuery("popular.example.com", dns.TypeA)
+	c.Set("", req, newAnswer(req, 100))
+
+	_, prefetch, _ := c.Get("", req)
+	assert.False(prefetch)
+	_, prefetch, _ = c.Get("", req)
+	assert.False(prefetch)
+
+	clock.Advance(85 * time.Second)
+	_, prefetch, _ = c.Get("", req)
+	assert.True(prefetch)
+
+	// only one prefetch is triggered per entry
+	_, prefetch, _ = c.Get("", req)
+	assert.False(prefetch)
+}
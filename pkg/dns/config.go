@@ -2,6 +2,8 @@ package dns
 
 import (
 	"fmt"
+	"reflect"
+	"sync"
 
 	"github.com/jonboulle/clockwork"
 
@@ -16,18 +18,97 @@ var WallClock = clockwork.NewRealClock()
 type Config struct {
 	cfg              configurator.Configurator
 	CustomDNSRecords []string // manual custom dns entries
+
+	upstreamsLock sync.Mutex
+	upstreamSpecs []configv1alpha3.LocalDNSUpstream
+	upstreams     []Upstream
+
+	zonesLock sync.Mutex
+	zoneSpecs []configv1alpha3.LocalDNSZone
+	zones     []*Zone
 }
 
-// GetNameservers nameservers to forward queries to
-func (c *Config) GetNameservers() []string {
-	var nameservers []string
+// GetUpstreams upstreams to forward queries to
+func (c *Config) GetUpstreams() []Upstream {
+	if specs := c.cfg.GetMeshConfig().Spec.Sidecar.LocalDNSProxy.Upstreams; len(specs) > 0 {
+		return c.getConfiguredUpstreams(specs)
+	}
+
+	var upstreams []Upstream
 	if upstream := c.cfg.GetLocalDNSProxyPrimaryUpstream(); len(upstream) > 0 {
-		nameservers = append(nameservers, fmt.Sprintf("%s:53", upstream))
+		upstreams = append(upstreams, &plainUpstream{address: fmt.Sprintf("%s:53", upstream)})
 	}
 	if upstream := c.cfg.GetLocalDNSProxySecondaryUpstream(); len(upstream) > 0 {
-		nameservers = append(nameservers, fmt.Sprintf("%s:53", upstream))
+		upstreams = append(upstreams, &plainUpstream{address: fmt.Sprintf("%s:53", upstream)})
+	}
+	return upstreams
+}
+
+// getConfiguredUpstreams returns the upstreams built from specs, they are rebuilt only
+// when specs change so that TLS settings and DoH connection pools are reused.
+func (c *Config) getConfiguredUpstreams(specs []configv1alpha3.LocalDNSUpstream) []Upstream {
+	c.upstreamsLock.Lock()
+	defer c.upstreamsLock.Unlock()
+
+	if c.upstreams != nil && reflect.DeepEqual(c.upstreamSpecs, specs) {
+		return c.upstreams
+	}
+
+	upstreams := make([]Upstream, 0, len(specs))
+	for _, spec := range specs {
+		upstream, err := NewUpstream(spec)
+		if err != nil {
+			log.Error().Err(err).Msgf("invalid upstream DNS server %s", spec.Address)
+			continue
+		}
+		upstreams = append(upstreams, upstream)
+	}
+
+	// the replaced upstreams no longer keep connections open
+	closeIdleConnections(c.upstreams)
+
+	c.upstreamSpecs = specs
+	c.upstreams = upstreams
+	return upstreams
+}
+
+// GetZones conditional forwarding zones ordered by precedence, they are rebuilt only when changed
+func (c *Config) GetZones() []*Zone {
+	specs := c.cfg.GetMeshConfig().Spec.Sidecar.LocalDNSProxy.Zones
+
+	c.zonesLock.Lock()
+	defer c.zonesLock.Unlock()
+
+	if len(specs) == 0 {
+		c.closeZones()
+		return nil
+	}
+
+	if c.zones == nil || !reflect.DeepEqual(c.zoneSpecs, specs) {
+		// the upstreams of the replaced zones no longer keep connections open
+		c.closeZones()
+		c.zoneSpecs = specs
+		c.zones = NewZones(specs)
+	}
+	return c.zones
+}
+
+// closeZones closes the idle connections of the upstreams of the zones and forgets them
+func (c *Config) closeZones() {
+	for _, zone := range c.zones {
+		closeIdleConnections(zone.Upstreams)
+	}
+	c.zoneSpecs = nil
+	c.zones = nil
+}
+
+// closeIdleConnections closes the idle connections kept open by the given upstreams
+func closeIdleConnections(upstreams []Upstream) {
+	for _, upstream := range upstreams {
+		if closer, ok := upstream.(idleConnectionsCloser); ok {
+			closer.CloseIdleConnections()
+		}
 	}
-	return nameservers
 }
 
 func (c *Config) IsWildcard() bool {
@@ -42,6 +123,11 @@ func (c *Config) GetLoopbackResolveDB() []*configv1alpha3.ResolveAddr {
 	return c.cfg.GetMeshConfig().Spec.Sidecar.LocalDNSProxy.Wildcard.LOs
 }
 
+// GetCache returns the response cache configuration
+func (c *Config) GetCache() configv1alpha3.LocalDNSCache {
+	return c.cfg.GetMeshConfig().Spec.Sidecar.LocalDNSProxy.Cache
+}
+
 func (c *Config) GenerateIPv6BasedOnIPv4() bool {
 	return c.cfg.GenerateIPv6BasedOnIPv4()
 }
@@ -7,7 +7,9 @@ import (
 	"sync"
 
 	"github.com/miekg/dns"
+	corev1 "k8s.io/api/core/v1"
 
+	"github.com/flomesh-io/fsm/pkg/metricsstore"
 	"github.com/flomesh-io/fsm/pkg/service"
 	"github.com/flomesh-io/fsm/pkg/utils"
 )
@@ -42,6 +44,7 @@ func (q *Question) String() string {
 type DNSHandler struct {
 	requestChannel chan DNSOperationData
 	resolver       *Resolver
+	cache          *Cache
 	active         bool
 	muActive       sync.RWMutex
 }
@@ -68,6 +71,10 @@ func NewHandler(config *Config) *DNSHandler {
 		active:         true,
 	}
 
+	if cache := config.GetCache(); cache.Enable {
+		handler.cache = NewCache(cache, WallClock)
+	}
+
 	go handler.do(config)
 
 	return handler
@@ -142,6 +149,11 @@ func (h *DNSHandler) do(cfg *Config) {
 				qname, fromNamespace := h.getRawQName(q.Name, trustDomain)
 				log.Debug().Msgf("%s lookup q.Name:%s qname:%s namespace:%s　trustDomain:%s", remote, q.Name, qname, fromNamespace, trustDomain)
 
+				if target, ok := nativeNames.Lookup(qname, trustDomain, func() []*corev1.Service { return k8sClient.ListServices(false, false) }); ok {
+					req.Question[index].Name = target
+					continue
+				}
+
 				segs := strings.Split(qname, `.`)
 				sections := len(segs)
 				if sections == 1 { //internal domain name
@@ -172,7 +184,8 @@ func (h *DNSHandler) do(cfg *Config) {
 				return
 			}
 
-			resp, err := h.resolver.Lookup(Net, req, cfg.GetTimeout(), cfg.GetInterval(), cfg.GetNameservers())
+			zone := MatchZone(cfg.GetZones(), q.Name, func() string { return h.getPodNamespace(remote) })
+			resp, err := h.lookup(Net, req, cfg, zone)
 			if err != nil {
 				log.Error().Msgf("resolve query error %s\n", err)
 				req.Question = origQuestions
@@ -181,15 +194,6 @@ func (h *DNSHandler) do(cfg *Config) {
 			}
 			resp.Question = origQuestions
 
-			if resp.Truncated && Net == "udp" {
-				resp, err = h.resolver.Lookup("tcp", req, cfg.GetTimeout(), cfg.GetInterval(), cfg.GetNameservers())
-				if err != nil {
-					log.Error().Msgf("resolve tcp query error %s\n", err)
-					h.HandleFailed(w, req)
-					return
-				}
-			}
-
 			log.Debug().Msgf("%s lookup　%s rcode:%d", remote, Q.String(), resp.Rcode)
 
 			if resp.Rcode == dns.RcodeNameError && cfg.IsWildcard() {
@@ -257,6 +261,80 @@ func (h *DNSHandler) do(cfg *Config) {
 	}
 }
 
+// lookup answers the query from the cache when possible, otherwise asks the upstream nameservers
+// of the zone, or the default ones if zone is nil
+func (h *DNSHandler) lookup(Net string, req *dns.Msg, cfg *Config, zone *Zone) (*dns.Msg, error) {
+	view := ""
+	if zone != nil {
+		view = zone.view
+	}
+
+	if h.cache != nil {
+		if resp, prefetch, ok := h.cache.Get(view, req); ok {
+			if prefetch {
+				go h.prefetch(req.Copy(), cfg, zone)
+			}
+			return resp, nil
+		}
+	}
+
+	resp, err := h.exchange(Net, req, cfg, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	if h.cache != nil {
+		h.cache.Set(view, req, resp)
+	}
+	return resp, nil
+}
+
+// exchange sends the query to the upstream nameservers, retrying over tcp if the udp response is truncated
+func (h *DNSHandler) exchange(Net string, req *dns.Msg, cfg *Config, zone *Zone) (*dns.Msg, error) {
+	upstreams := cfg.GetUpstreams()
+	if zone != nil {
+		upstreams = zone.Upstreams
+	}
+
+	resp, err := h.resolver.Lookup(Net, req, cfg.GetTimeout(), cfg.GetInterval(), upstreams)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Truncated && Net == "udp" {
+		return h.resolver.Lookup("tcp", req, cfg.GetTimeout(), cfg.GetInterval(), upstreams)
+	}
+	return resp, nil
+}
+
+// prefetch refreshes the cache entry of a popular query before it expires
+func (h *DNSHandler) prefetch(req *dns.Msg, cfg *Config, zone *Zone) {
+	metricsstore.DefaultMetricsStore.DNSCachePrefetchCount.Inc()
+
+	resp, err := h.exchange("udp", req, cfg, zone)
+	if err != nil {
+		log.Warn().Msgf("prefetch %s error %s", req.Question[0].Name, err)
+		return
+	}
+
+	view := ""
+	if zone != nil {
+		view = zone.view
+	}
+	h.cache.Set(view, req, resp)
+}
+
+// getPodNamespace returns the namespace of the pod the query comes from
+func (h *DNSHandler) getPodNamespace(remote net.IP) string {
+	if k8sClient == nil {
+		return ""
+	}
+	if pod := k8sClient.GetPodByIP(remote.String()); pod != nil {
+		return pod.Namespace
+	}
+	return ""
+}
+
 // DoTCP begins a tcp query
 func (h *DNSHandler) DoTCP(w dns.ResponseWriter, req *dns.Msg) {
 	h.muActive.RLock()
@@ -0,0 +1,102 @@
+package dns
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/flomesh-io/fsm/pkg/connector"
+	"github.com/flomesh-io/fsm/pkg/constants"
+)
+
+// NativeNames indexes the native registry DNS names of connector synced services,
+// such as <service>.service.consul, to the services they were synced to.
+type NativeNames struct {
+	lock  sync.RWMutex
+	names map[string]*corev1.Service
+	stale bool
+}
+
+// NewNativeNames returns an empty index which is built on first lookup
+func NewNativeNames() *NativeNames {
+	return &NativeNames{stale: true}
+}
+
+// Invalidate marks the index to be rebuilt on next lookup
+func (n *NativeNames) Invalidate() {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.stale = true
+}
+
+// Lookup returns the in cluster FQDN of the service known by the native name qname.
+// listServices is only called when the index needs to be rebuilt.
+func (n *NativeNames) Lookup(qname, trustDomain string, listServices func() []*corev1.Service) (string, bool) {
+	qname = strings.ToLower(strings.TrimSuffix(qname, `.`))
+
+	n.lock.RLock()
+	if !n.stale {
+		svc, ok := n.names[qname]
+		n.lock.RUnlock()
+		return serviceFQDN(svc, trustDomain), ok
+	}
+	n.lock.RUnlock()
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if n.stale {
+		n.names = buildNativeNames(listServices())
+		n.stale = false
+	}
+	svc, ok := n.names[qname]
+	return serviceFQDN(svc, trustDomain), ok
+}
+
+// buildNativeNames indexes the native DNS names of the given services, a name claimed
+// by several services resolves to the oldest of them, or the lexically first on a tie.
+func buildNativeNames(services []*corev1.Service) map[string]*corev1.Service {
+	services = slices.Clone(services)
+	slices.SortFunc(services, func(a, b *corev1.Service) int {
+		if c := a.CreationTimestamp.Time.Compare(b.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.Namespace, b.Namespace); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	names := make(map[string]*corev1.Service)
+	for _, svc := range services {
+		if svc.Labels[constants.CloudSourcedServiceLabel] != "true" {
+			continue
+		}
+		annotation := svc.Annotations[connector.AnnotationCloudServiceNativeDNSNames]
+		if len(annotation) == 0 {
+			continue
+		}
+		for _, name := range strings.Split(annotation, `,`) {
+			name = strings.ToLower(strings.Trim(strings.TrimSpace(name), `.`))
+			if len(name) == 0 {
+				continue
+			}
+			if prev, exists := names[name]; exists {
+				log.Warn().Msgf("native DNS name %s is claimed by both %s/%s and %s/%s, ignoring it for %s/%s",
+					name, prev.Namespace, prev.Name, svc.Namespace, svc.Name, svc.Namespace, svc.Name)
+				continue
+			}
+			names[name] = svc
+		}
+	}
+	return names
+}
+
+func serviceFQDN(svc *corev1.Service, trustDomain string) string {
+	if svc == nil {
+		return ""
+	}
+	return fmt.Sprintf(`%s.%s.svc.%s.`, svc.Name, svc.Namespace, trustDomain)
+}
@@ -0,0 +1,101 @@
+package dns
+
+import (
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/flomesh-io/fsm/pkg/connector"
+	"github.com/flomesh-io/fsm/pkg/constants"
+)
+
+func TestNativeNamesLookup(t *testing.T) {
+	assert := tassert.New(t)
+
+	cloudService := func(namespace, name, nativeNames string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   namespace,
+				Name:        name,
+				Labels:      map[string]string{constants.CloudSourcedServiceLabel: "true"},
+				Annotations: map[string]string{connector.AnnotationCloudServiceNativeDNSNames: nativeNames},
+			},
+		}
+	}
+
+	services := []*corev1.Service{
+		cloudService("derive", "orders", "orders.service.consul"),
+		cloudService("derive", "payments", "payments.default_group.nacos, payments.billing.nacos"),
+		cloudService("other", "orders", "orders.service.consul"),
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "default",
+				Name:        "local",
+				Annotations: map[string]string{connector.AnnotationCloudServiceNativeDNSNames: "local.service.consul"},
+			},
+		},
+	}
+
+	lists := 0
+	listServices := func() []*corev1.Service {
+		lists++
+		return services
+	}
+
+	names := NewNativeNames()
+
+	target, ok := names.Lookup("Orders.Service.Consul.", "cluster.local", listServices)
+	assert.True(ok)
+	assert.Equal("orders.derive.svc.cluster.local.", target)
+
+	target, ok = names.Lookup("payments.billing.nacos", "cluster.local", listServices)
+	assert.True(ok)
+	assert.Equal("payments.derive.svc.cluster.local.", target)
+
+	_, ok = names.Lookup("local.service.consul", "cluster.local", listServices)
+	assert.False(ok)
+
+	_, ok = names.Lookup("unknown.service.consul", "cluster.local", listServices)
+	assert.False(ok)
+	assert.Equal(1, lists)
+
+	names.Invalidate()
+	services = services[:1]
+	_, ok = names.Lookup("payments.billing.nacos", "cluster.local", listServices)
+	assert.False(ok)
+	assert.Equal(2, lists)
+}
+
+func TestBuildNativeNamesConflict(t *testing.T) {
+	assert := tassert.New(t)
+
+	cloudService := func(namespace, name string, created time.Time) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         namespace,
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+				Labels:            map[string]string{constants.CloudSourcedServiceLabel: "true"},
+				Annotations:       map[string]string{connector.AnnotationCloudServiceNativeDNSNames: "orders.service.consul"},
+			},
+		}
+	}
+
+	now := time.Now()
+	newer := cloudService("a", "orders", now)
+	older := cloudService("b", "orders", now.Add(-time.Hour))
+	sameAge := cloudService("c", "orders", now.Add(-time.Hour))
+
+	// the oldest service wins whatever the order the services are listed in
+	for _, services := range [][]*corev1.Service{{newer, older, sameAge}, {sameAge, older, newer}} {
+		names := buildNativeNames(services)
+		assert.Equal(older, names["orders.service.consul"])
+	}
+
+	// the lexically first service wins among services of the same age
+	names := buildNativeNames([]*corev1.Service{cloudService("z", "orders", now), cloudService("a", "orders", now)})
+	assert.Equal("a", names["orders.service.consul"].Namespace)
+}
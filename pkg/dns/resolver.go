@@ -26,25 +26,21 @@ type Resolver struct {
 	config *dns.ClientConfig
 }
 
-// Lookup will ask each nameserver in top-to-bottom fashion, starting a new request
+// Lookup will ask each upstream in top-to-bottom fashion, starting a new request
 // in every second, and return as early as possbile (have an answer).
 // It returns an error if no request has succeeded.
-func (r *Resolver) Lookup(net string, req *dns.Msg, timeout int, interval int, nameServers []string) (*dns.Msg, error) {
-	log.Debug().Msgf("Lookup %s, timeout: %d, interval: %d, nameservers: %v", net, timeout, interval, nameServers)
-
-	c := &dns.Client{
-		Net:          net,
-		ReadTimeout:  r.Timeout(timeout),
-		WriteTimeout: r.Timeout(timeout),
-	}
+func (r *Resolver) Lookup(net string, req *dns.Msg, timeout int, interval int, upstreams []Upstream) (*dns.Msg, error) {
+	log.Debug().Msgf("Lookup %s, timeout: %d, interval: %d, upstreams: %v", net, timeout, interval, upstreams)
 
 	qname := req.Question[0].Name
+	exchangeTimeout := r.Timeout(timeout)
 
 	res := make(chan *dns.Msg, 1)
 	var wg sync.WaitGroup
-	L := func(nameserver string) {
+	L := func(upstream Upstream) {
 		defer wg.Done()
-		r, _, err := c.Exchange(req, nameserver)
+		nameserver := upstream.String()
+		r, err := upstream.Exchange(net, req, exchangeTimeout)
 		if err != nil {
 			log.Warn().Msgf("%s socket error on %s", qname, nameserver)
 			log.Warn().Msgf("error:%s", err.Error())
@@ -68,9 +64,9 @@ func (r *Resolver) Lookup(net string, req *dns.Msg, timeout int, interval int, n
 	defer ticker.Stop()
 
 	// Start lookup on each nameserver top-down, in every second
-	for _, nameServer := range nameServers {
+	for _, upstream := range upstreams {
 		wg.Add(1)
-		go L(nameServer)
+		go L(upstream)
 		// but exit early, if we have an answer
 		select {
 		case r := <-res:
@@ -86,7 +82,11 @@ func (r *Resolver) Lookup(net string, req *dns.Msg, timeout int, interval int, n
 	case r := <-res:
 		return r, nil
 	default:
-		return nil, ResolvError{qname, net, nameServers}
+		nameservers := make([]string, 0, len(upstreams))
+		for _, upstream := range upstreams {
+			nameservers = append(nameservers, upstream.String())
+		}
+		return nil, ResolvError{qname, net, nameservers}
 	}
 }
 
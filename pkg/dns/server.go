@@ -2,6 +2,7 @@ package dns
 
 import (
 	"fmt"
+	"reflect"
 	"sync"
 	"time"
 
@@ -95,6 +96,8 @@ var (
 	k8sClient k8s.Controller
 	cfg       configurator.Configurator
 
+	nativeNames = NewNativeNames()
+
 	server = &Server{
 		host:     fmt.Sprintf(":%d", constants.FSMDNSProxyPort),
 		rTimeout: 5 * time.Second,
@@ -135,6 +138,10 @@ func WatchAndUpdateLocalDNSProxy(msgBroker *messaging.Broker, stop <-chan struct
 	kubePubSub := msgBroker.GetKubeEventPubSub()
 	meshCfgUpdateChan := kubePubSub.Sub(announcements.MeshConfigUpdated.String())
 	defer msgBroker.Unsub(kubePubSub, meshCfgUpdateChan)
+	svcUpdateChan := kubePubSub.Sub(announcements.ServiceAdded.String(),
+		announcements.ServiceUpdated.String(),
+		announcements.ServiceDeleted.String())
+	defer msgBroker.Unsub(kubePubSub, svcUpdateChan)
 
 	for {
 		select {
@@ -142,6 +149,9 @@ func WatchAndUpdateLocalDNSProxy(msgBroker *messaging.Broker, stop <-chan struct
 			log.Info().Msg("Received stop signal, exiting local dns proxy update routine")
 			return
 
+		case <-svcUpdateChan:
+			nativeNames.Invalidate()
+
 		case event := <-meshCfgUpdateChan:
 			msg, ok := event.(events.PubSubMessage)
 			if !ok {
@@ -161,6 +171,11 @@ func WatchAndUpdateLocalDNSProxy(msgBroker *messaging.Broker, stop <-chan struct
 				} else {
 					Stop()
 				}
+			} else if newObj.Spec.Sidecar.LocalDNSProxy.Enable &&
+				!reflect.DeepEqual(prevObj.Spec.Sidecar.LocalDNSProxy.Cache, newObj.Spec.Sidecar.LocalDNSProxy.Cache) {
+				// the cache is sized on start, restart to apply the new settings
+				Stop()
+				Start()
 			}
 		}
 	}
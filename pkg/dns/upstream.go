@@ -0,0 +1,296 @@
+package dns
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+
+	configv1alpha3 "github.com/flomesh-io/fsm/pkg/apis/config/v1alpha3"
+)
+
+const (
+	dohMediaType = "application/dns-message"
+
+	defaultDNSPort = "53"
+	defaultDoTPort = "853"
+
+	// maxDoHResponseSize is the largest possible DNS message
+	maxDoHResponseSize = 65535
+
+	// maxIdleTLSConns is the number of idle connections kept open per DNS over TLS upstream
+	maxIdleTLSConns = 4
+)
+
+// Upstream is a DNS server queries are forwarded to
+type Upstream interface {
+	// Exchange sends the query and waits for the response, net is the transport
+	// the query was received on and is only honoured by plain upstreams.
+	Exchange(net string, req *dns.Msg, timeout time.Duration) (*dns.Msg, error)
+
+	// String returns the address of the upstream
+	String() string
+}
+
+// idleConnectionsCloser is implemented by the upstreams keeping connections open
+type idleConnectionsCloser interface {
+	CloseIdleConnections()
+}
+
+// plainUpstream is a DNS server reached over udp or tcp
+type plainUpstream struct {
+	address string
+	net     string
+}
+
+// Exchange sends the query over udp or tcp
+func (u *plainUpstream) Exchange(net string, req *dns.Msg, timeout time.Duration) (*dns.Msg, error) {
+	if len(u.net) > 0 {
+		net = u.net
+	}
+	c := &dns.Client{
+		Net:          net,
+		ReadTimeout:  timeout,
+		WriteTimeout: timeout,
+	}
+	r, _, err := c.Exchange(req, u.address)
+	return r, err
+}
+
+func (u *plainUpstream) String() string {
+	return u.address
+}
+
+// tlsUpstream is a DNS over TLS server, the connections are kept open and reused
+// across queries to avoid a TLS handshake per query
+type tlsUpstream struct {
+	address   string
+	tlsConfig *tls.Config
+
+	idleLock sync.Mutex
+	idle     []*dns.Conn
+	closed   bool
+}
+
+// Exchange sends the query over TLS
+func (u *tlsUpstream) Exchange(_ string, req *dns.Msg, timeout time.Duration) (*dns.Msg, error) {
+	c := &dns.Client{
+		Net:          "tcp-tls",
+		TLSConfig:    u.tlsConfig,
+		DialTimeout:  timeout,
+		ReadTimeout:  timeout,
+		WriteTimeout: timeout,
+	}
+
+	// an idle connection may have been closed by the server, the query is then
+	// retried over a new connection
+	if conn := u.getIdleConn(); conn != nil {
+		if r, _, err := c.ExchangeWithConn(req, conn); err == nil {
+			u.putIdleConn(conn)
+			return r, nil
+		}
+		_ = conn.Close()
+	}
+
+	conn, err := c.Dial(u.address)
+	if err != nil {
+		return nil, err
+	}
+	r, _, err := c.ExchangeWithConn(req, conn)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	u.putIdleConn(conn)
+	return r, nil
+}
+
+func (u *tlsUpstream) getIdleConn() *dns.Conn {
+	u.idleLock.Lock()
+	defer u.idleLock.Unlock()
+
+	if len(u.idle) == 0 {
+		return nil
+	}
+	conn := u.idle[len(u.idle)-1]
+	u.idle = u.idle[:len(u.idle)-1]
+	return conn
+}
+
+func (u *tlsUpstream) putIdleConn(conn *dns.Conn) {
+	u.idleLock.Lock()
+	defer u.idleLock.Unlock()
+
+	if u.closed || len(u.idle) >= maxIdleTLSConns {
+		_ = conn.Close()
+		return
+	}
+	u.idle = append(u.idle, conn)
+}
+
+// CloseIdleConnections closes the idle connections, the connections in use are
+// closed once the queries are answered
+func (u *tlsUpstream) CloseIdleConnections() {
+	u.idleLock.Lock()
+	defer u.idleLock.Unlock()
+
+	for _, conn := range u.idle {
+		_ = conn.Close()
+	}
+	u.idle = nil
+	u.closed = true
+}
+
+func (u *tlsUpstream) String() string {
+	return "tls://" + u.address
+}
+
+// httpsUpstream is a DNS over HTTPS server
+type httpsUpstream struct {
+	url    string
+	client *http.Client
+}
+
+// Exchange sends the query as a DNS wireformat POST request
+func (u *httpsUpstream) Exchange(_ string, req *dns.Msg, timeout time.Duration) (*dns.Msg, error) {
+	// RFC 8484 4.1: the DNS ID should be 0 to maximize HTTP cache friendliness
+	q := req.Copy()
+	q.Id = 0
+	body, err := q.Pack()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, u.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", dohMediaType)
+	httpReq.Header.Set("Accept", dohMediaType)
+
+	httpResp, err := u.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d from %s", httpResp.StatusCode, u.url)
+	}
+
+	buf, err := io.ReadAll(io.LimitReader(httpResp.Body, maxDoHResponseSize))
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(dns.Msg)
+	if err = r.Unpack(buf); err != nil {
+		return nil, err
+	}
+	r.Id = req.Id
+	return r, nil
+}
+
+// CloseIdleConnections closes the idle connections of the http client
+func (u *httpsUpstream) CloseIdleConnections() {
+	u.client.CloseIdleConnections()
+}
+
+func (u *httpsUpstream) String() string {
+	return u.url
+}
+
+// NewUpstream creates an Upstream from its MeshConfig spec
+func NewUpstream(spec configv1alpha3.LocalDNSUpstream) (Upstream, error) {
+	switch spec.Protocol {
+	case "", configv1alpha3.LocalDNSUpstreamProtocolUDP:
+		return &plainUpstream{address: withDefaultPort(spec.Address, defaultDNSPort)}, nil
+
+	case configv1alpha3.LocalDNSUpstreamProtocolTCP:
+		return &plainUpstream{address: withDefaultPort(spec.Address, defaultDNSPort), net: "tcp"}, nil
+
+	case configv1alpha3.LocalDNSUpstreamProtocolTLS:
+		address := withDefaultPort(spec.Address, defaultDoTPort)
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig, err := newUpstreamTLSConfig(spec.TLS, host)
+		if err != nil {
+			return nil, err
+		}
+		return &tlsUpstream{address: address, tlsConfig: tlsConfig}, nil
+
+	case configv1alpha3.LocalDNSUpstreamProtocolHTTPS:
+		u, err := url.Parse(spec.Address)
+		if err != nil {
+			return nil, err
+		}
+		if u.Scheme != "https" {
+			return nil, fmt.Errorf("invalid DNS over HTTPS url %s", spec.Address)
+		}
+		tlsConfig, err := newUpstreamTLSConfig(spec.TLS, u.Hostname())
+		if err != nil {
+			return nil, err
+		}
+		return &httpsUpstream{
+			url: spec.Address,
+			client: &http.Client{
+				Transport: &http.Transport{
+					Proxy:               http.ProxyFromEnvironment,
+					TLSClientConfig:     tlsConfig,
+					ForceAttemptHTTP2:   true,
+					MaxIdleConnsPerHost: 16,
+					IdleConnTimeout:     90 * time.Second,
+				},
+			},
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported upstream protocol %s", spec.Protocol)
+	}
+}
+
+func newUpstreamTLSConfig(spec *configv1alpha3.LocalDNSUpstreamTLS, host string) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName:         host,
+		MinVersion:         tls.VersionTLS12,
+		ClientSessionCache: tls.NewLRUClientSessionCache(0),
+	}
+	if spec == nil {
+		return tlsConfig, nil
+	}
+
+	if len(spec.ServerName) > 0 {
+		tlsConfig.ServerName = spec.ServerName
+	}
+	if len(spec.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(spec.CABundle)) {
+			return nil, fmt.Errorf("no valid certificate found in caBundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	tlsConfig.InsecureSkipVerify = spec.InsecureSkipVerify // #nosec G402
+
+	return tlsConfig, nil
+}
+
+func withDefaultPort(address, port string) string {
+	if _, _, err := net.SplitHostPort(address); err == nil {
+		return address
+	}
+	return net.JoinHostPort(address, port)
+}
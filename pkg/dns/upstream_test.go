@@ -0,0 +1,190 @@
+package dns
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	tassert "github.com/stretchr/testify/assert"
+
+	configv1alpha3 "github.com/flomesh-io/fsm/pkg/apis/config/v1alpha3"
+)
+
+func answerA(req *dns.Msg) *dns.Msg {
+	m := new(dns.Msg)
+	m.SetReply(req)
+	m.Answer = append(m.Answer, &dns.A{
+		Hdr: dns.RR_Header{Name: req.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+		A:   net.ParseIP("10.0.0.1"),
+	})
+	return m
+}
+
+func newDoHServer(t *testing.T) *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != dohMediaType {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		req := new(dns.Msg)
+		if err = req.Unpack(body); err != nil || req.Id != 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		resp, err := answerA(req).Pack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		w.Header().Set("Content-Type", dohMediaType)
+		_, _ = w.Write(resp)
+	}))
+}
+
+func caBundle(s *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.Certificate().Raw}))
+}
+
+func TestHTTPSUpstream(t *testing.T) {
+	server := newDoHServer(t)
+	defer server.Close()
+
+	testCases := []struct {
+		name      string
+		tls       *configv1alpha3.LocalDNSUpstreamTLS
+		expectErr bool
+	}{
+		{
+			name:      "system roots do not trust the test server",
+			expectErr: true,
+		},
+		{
+			name: "custom CA",
+			tls:  &configv1alpha3.LocalDNSUpstreamTLS{CABundle: caBundle(server)},
+		},
+		{
+			name: "custom CA with matching server name",
+			tls:  &configv1alpha3.LocalDNSUpstreamTLS{CABundle: caBundle(server), ServerName: "example.com"},
+		},
+		{
+			name:      "custom CA with mismatching server name",
+			tls:       &configv1alpha3.LocalDNSUpstreamTLS{CABundle: caBundle(server), ServerName: "dns.flomesh.io"},
+			expectErr: true,
+		},
+		{
+			name: "insecure skip verify",
+			tls:  &configv1alpha3.LocalDNSUpstreamTLS{InsecureSkipVerify: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			upstream, err := NewUpstream(configv1alpha3.LocalDNSUpstream{
+				Protocol: configv1alpha3.LocalDNSUpstreamProtocolHTTPS,
+				Address:  server.URL + "/dns-query",
+				TLS:      tc.tls,
+			})
+			assert.NoError(err)
+
+			req := new(dns.Msg)
+			req.SetQuestion("www.example.com.", dns.TypeA)
+			resp, err := upstream.Exchange("udp", req, time.Second)
+			if tc.expectErr {
+				assert.Error(err)
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(req.Id, resp.Id)
+			assert.Len(resp.Answer, 1)
+		})
+	}
+}
+
+func TestTLSUpstream(t *testing.T) {
+	assert := tassert.New(t)
+
+	// reuse the httptest certificate for the DNS over TLS listener
+	cert := newDoHServer(t)
+	defer cert.Close()
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", cert.TLS)
+	assert.NoError(err)
+	counter := &countingListener{Listener: listener}
+
+	server := &dns.Server{
+		Listener: counter,
+		Net:      "tcp-tls",
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+			_ = w.WriteMsg(answerA(req))
+		}),
+	}
+	go func() { _ = server.ActivateAndServe() }()
+	defer server.Shutdown() //nolint: errcheck
+
+	upstream, err := NewUpstream(configv1alpha3.LocalDNSUpstream{
+		Protocol: configv1alpha3.LocalDNSUpstreamProtocolTLS,
+		Address:  listener.Addr().String(),
+		TLS:      &configv1alpha3.LocalDNSUpstreamTLS{CABundle: caBundle(cert)},
+	})
+	assert.NoError(err)
+
+	for i := 0; i < 3; i++ {
+		req := new(dns.Msg)
+		req.SetQuestion("www.example.com.", dns.TypeA)
+		resp, err := upstream.Exchange("udp", req, time.Second)
+		assert.NoError(err)
+		assert.Equal(req.Id, resp.Id)
+		assert.Len(resp.Answer, 1)
+	}
+	// the connection is reused across queries
+	assert.EqualValues(1, counter.accepted.Load())
+}
+
+type countingListener struct {
+	net.Listener
+	accepted atomic.Int32
+}
+
+func (l *countingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err == nil {
+		l.accepted.Add(1)
+	}
+	return conn, err
+}
+
+func TestNewUpstream(t *testing.T) {
+	assert := tassert.New(t)
+
+	upstream, err := NewUpstream(configv1alpha3.LocalDNSUpstream{Address: "10.96.0.10"})
+	assert.NoError(err)
+	assert.Equal("10.96.0.10:53", upstream.String())
+
+	upstream, err = NewUpstream(configv1alpha3.LocalDNSUpstream{Protocol: configv1alpha3.LocalDNSUpstreamProtocolTLS, Address: "1.1.1.1"})
+	assert.NoError(err)
+	assert.Equal("tls://1.1.1.1:853", upstream.String())
+
+	_, err = NewUpstream(configv1alpha3.LocalDNSUpstream{Protocol: configv1alpha3.LocalDNSUpstreamProtocolHTTPS, Address: "http://1.1.1.1/dns-query"})
+	assert.Error(err)
+
+	_, err = NewUpstream(configv1alpha3.LocalDNSUpstream{
+		Protocol: configv1alpha3.LocalDNSUpstreamProtocolTLS,
+		Address:  "1.1.1.1",
+		TLS:      &configv1alpha3.LocalDNSUpstreamTLS{CABundle: "not a pem"},
+	})
+	assert.Error(err)
+}
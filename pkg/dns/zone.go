@@ -0,0 +1,102 @@
+package dns
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	configv1alpha3 "github.com/flomesh-io/fsm/pkg/apis/config/v1alpha3"
+)
+
+// Zone is a domain whose queries are forwarded to dedicated upstreams
+type Zone struct {
+	// Name is the fully qualified domain of the zone in lower case
+	Name string
+
+	// Upstreams are the upstream DNS servers of the zone
+	Upstreams []Upstream
+
+	// view identifies the zone in the response cache, zones sharing
+	// a name but visible to different namespaces have distinct views
+	view       string
+	namespaces map[string]bool
+}
+
+// visibleTo returns true if the pods of namespace see the zone
+func (z *Zone) visibleTo(namespace string) bool {
+	return len(z.namespaces) == 0 || z.namespaces[namespace]
+}
+
+// contains returns true if qname is the domain of the zone or one of its subdomains
+func (z *Zone) contains(qname string) bool {
+	return qname == z.Name || strings.HasSuffix(qname, "."+z.Name)
+}
+
+// NewZones creates the zones from their MeshConfig spec, ordered by precedence:
+// the most specific domain first and, for the same domain, namespace scoped views first.
+func NewZones(specs []configv1alpha3.LocalDNSZone) []*Zone {
+	zones := make([]*Zone, 0, len(specs))
+	for idx, spec := range specs {
+		zone := &Zone{
+			Name: strings.ToLower(dns.Fqdn(spec.Name)),
+			view: fmt.Sprintf("%s#%d", strings.ToLower(dns.Fqdn(spec.Name)), idx),
+		}
+		if len(spec.Namespaces) > 0 {
+			zone.namespaces = make(map[string]bool)
+			for _, ns := range spec.Namespaces {
+				zone.namespaces[ns] = true
+			}
+		}
+		for _, upstreamSpec := range spec.Upstreams {
+			upstream, err := NewUpstream(upstreamSpec)
+			if err != nil {
+				log.Error().Err(err).Msgf("invalid upstream DNS server %s of zone %s", upstreamSpec.Address, spec.Name)
+				continue
+			}
+			zone.Upstreams = append(zone.Upstreams, upstream)
+		}
+		if len(zone.Upstreams) == 0 {
+			log.Error().Msgf("zone %s has no valid upstream DNS server, ignored", spec.Name)
+			continue
+		}
+		zones = append(zones, zone)
+	}
+
+	sort.SliceStable(zones, func(i, j int) bool {
+		if li, lj := dns.CountLabel(zones[i].Name), dns.CountLabel(zones[j].Name); li != lj {
+			return li > lj
+		}
+		return len(zones[i].namespaces) > 0 && len(zones[j].namespaces) == 0
+	})
+
+	return zones
+}
+
+// MatchZone returns the zone qname belongs to for the pods of a namespace, or nil if none matches.
+// namespace is only called when a namespace scoped zone needs to be evaluated.
+func MatchZone(zones []*Zone, qname string, namespace func() string) *Zone {
+	qname = strings.ToLower(dns.Fqdn(qname))
+
+	var (
+		ns         string
+		nsResolved bool
+	)
+	for _, zone := range zones {
+		if !zone.contains(qname) {
+			continue
+		}
+		if len(zone.namespaces) > 0 {
+			if !nsResolved {
+				ns = namespace()
+				nsResolved = true
+			}
+			if !zone.visibleTo(ns) {
+				continue
+			}
+		}
+		return zone
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package dns
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/miekg/dns"
+	tassert "github.com/stretchr/testify/assert"
+
+	configv1alpha3 "github.com/flomesh-io/fsm/pkg/apis/config/v1alpha3"
+	"github.com/flomesh-io/fsm/pkg/configurator"
+)
+
+func TestMatchZone(t *testing.T) {
+	zones := NewZones([]configv1alpha3.LocalDNSZone{
+		{
+			Name:      "example.com",
+			Upstreams: []configv1alpha3.LocalDNSUpstream{{Address: "10.0.0.1"}},
+		},
+		{
+			Name:      "corp.example.com",
+			Upstreams: []configv1alpha3.LocalDNSUpstream{{Address: "10.0.0.2"}},
+		},
+		{
+			Name:       "corp.example.com",
+			Namespaces: []string{"finance"},
+			Upstreams:  []configv1alpha3.LocalDNSUpstream{{Address: "10.0.0.3"}},
+		},
+		{
+			Name:      "consul",
+			Upstreams: []configv1alpha3.LocalDNSUpstream{{Protocol: configv1alpha3.LocalDNSUpstreamProtocolTCP, Address: "10.0.0.4:8600"}},
+		},
+		{
+			Name:      "invalid",
+			Upstreams: []configv1alpha3.LocalDNSUpstream{{Protocol: "quic", Address: "10.0.0.5"}},
+		},
+	})
+
+	testCases := []struct {
+		name             string
+		qname            string
+		namespace        string
+		expectedUpstream string
+	}{
+		{
+			name:             "domain of the zone",
+			qname:            "example.com.",
+			expectedUpstream: "10.0.0.1:53",
+		},
+		{
+			name:             "most specific zone wins",
+			qname:            "ldap.corp.example.com.",
+			namespace:        "default",
+			expectedUpstream: "10.0.0.2:53",
+		},
+		{
+			name:             "namespace scoped view",
+			qname:            "LDAP.Corp.Example.com.",
+			namespace:        "finance",
+			expectedUpstream: "10.0.0.3:53",
+		},
+		{
+			name:             "zone matched on label boundaries",
+			qname:            "web.service.consul.",
+			expectedUpstream: "10.0.0.4:8600",
+		},
+		{
+			name:  "no zone",
+			qname: "www.notconsul.",
+		},
+		{
+			name:  "zone without valid upstreams is ignored",
+			qname: "www.invalid.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			zone := MatchZone(zones, tc.qname, func() string { return tc.namespace })
+			if len(tc.expectedUpstream) == 0 {
+				assert.Nil(zone)
+				return
+			}
+			assert.NotNil(zone)
+			assert.Equal(tc.expectedUpstream, zone.Upstreams[0].String())
+		})
+	}
+}
+
+func TestMatchZoneResolvesNamespaceLazily(t *testing.T) {
+	assert := tassert.New(t)
+
+	zones := NewZones([]configv1alpha3.LocalDNSZone{
+		{
+			Name:      "consul",
+			Upstreams: []configv1alpha3.LocalDNSUpstream{{Address: "10.0.0.4"}},
+		},
+	})
+
+	called := false
+	zone := MatchZone(zones, "web.service.consul", func() string {
+		called = true
+		return ""
+	})
+	assert.NotNil(zone)
+	assert.False(called)
+}
+
+// closingUpstream records whether its idle connections were closed
+type closingUpstream struct {
+	closed bool
+}
+
+func (u *closingUpstream) Exchange(string, *dns.Msg, time.Duration) (*dns.Msg, error) {
+	return nil, nil
+}
+
+func (u *closingUpstream) String() string {
+	return "closing"
+}
+
+func (u *closingUpstream) CloseIdleConnections() {
+	u.closed = true
+}
+
+func TestGetZonesClosesReplacedUpstreams(t *testing.T) {
+	assert := tassert.New(t)
+
+	mockCtrl := gomock.NewController(t)
+	mockConfigurator := configurator.NewMockConfigurator(mockCtrl)
+	meshConfig := configv1alpha3.MeshConfig{}
+	mockConfigurator.EXPECT().GetMeshConfig().DoAndReturn(func() configv1alpha3.MeshConfig { return meshConfig }).AnyTimes()
+	c := &Config{cfg: mockConfigurator}
+
+	meshConfig.Spec.Sidecar.LocalDNSProxy.Zones = []configv1alpha3.LocalDNSZone{
+		{Name: "example.com", Upstreams: []configv1alpha3.LocalDNSUpstream{{Address: "10.0.0.1"}}},
+	}
+	zones := c.GetZones()
+	assert.Len(zones, 1)
+	upstream := &closingUpstream{}
+	zones[0].Upstreams = []Upstream{upstream}
+
+	// unchanged zones are reused
+	assert.Equal(zones, c.GetZones())
+	assert.False(upstream.closed)
+
+	meshConfig.Spec.Sidecar.LocalDNSProxy.Zones = []configv1alpha3.LocalDNSZone{
+		{Name: "example.com", Upstreams: []configv1alpha3.LocalDNSUpstream{{Address: "10.0.0.2"}}},
+	}
+	zones = c.GetZones()
+	assert.True(upstream.closed)
+	assert.Equal("10.0.0.2:53", zones[0].Upstreams[0].String())
+
+	// removing the zones closes their upstreams as well
+	upstream = &closingUpstream{}
+	zones[0].Upstreams = []Upstream{upstream}
+	meshConfig.Spec.Sidecar.LocalDNSProxy.Zones = nil
+	assert.Nil(c.GetZones())
+	assert.True(upstream.closed)
+}
@@ -0,0 +1,221 @@
+package egressaudit
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/configurator"
+	"github.com/flomesh-io/fsm/pkg/identity"
+	"github.com/flomesh-io/fsm/pkg/k8s"
+	"github.com/flomesh-io/fsm/pkg/policy"
+	"github.com/flomesh-io/fsm/pkg/telemetry"
+)
+
+// NewCollector returns a collector aggregating the egress destinations audited by sidecars
+func NewCollector(kubeController k8s.Controller, cfg configurator.Configurator, policyController policy.Controller) *Collector {
+	return &Collector{
+		kubeController:   kubeController,
+		cfg:              cfg,
+		policyController: policyController,
+		scraper:          telemetry.NewScraper(egressConnectionTotalMetric),
+		snapshots:        make(map[podKey]*podSnapshot),
+	}
+}
+
+// Run scrapes the sidecars periodically while egress audit mode is enabled
+func (c *Collector) Run(stop <-chan struct{}) {
+	ticker := time.NewTicker(scrapeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			log.Info().Msg("Received stop signal, exiting egress audit collector")
+			return
+
+		case <-ticker.C:
+			if !c.cfg.GetMeshConfig().Spec.Observability.EgressLogging.AuditMode {
+				continue
+			}
+			c.scrape()
+		}
+	}
+}
+
+func (c *Collector) scrape() {
+	now := time.Now()
+	c.scraper.ScrapeAll(c.kubeController.ListPods(), func(pod *corev1.Pod, samples []telemetry.Sample) {
+		c.update(podKey{namespace: pod.Namespace, name: pod.Name}, pod.Spec.ServiceAccountName, auditedDestinations(samples), now)
+	})
+	c.prune(now)
+}
+
+// update merges the counters scraped from a pod into its snapshot
+func (c *Collector) update(key podKey, serviceAccount string, counters map[Destination]uint64, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	snapshot, ok := c.snapshots[key]
+	if !ok {
+		snapshot = &podSnapshot{connections: telemetry.NewCounters[Destination]()}
+		c.snapshots[key] = snapshot
+	}
+	snapshot.serviceAccount = serviceAccount
+	snapshot.connections.Update(counters, now)
+}
+
+// prune drops the destinations not seen within the retention period
+func (c *Collector) prune(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, snapshot := range c.snapshots {
+		if empty := snapshot.connections.Prune(now.Add(-retention)); empty {
+			delete(c.snapshots, key)
+		}
+	}
+}
+
+// ListRecords returns the audited destinations aggregated per service account, the destinations allowed
+// by the current Egress policies of a service account are left out as the sidecar counters of the
+// connections audited before the policies were applied are kept until the sidecar restarts
+func (c *Collector) ListRecords(namespace string) []Record {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	type recordKey struct {
+		namespace      string
+		serviceAccount string
+		destination    Destination
+	}
+
+	egressPolicies := make(map[identity.K8sServiceAccount][]*policyv1alpha1.Egress)
+	records := make(map[recordKey]*Record)
+	for key, snapshot := range c.snapshots {
+		if len(namespace) > 0 && key.namespace != namespace {
+			continue
+		}
+		sa := identity.K8sServiceAccount{Namespace: key.namespace, Name: snapshot.serviceAccount}
+		policies, ok := egressPolicies[sa]
+		if !ok {
+			policies = c.policyController.ListEgressPoliciesForSourceIdentity(sa)
+			egressPolicies[sa] = policies
+		}
+		snapshot.connections.Range(func(dest Destination, count uint64, lastSeen time.Time) {
+			if isAllowed(policies, dest) {
+				return
+			}
+			rk := recordKey{namespace: key.namespace, serviceAccount: snapshot.serviceAccount, destination: dest}
+			record, ok := records[rk]
+			if !ok {
+				record = &Record{Namespace: key.namespace, ServiceAccount: snapshot.serviceAccount, Destination: dest}
+				records[rk] = record
+			}
+			record.Connections += count
+			if lastSeen.After(record.LastSeen) {
+				record.LastSeen = lastSeen
+			}
+		})
+	}
+
+	list := make([]Record, 0, len(records))
+	for _, record := range records {
+		list = append(list, *record)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.ServiceAccount != b.ServiceAccount {
+			return a.ServiceAccount < b.ServiceAccount
+		}
+		if a.Destination.Host != b.Destination.Host {
+			return a.Destination.Host < b.Destination.Host
+		}
+		if a.Destination.Port != b.Destination.Port {
+			return a.Destination.Port < b.Destination.Port
+		}
+		return a.Destination.Protocol < b.Destination.Protocol
+	})
+	return list
+}
+
+// Handler returns the HTTP handler serving the audited destinations as JSON,
+// the namespace query parameter limits the records to a single namespace
+func (c *Collector) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		records := c.ListRecords(req.URL.Query().Get("namespace"))
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(records); err != nil {
+			log.Error().Err(err).Msg("Error encoding egress audit records")
+		}
+	})
+}
+
+// auditedDestinations returns the connection counters of the audited egress destinations in the given samples
+func auditedDestinations(samples []telemetry.Sample) map[Destination]uint64 {
+	counters := make(map[Destination]uint64)
+	for _, sample := range samples {
+		if sample.Labels["egress_decision"] != auditDecision || sample.Value <= 0 {
+			continue
+		}
+
+		port, err := strconv.Atoi(sample.Labels["destination_port"])
+		if err != nil || len(sample.Labels["destination_host"]) == 0 {
+			continue
+		}
+
+		dest := Destination{
+			Host:     sample.Labels["destination_host"],
+			Port:     port,
+			Protocol: sample.Labels["destination_protocol"],
+		}
+		counters[dest] += uint64(sample.Value)
+	}
+
+	return counters
+}
+
+// isAllowed returns true if the destination is allowed by any of the given Egress policies,
+// a policy scoped to hosts or IP ranges allows only those on its ports
+func isAllowed(egressPolicies []*policyv1alpha1.Egress, dest Destination) bool {
+	for _, egressPolicy := range egressPolicies {
+		portMatched := false
+		for _, portSpec := range egressPolicy.Spec.Ports {
+			if portSpec.Number == dest.Port {
+				portMatched = true
+				break
+			}
+		}
+		if !portMatched {
+			continue
+		}
+
+		if len(egressPolicy.Spec.Hosts) == 0 && len(egressPolicy.Spec.IPAddresses) == 0 {
+			return true
+		}
+		for _, host := range egressPolicy.Spec.Hosts {
+			if strings.EqualFold(host, dest.Host) {
+				return true
+			}
+		}
+		if ip := net.ParseIP(dest.Host); ip != nil {
+			for _, ipRange := range egressPolicy.Spec.IPAddresses {
+				if _, cidr, err := net.ParseCIDR(ipRange); err == nil && cidr.Contains(ip) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
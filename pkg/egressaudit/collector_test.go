@@ -0,0 +1,114 @@
+package egressaudit
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/identity"
+	"github.com/flomesh-io/fsm/pkg/policy"
+	"github.com/flomesh-io/fsm/pkg/telemetry"
+)
+
+func TestAuditedDestinations(t *testing.T) {
+	assert := tassert.New(t)
+
+	metrics := `# TYPE sidecar_egress_cx_total counter
+sidecar_egress_cx_total{destination_host="api.github.com",destination_port="443",destination_protocol="https",egress_policy="",egress_decision="audit"} 3
+sidecar_egress_cx_total{destination_host="httpbin.org",destination_port="80",destination_protocol="http",egress_policy="",egress_decision="audit"} 2
+sidecar_egress_cx_total{destination_host="httpbin.org",destination_port="80",destination_protocol="http",egress_policy="test/httpbin",egress_decision="allow"} 5
+sidecar_egress_cx_total{destination_host="10.0.0.1",destination_port="5432",destination_protocol="tcp",egress_policy="",egress_decision="audit"} 0
+sidecar_egress_cx_tx_bytes_total{destination_host="api.github.com"} 1024
+`
+
+	samples, err := telemetry.Parse(strings.NewReader(metrics), egressConnectionTotalMetric)
+	assert.Nil(err)
+
+	counters := auditedDestinations(samples)
+	assert.Equal(map[Destination]uint64{
+		{Host: "api.github.com", Port: 443, Protocol: "https"}: 3,
+		{Host: "httpbin.org", Port: 80, Protocol: "http"}:      2,
+	}, counters)
+}
+
+func TestCollectorUpdate(t *testing.T) {
+	assert := tassert.New(t)
+
+	mockCtrl := gomock.NewController(t)
+	mockPolicyController := policy.NewMockController(mockCtrl)
+	mockPolicyController.EXPECT().ListEgressPoliciesForSourceIdentity(gomock.Any()).Return(nil).AnyTimes()
+
+	c := &Collector{policyController: mockPolicyController, snapshots: make(map[podKey]*podSnapshot)}
+	github := Destination{Host: "api.github.com", Port: 443, Protocol: "https"}
+	now := time.Now()
+
+	c.update(podKey{namespace: "test", name: "pod-1"}, "sa-1", map[Destination]uint64{github: 3}, now)
+	c.update(podKey{namespace: "test", name: "pod-2"}, "sa-1", map[Destination]uint64{github: 1}, now)
+	c.update(podKey{namespace: "other", name: "pod-3"}, "sa-2", map[Destination]uint64{github: 4}, now)
+
+	// Counter of pod-1 is reset by a sidecar restart
+	c.update(podKey{namespace: "test", name: "pod-1"}, "sa-1", map[Destination]uint64{github: 2}, now)
+
+	records := c.ListRecords("test")
+	assert.Len(records, 1)
+	assert.Equal("sa-1", records[0].ServiceAccount)
+	assert.Equal(github, records[0].Destination)
+	assert.Equal(uint64(6), records[0].Connections)
+
+	assert.Len(c.ListRecords(""), 2)
+
+	c.prune(now.Add(retention + time.Minute))
+	assert.Empty(c.ListRecords(""))
+}
+
+func TestCollectorListRecordsHostScopedPolicy(t *testing.T) {
+	assert := tassert.New(t)
+
+	mockCtrl := gomock.NewController(t)
+	mockPolicyController := policy.NewMockController(mockCtrl)
+	mockPolicyController.EXPECT().ListEgressPoliciesForSourceIdentity(identity.K8sServiceAccount{Namespace: "test", Name: "sa-1"}).Return([]*policyv1alpha1.Egress{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "github"},
+			Spec: policyv1alpha1.EgressSpec{
+				Hosts: []string{"api.github.com"},
+				Ports: []policyv1alpha1.PortSpec{{Number: 443, Protocol: "https"}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "db"},
+			Spec: policyv1alpha1.EgressSpec{
+				IPAddresses: []string{"10.0.0.0/24"},
+				Ports:       []policyv1alpha1.PortSpec{{Number: 5432, Protocol: "tcp"}},
+			},
+		},
+	}).AnyTimes()
+
+	c := &Collector{policyController: mockPolicyController, snapshots: make(map[podKey]*podSnapshot)}
+	github := Destination{Host: "api.github.com", Port: 443, Protocol: "https"}
+	gitlab := Destination{Host: "gitlab.com", Port: 443, Protocol: "https"}
+	githubHTTP := Destination{Host: "api.github.com", Port: 80, Protocol: "http"}
+	dbInRange := Destination{Host: "10.0.0.10", Port: 5432, Protocol: "tcp"}
+	dbOutOfRange := Destination{Host: "10.0.1.10", Port: 5432, Protocol: "tcp"}
+
+	// The connections to api.github.com:443 were audited before the policy was applied
+	c.update(podKey{namespace: "test", name: "pod-1"}, "sa-1", map[Destination]uint64{
+		github:       3,
+		gitlab:       2,
+		githubHTTP:   1,
+		dbInRange:    1,
+		dbOutOfRange: 1,
+	}, time.Now())
+
+	var destinations []Destination
+	for _, record := range c.ListRecords("test") {
+		destinations = append(destinations, record.Destination)
+	}
+
+	// Other hosts on the port of a host scoped policy are still audited
+	assert.ElementsMatch([]Destination{gitlab, githubHTTP, dbOutOfRange}, destinations)
+}
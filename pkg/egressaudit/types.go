@@ -0,0 +1,69 @@
+// Package egressaudit aggregates the outbound destinations that sidecars in egress audit mode
+// observed without a matching Egress policy, so the policies covering them can be suggested.
+package egressaudit
+
+import (
+	"sync"
+	"time"
+
+	"github.com/flomesh-io/fsm/pkg/configurator"
+	"github.com/flomesh-io/fsm/pkg/k8s"
+	"github.com/flomesh-io/fsm/pkg/logger"
+	"github.com/flomesh-io/fsm/pkg/policy"
+	"github.com/flomesh-io/fsm/pkg/telemetry"
+)
+
+var log = logger.New("egress-audit")
+
+const (
+	// egressConnectionTotalMetric is the sidecar metric counting egress connections per destination
+	egressConnectionTotalMetric = "sidecar_egress_cx_total"
+
+	// auditDecision is the egress decision of connections not matched by any Egress policy
+	auditDecision = "audit"
+
+	// scrapeInterval is the interval at which sidecar metrics are scraped
+	scrapeInterval = 60 * time.Second
+
+	// retention is how long destinations that are no longer seen are kept
+	retention = 24 * time.Hour
+)
+
+// Destination is an outbound destination observed by sidecars
+type Destination struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Protocol string `json:"protocol"`
+}
+
+// Record is an observed destination of a service account along with its connection count
+type Record struct {
+	Namespace      string      `json:"namespace"`
+	ServiceAccount string      `json:"serviceAccount"`
+	Destination    Destination `json:"destination"`
+	Connections    uint64      `json:"connections"`
+	LastSeen       time.Time   `json:"lastSeen"`
+}
+
+// podKey identifies the pod a scraped snapshot belongs to
+type podKey struct {
+	namespace string
+	name      string
+}
+
+// podSnapshot is the audited destinations scraped from a pod
+type podSnapshot struct {
+	serviceAccount string
+	connections    *telemetry.Counters[Destination]
+}
+
+// Collector scrapes the egress metrics of sidecars and aggregates the audited destinations
+type Collector struct {
+	kubeController   k8s.Controller
+	cfg              configurator.Configurator
+	policyController policy.Controller
+	scraper          *telemetry.Scraper
+
+	mu        sync.RWMutex
+	snapshots map[podKey]*podSnapshot
+}
@@ -0,0 +1,65 @@
+package endpoint
+
+import (
+	"math"
+)
+
+// WithLocality returns the endpoints weighted to prefer the ones in the given local zone.
+// The endpoints of the other zones are dropped as long as the capacity of the local zone, which is
+// the percentage of its endpoints relative to an even distribution of the endpoints across the zones,
+// is at least minLocalCapacity. Below the threshold, spilloverPercentage percent of the traffic is
+// sent to the other zones. The endpoints of remote clusters are left untouched.
+func WithLocality(endpoints []Endpoint, localZone string, minLocalCapacity, spilloverPercentage uint32) []Endpoint {
+	if len(localZone) == 0 || len(endpoints) == 0 {
+		return endpoints
+	}
+
+	var local, other, remote []Endpoint
+	zones := make(map[string]bool)
+	for _, ep := range endpoints {
+		switch {
+		case len(ep.ClusterKey) > 0:
+			remote = append(remote, ep)
+		case ep.Zone == localZone:
+			local = append(local, ep)
+			zones[ep.Zone] = true
+		default:
+			other = append(other, ep)
+			// The endpoints with unknown zone are not counted as a zone
+			if len(ep.Zone) > 0 {
+				zones[ep.Zone] = true
+			}
+		}
+	}
+
+	// Nothing to prefer if there is no endpoint in the local zone or all of them are local
+	if len(local) == 0 || len(other) == 0 {
+		return endpoints
+	}
+
+	capacity := uint64(len(local)) * uint64(len(zones)) * 100 / uint64(len(local)+len(other))
+	if capacity >= uint64(minLocalCapacity) || spilloverPercentage == 0 {
+		return append(local, remote...)
+	}
+
+	if spilloverPercentage >= 100 {
+		return append(other, remote...)
+	}
+
+	// Weight the endpoints so that the other zones receive spilloverPercentage percent of the traffic
+	localWeight := Weight(100)
+	otherWeight := Weight(math.Ceil(float64(localWeight) * float64(spilloverPercentage) * float64(len(local)) /
+		(float64(100-spilloverPercentage) * float64(len(other)))))
+
+	weighted := make([]Endpoint, 0, len(endpoints))
+	for _, ep := range local {
+		ep.Weight = localWeight
+		weighted = append(weighted, ep)
+	}
+	for _, ep := range other {
+		ep.Weight = otherWeight
+		weighted = append(weighted, ep)
+	}
+
+	return append(weighted, remote...)
+}
@@ -0,0 +1,87 @@
+package endpoint
+
+import (
+	"net"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+)
+
+func TestWithLocality(t *testing.T) {
+	ep := func(ip, zone string) Endpoint {
+		return Endpoint{IP: net.ParseIP(ip), Port: 80, Zone: zone}
+	}
+	remote := Endpoint{IP: net.ParseIP("10.1.0.1"), Port: 80, ClusterKey: "region/zone/group/cluster"}
+
+	testCases := []struct {
+		name                string
+		endpoints           []Endpoint
+		localZone           string
+		minLocalCapacity    uint32
+		spilloverPercentage uint32
+		expected            []Endpoint
+	}{
+		{
+			name:             "unknown local zone",
+			endpoints:        []Endpoint{ep("10.0.0.1", "a"), ep("10.0.0.2", "b")},
+			localZone:        "",
+			minLocalCapacity: 70,
+			expected:         []Endpoint{ep("10.0.0.1", "a"), ep("10.0.0.2", "b")},
+		},
+		{
+			name:             "no endpoint in the local zone",
+			endpoints:        []Endpoint{ep("10.0.0.1", "a"), ep("10.0.0.2", "b")},
+			localZone:        "c",
+			minLocalCapacity: 70,
+			expected:         []Endpoint{ep("10.0.0.1", "a"), ep("10.0.0.2", "b")},
+		},
+		{
+			name:             "local capacity above the threshold keeps the traffic in the zone",
+			endpoints:        []Endpoint{ep("10.0.0.1", "a"), ep("10.0.0.2", "b"), ep("10.0.0.3", "a"), remote},
+			localZone:        "a",
+			minLocalCapacity: 70,
+			expected:         []Endpoint{ep("10.0.0.1", "a"), ep("10.0.0.3", "a"), remote},
+		},
+		{
+			name:                "local capacity below the threshold spills over",
+			endpoints:           []Endpoint{ep("10.0.0.1", "a"), ep("10.0.0.2", "b"), ep("10.0.0.3", "b"), ep("10.0.0.4", "b")},
+			localZone:           "a",
+			minLocalCapacity:    70,
+			spilloverPercentage: 25,
+			expected: []Endpoint{
+				{IP: net.ParseIP("10.0.0.1"), Port: 80, Zone: "a", Weight: 100},
+				{IP: net.ParseIP("10.0.0.2"), Port: 80, Zone: "b", Weight: 12},
+				{IP: net.ParseIP("10.0.0.3"), Port: 80, Zone: "b", Weight: 12},
+				{IP: net.ParseIP("10.0.0.4"), Port: 80, Zone: "b", Weight: 12},
+			},
+		},
+		{
+			name:                "endpoints with unknown zone are not counted as a zone",
+			endpoints:           []Endpoint{ep("10.0.0.1", "a"), ep("10.0.0.2", ""), ep("10.0.0.3", "b")},
+			localZone:           "a",
+			minLocalCapacity:    70,
+			spilloverPercentage: 25,
+			expected: []Endpoint{
+				{IP: net.ParseIP("10.0.0.1"), Port: 80, Zone: "a", Weight: 100},
+				{IP: net.ParseIP("10.0.0.2"), Port: 80, Zone: "", Weight: 17},
+				{IP: net.ParseIP("10.0.0.3"), Port: 80, Zone: "b", Weight: 17},
+			},
+		},
+		{
+			name:                "full spillover",
+			endpoints:           []Endpoint{ep("10.0.0.1", "a"), ep("10.0.0.2", "b"), ep("10.0.0.3", "b"), ep("10.0.0.4", "b")},
+			localZone:           "a",
+			minLocalCapacity:    70,
+			spilloverPercentage: 100,
+			expected:            []Endpoint{ep("10.0.0.2", "b"), ep("10.0.0.3", "b"), ep("10.0.0.4", "b")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			actual := WithLocality(tc.endpoints, tc.localZone, tc.minLocalCapacity, tc.spilloverPercentage)
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
@@ -48,6 +48,9 @@ type Endpoint struct {
 	// Path is a name with which a web service is accessed.
 	Path string `json:"path,omitempty"`
 
+	// ServerName is the SNI with which a service is accessed through the gateway.
+	ServerName string `json:"serverName,omitempty"`
+
 	// ClusterID belongs to cluster.
 	ClusterID string `json:"clusterId,omitempty"`
 
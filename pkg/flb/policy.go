@@ -0,0 +1,97 @@
+package flb
+
+import (
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/constants"
+)
+
+// IsServiceTargetRef checks if the target reference refers to the Service with the given name
+func IsServiceTargetRef(ref gwv1alpha2.LocalPolicyTargetReference, name string) bool {
+	return string(ref.Group) == constants.KubernetesCoreGroup &&
+		string(ref.Kind) == constants.KubernetesServiceKind &&
+		string(ref.Name) == name
+}
+
+// IsNamespaceTargetRef checks if the target reference refers to the Namespace with the given name
+func IsNamespaceTargetRef(ref gwv1alpha2.LocalPolicyTargetReference, namespace string) bool {
+	return string(ref.Group) == constants.KubernetesCoreGroup &&
+		string(ref.Kind) == constants.KubernetesNamespaceKind &&
+		string(ref.Name) == namespace
+}
+
+// PolicyTargetsService checks if the policy targets the Service directly
+func PolicyTargetsService(policy *policyv1alpha1.FLBPolicy, name string) bool {
+	for _, ref := range policy.Spec.TargetRefs {
+		if IsServiceTargetRef(ref, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PolicyTargetsNamespace checks if the policy targets its own Namespace
+func PolicyTargetsNamespace(policy *policyv1alpha1.FLBPolicy) bool {
+	for _, ref := range policy.Spec.TargetRefs {
+		if IsNamespaceTargetRef(ref, policy.Namespace) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasTargetRef checks if the policy has the target reference
+func HasTargetRef(policy *policyv1alpha1.FLBPolicy, ref gwv1alpha2.LocalPolicyTargetReference) bool {
+	for _, r := range policy.Spec.TargetRefs {
+		if r.Group == ref.Group && r.Kind == ref.Kind && r.Name == ref.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsOlderPolicy checks if policy a is created before policy b, the name breaks the tie
+func IsOlderPolicy(a, b *policyv1alpha1.FLBPolicy) bool {
+	if a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.Name < b.Name
+	}
+
+	return a.CreationTimestamp.Before(&b.CreationTimestamp)
+}
+
+// FindServicePolicy returns the FLBPolicy applied to the Service, a policy targeting the Service
+// takes precedence over a policy targeting its Namespace, the oldest one wins among policies of the same kind of target
+func FindServicePolicy(policies []policyv1alpha1.FLBPolicy, svc *corev1.Service) *policyv1alpha1.FLBPolicy {
+	candidates := make([]*policyv1alpha1.FLBPolicy, 0, len(policies))
+	for i := range policies {
+		candidates = append(candidates, &policies[i])
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return IsOlderPolicy(candidates[i], candidates[j])
+	})
+
+	var nsPolicy *policyv1alpha1.FLBPolicy
+	for _, policy := range candidates {
+		if policy.Namespace != svc.Namespace {
+			continue
+		}
+
+		if PolicyTargetsService(policy, svc.Name) {
+			return policy
+		}
+
+		if nsPolicy == nil && PolicyTargetsNamespace(policy) {
+			nsPolicy = policy
+		}
+	}
+
+	return nsPolicy
+}
@@ -0,0 +1,99 @@
+package flb
+
+import (
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+)
+
+func TestFindServicePolicy(t *testing.T) {
+	now := time.Now()
+
+	policy := func(name string, created time.Time, refs ...gwv1alpha2.LocalPolicyTargetReference) policyv1alpha1.FLBPolicy {
+		return policyv1alpha1.FLBPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "test",
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Spec: policyv1alpha1.FLBPolicySpec{TargetRefs: refs},
+		}
+	}
+	serviceRef := func(name string) gwv1alpha2.LocalPolicyTargetReference {
+		return gwv1alpha2.LocalPolicyTargetReference{Kind: "Service", Name: gwv1alpha2.ObjectName(name)}
+	}
+	namespaceRef := func(name string) gwv1alpha2.LocalPolicyTargetReference {
+		return gwv1alpha2.LocalPolicyTargetReference{Kind: "Namespace", Name: gwv1alpha2.ObjectName(name)}
+	}
+
+	testCases := []struct {
+		name     string
+		policies []policyv1alpha1.FLBPolicy
+		service  string
+		expected string
+	}{
+		{
+			name:     "no policy",
+			service:  "web",
+			expected: "",
+		},
+		{
+			name: "service policy takes precedence over namespace policy",
+			policies: []policyv1alpha1.FLBPolicy{
+				policy("ns", now.Add(-time.Hour), namespaceRef("test")),
+				policy("svc", now, serviceRef("web")),
+			},
+			service:  "web",
+			expected: "svc",
+		},
+		{
+			name: "namespace policy applies to untargeted service",
+			policies: []policyv1alpha1.FLBPolicy{
+				policy("ns", now, namespaceRef("test")),
+				policy("svc", now, serviceRef("web")),
+			},
+			service:  "api",
+			expected: "ns",
+		},
+		{
+			name: "oldest policy wins",
+			policies: []policyv1alpha1.FLBPolicy{
+				policy("newer", now, serviceRef("web")),
+				policy("older", now.Add(-time.Minute), serviceRef("web")),
+			},
+			service:  "web",
+			expected: "older",
+		},
+		{
+			name: "other namespace is not applied",
+			policies: []policyv1alpha1.FLBPolicy{
+				policy("ns", now, namespaceRef("other")),
+			},
+			service:  "web",
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: tc.service}}
+			actual := FindServicePolicy(tc.policies, svc)
+			if tc.expected == "" {
+				assert.Nil(actual)
+				return
+			}
+
+			if assert.NotNil(actual) {
+				assert.Equal(tc.expected, actual.Name)
+			}
+		})
+	}
+}
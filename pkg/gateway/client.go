@@ -111,6 +111,7 @@ func newClient(ctx *cctx.ControllerContext) *client {
 		fsminformers.InformerKeyGatewayRequestTermination: &extv1alpha1.RequestTermination{},
 		fsminformers.InformerKeyGatewayConcurrencyLimit:   &extv1alpha1.ConcurrencyLimit{},
 		fsminformers.InformerKeyGatewayDNSModifier:        &extv1alpha1.DNSModifier{},
+		fsminformers.InformerKeyGatewayJWTAuth:            &extv1alpha1.JWTAuth{},
 	}
 
 	if version.IsEndpointSliceEnabled(ctx.KubeClient) {
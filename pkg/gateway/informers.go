@@ -88,6 +88,8 @@ func getEventTypesByObjectType(obj interface{}) *k8s.EventTypes {
 		return getEventTypesByInformerKey(fsminformers.InformerKeyGatewayConcurrencyLimit)
 	case *extv1alpha1.DNSModifier:
 		return getEventTypesByInformerKey(fsminformers.InformerKeyGatewayDNSModifier)
+	case *extv1alpha1.JWTAuth:
+		return getEventTypesByInformerKey(fsminformers.InformerKeyGatewayJWTAuth)
 	}
 
 	return nil
@@ -301,6 +303,12 @@ func getEventTypesByInformerKey(informerKey fsminformers.InformerKey) *k8s.Event
 			Update: announcements.GatewayDNSModifierUpdated,
 			Delete: announcements.GatewayDNSModifierDeleted,
 		}
+	case fsminformers.InformerKeyGatewayJWTAuth:
+		return &k8s.EventTypes{
+			Add:    announcements.GatewayJWTAuthAdded,
+			Update: announcements.GatewayJWTAuthUpdated,
+			Delete: announcements.GatewayJWTAuthDeleted,
+		}
 	}
 
 	return nil
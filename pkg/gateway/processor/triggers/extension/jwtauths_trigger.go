@@ -0,0 +1,34 @@
+package extension
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/extension/v1alpha1"
+
+	"github.com/flomesh-io/fsm/pkg/gateway/processor"
+)
+
+// JWTAuthTrigger is a processor for JWTAuth objects
+type JWTAuthTrigger struct{}
+
+// Insert adds a JWTAuth object to the processor and returns true if the processor is changed
+func (p *JWTAuthTrigger) Insert(obj interface{}, processor processor.Processor) bool {
+	config, ok := obj.(*extv1alpha1.JWTAuth)
+	if !ok {
+		log.Error().Msgf("[GW] unexpected object type %T", obj)
+		return false
+	}
+
+	return processor.IsFilterConfigReferred(config.Kind, client.ObjectKeyFromObject(config))
+}
+
+// Delete removes a JWTAuth object from the processor and returns true if the processor is changed
+func (p *JWTAuthTrigger) Delete(obj interface{}, processor processor.Processor) bool {
+	config, ok := obj.(*extv1alpha1.JWTAuth)
+	if !ok {
+		log.Error().Msgf("[GW] unexpected object type %T", obj)
+		return false
+	}
+
+	return processor.IsFilterConfigReferred(config.Kind, client.ObjectKeyFromObject(config))
+}
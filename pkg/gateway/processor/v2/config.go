@@ -33,7 +33,7 @@ func (c *GatewayProcessor) BuildConfigs() {
 	}
 
 	for _, gw := range gwutils.GetGateways(c.client, gwutils.IsAcceptedGateway) {
-		cfg := NewGatewayConfigGenerator(gw, c, c.client, c.cfg).Generate()
+		cfg := NewGatewayConfigGenerator(gw, c, c.client, c.cfg, c.jwksCache).Generate()
 
 		go c.syncConfigDir(gw, cfg)
 	}
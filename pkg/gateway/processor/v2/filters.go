@@ -15,6 +15,7 @@ import (
 
 	extv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/extension/v1alpha1"
 	"github.com/flomesh-io/fsm/pkg/constants"
+	"github.com/flomesh-io/fsm/pkg/jwks"
 )
 
 func (c *ConfigGenerator) resolveFilterDefinition(filterType extv1alpha1.FilterType, filterScope extv1alpha1.FilterScope, ref *gwv1.LocalObjectReference) *extv1alpha1.FilterDefinition {
@@ -212,6 +213,47 @@ func (c *ConfigGenerator) resolveFilterConfig(ns string, ref *gwv1.LocalObjectRe
 		}
 
 		return toMap(k, &result)
+	case constants.GatewayJWTAuthKind:
+		k := "jwtAuth"
+
+		obj := &extv1alpha1.JWTAuth{}
+		if err := c.client.Get(ctx, key, obj); err != nil {
+			log.Error().Msgf("[GW] Failed to resolve JWTAuth: %s", err)
+			return emptyConfig(k)
+		}
+
+		spec := obj.Spec.DeepCopy()
+		providers := make([]extv1alpha1.JWTProvider, 0, len(spec.Providers))
+		for _, provider := range spec.Providers {
+			// Resolve the key set inline so that the gateway doesn't need to fetch it,
+			// the tokens of a provider whose key set can't be resolved are rejected
+			keySet := jwks.EmptyKeySet
+			switch {
+			case provider.JWKS != nil:
+				parsed, err := jwks.Parse(*provider.JWKS)
+				if err != nil {
+					log.Error().Msgf("[GW] Invalid JWKS of issuer %s in JWTAuth %s: %s", provider.Issuer, key, err)
+					break
+				}
+				keySet = parsed
+			case provider.JWKSURI != nil && c.jwksCache != nil:
+				cached, err := c.jwksCache.Get(*provider.JWKSURI)
+				if err != nil {
+					log.Warn().Msgf("[GW] JWKS of issuer %s in JWTAuth %s is not resolved: %s", provider.Issuer, key, err)
+					break
+				}
+				keySet = cached
+			default:
+				log.Warn().Msgf("[GW] No JWKS of issuer %s is resolved in JWTAuth %s", provider.Issuer, key)
+			}
+			provider.JWKS = &keySet
+
+			provider.JWKSURI = nil
+			providers = append(providers, provider)
+		}
+		spec.Providers = providers
+
+		return toMap(k, spec)
 	case constants.GatewayAPIExtensionFilterConfigKind:
 		obj := &extv1alpha1.FilterConfig{}
 		if err := c.client.Get(ctx, key, obj); err != nil {
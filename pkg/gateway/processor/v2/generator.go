@@ -18,6 +18,7 @@ import (
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	gwutils "github.com/flomesh-io/fsm/pkg/gateway/utils"
+	"github.com/flomesh-io/fsm/pkg/jwks"
 	"github.com/flomesh-io/fsm/pkg/utils"
 )
 
@@ -33,9 +34,10 @@ type ConfigGenerator struct {
 	backendTLSPolicies  map[string]*fgwv2.BackendTLSPolicy
 	backendLBPolicies   map[string]*fgwv2.BackendLBPolicy
 	healthCheckPolicies map[string]*fgwv2.HealthCheckPolicy
+	jwksCache           *jwks.Cache
 }
 
-func NewGatewayConfigGenerator(gateway *gwv1.Gateway, processor processor.Processor, client cache.Cache, mc configurator.Configurator) processor.Generator {
+func NewGatewayConfigGenerator(gateway *gwv1.Gateway, processor processor.Processor, client cache.Cache, mc configurator.Configurator, jwksCache *jwks.Cache) processor.Generator {
 	p := &ConfigGenerator{
 		client:              client,
 		processor:           processor,
@@ -47,6 +49,7 @@ func NewGatewayConfigGenerator(gateway *gwv1.Gateway, processor processor.Proces
 		backendTLSPolicies:  map[string]*fgwv2.BackendTLSPolicy{},
 		backendLBPolicies:   map[string]*fgwv2.BackendLBPolicy{},
 		healthCheckPolicies: map[string]*fgwv2.HealthCheckPolicy{},
+		jwksCache:           jwksCache,
 	}
 
 	if processor.UseEndpointSlices() {
@@ -2,6 +2,7 @@
 package v2
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/flomesh-io/fsm/pkg/utils"
@@ -37,6 +38,7 @@ import (
 	mcsv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/multicluster/v1alpha1"
 
 	"github.com/flomesh-io/fsm/pkg/configurator"
+	"github.com/flomesh-io/fsm/pkg/jwks"
 	"github.com/flomesh-io/fsm/pkg/k8s/informers"
 	"github.com/flomesh-io/fsm/pkg/repo"
 )
@@ -50,6 +52,7 @@ type GatewayProcessor struct {
 	mutex             *sync.RWMutex
 	useEndpointSlices bool
 	gatewayFilesHash  map[string]map[string]string
+	jwksCache         *jwks.Cache
 }
 
 // NewGatewayProcessor creates a new gateway processor
@@ -59,7 +62,7 @@ func NewGatewayProcessor(ctx *cctx.ControllerContext) *GatewayProcessor {
 	cfg := ctx.Configurator
 	repoBaseURL := fmt.Sprintf("%s://%s:%d", "http", cfg.GetRepoServerIPAddr(), cfg.GetProxyServerPort())
 	useEndpointSlices := cfg.GetFeatureFlags().UseEndpointSlicesForGateway && version.IsEndpointSliceEnabled(ctx.KubeClient)
-	return &GatewayProcessor{
+	c := &GatewayProcessor{
 		repoClient: repo.NewRepoClient(repoBaseURL, cfg.GetFSMLogLevel()),
 		client:     ctx.Manager.GetCache(),
 		cfg:        cfg,
@@ -99,12 +102,35 @@ func NewGatewayProcessor(ctx *cctx.ControllerContext) *GatewayProcessor {
 			informers.RequestTerminationResourceType:      &extensiontrigger.RequestTerminationTrigger{},
 			informers.ConcurrencyLimitResourceType:        &extensiontrigger.ConcurrencyLimitTrigger{},
 			informers.DNSModifierResourceType:             &extensiontrigger.DNSModifierTrigger{},
+			informers.JWTAuthResourceType:                 &extensiontrigger.JWTAuthTrigger{},
 		},
 
 		mutex:             new(sync.RWMutex),
 		useEndpointSlices: useEndpointSlices,
 		gatewayFilesHash:  make(map[string]map[string]string),
 	}
+	c.jwksCache = jwks.NewCache(ctx.MsgBroker, jwks.DefaultRefreshInterval, c.listJWKSURIs, ctx.Stop)
+
+	return c
+}
+
+// listJWKSURIs returns the URIs of the key sets referenced by the JWTAuth filter configs
+func (c *GatewayProcessor) listJWKSURIs() []string {
+	list := &extv1alpha1.JWTAuthList{}
+	if err := c.client.List(context.Background(), list); err != nil {
+		log.Error().Msgf("[GW] Failed to list JWTAuths: %s", err)
+		return nil
+	}
+
+	var uris []string
+	for _, jwtAuth := range list.Items {
+		for _, provider := range jwtAuth.Spec.Providers {
+			if provider.JWKS == nil && provider.JWKSURI != nil {
+				uris = append(uris, *provider.JWKSURI)
+			}
+		}
+	}
+	return uris
 }
 
 // Insert inserts an object into the processor
@@ -198,6 +224,8 @@ func (c *GatewayProcessor) getTrigger(obj interface{}) processor.Trigger {
 		return c.triggers[informers.ConcurrencyLimitResourceType]
 	case *extv1alpha1.DNSModifier:
 		return c.triggers[informers.DNSModifierResourceType]
+	case *extv1alpha1.JWTAuth:
+		return c.triggers[informers.JWTAuthResourceType]
 	}
 
 	return nil
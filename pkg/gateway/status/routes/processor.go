@@ -115,6 +115,11 @@ func (p *RouteStatusProcessor) computeRouteParentStatus(rs status.RouteStatusObj
 		}
 	}()
 
+	if gwutils.IsServiceParentRef(parentRef) {
+		p.computeServiceParentStatus(rs, parentRef, rps)
+		return
+	}
+
 	if parentRef.Group != nil && *parentRef.Group != gwv1.GroupName {
 		p.addNotAcceptedCondition(rs.GetResource(), rps, gwv1.RouteReasonUnsupportedValue, fmt.Sprintf("Group %q is not supported as parent of Route", *parentRef.Group))
 
@@ -18,6 +18,7 @@ import (
 	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	"github.com/flomesh-io/fsm/pkg/constants"
+	gwutils "github.com/flomesh-io/fsm/pkg/gateway/utils"
 )
 
 // --- DefaultRouteStatusObject ---
@@ -107,9 +108,14 @@ func (r *DefaultRouteParentStatusObject) AddCondition(conditionType gwv1.RouteCo
 	}
 
 	if rps == nil {
+		controllerName := constants.GatewayController
+		if gwutils.IsServiceParentRef(r.parentRef) {
+			controllerName = constants.MeshController
+		}
+
 		rps = &gwv1.RouteParentStatus{
 			ParentRef:      r.parentRef,
-			ControllerName: constants.GatewayController,
+			ControllerName: gwv1.GatewayController(controllerName),
 		}
 
 		r.routeParentStatuses = append(r.routeParentStatuses, rps)
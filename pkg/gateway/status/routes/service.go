@@ -0,0 +1,73 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/flomesh-io/fsm/pkg/gateway/status"
+	gwutils "github.com/flomesh-io/fsm/pkg/gateway/utils"
+)
+
+// computeServiceParentStatus computes the status of a Route attached to a Service (GAMMA),
+// such routes configure the sidecars of the mesh instead of the gateways
+func (p *RouteStatusProcessor) computeServiceParentStatus(rs status.RouteStatusObject, parentRef gwv1.ParentReference, rps status.RouteParentStatusObject) {
+	parentKey := types.NamespacedName{
+		Namespace: gwutils.NamespaceDerefOr(parentRef.Namespace, rs.GetFullName().Namespace),
+		Name:      string(parentRef.Name),
+	}
+	parent := &corev1.Service{}
+	if err := p.client.Get(context.Background(), parentKey, parent); err != nil {
+		if errors.IsNotFound(err) {
+			p.addNotAcceptedCondition(rs.GetResource(), rps, gwv1.RouteReasonNoMatchingParent, fmt.Sprintf("Parent Service %s not found", parentKey))
+		} else {
+			p.addNotAcceptedCondition(rs.GetResource(), rps, gwv1.RouteReasonNoMatchingParent, fmt.Sprintf("Failed to get Parent Service %s: %s", parentKey, err))
+		}
+
+		return
+	}
+
+	if parentRef.Port != nil && !hasServicePort(parent, int32(*parentRef.Port)) {
+		p.addNotAcceptedCondition(rs.GetResource(), rps, gwv1.RouteReasonNoMatchingParent, fmt.Sprintf("Port %d is not found in Parent Service %s", *parentRef.Port, parentKey))
+		return
+	}
+
+	switch route := rs.GetResource().(type) {
+	case *gwv1.HTTPRoute:
+		for _, rule := range route.Spec.Rules {
+			for _, bk := range rule.BackendRefs {
+				if p.backendRefToServicePortName(route, bk.BackendObjectReference, rps) == nil {
+					return
+				}
+			}
+		}
+	case *gwv1.GRPCRoute:
+		for _, rule := range route.Spec.Rules {
+			for _, bk := range rule.BackendRefs {
+				if p.backendRefToServicePortName(route, bk.BackendObjectReference, rps) == nil {
+					return
+				}
+			}
+		}
+	default:
+		p.addNotAcceptedCondition(rs.GetResource(), rps, gwv1.RouteReasonUnsupportedValue, fmt.Sprintf("%s is not supported to be attached to Service", rs.GroupVersionKind().Kind))
+		return
+	}
+
+	// All backend references of all rules have been resolved successfully for the parent
+	p.addResolvedRefsCondition(rs.GetResource(), rps, gwv1.RouteReasonResolvedRefs, "All backend references are resolved")
+}
+
+func hasServicePort(svc *corev1.Service, port int32) bool {
+	for _, p := range svc.Spec.Ports {
+		if p.Port == port {
+			return true
+		}
+	}
+
+	return false
+}
@@ -91,6 +91,12 @@ func IsRefToGateway(parentRef gwv1.ParentReference, gateway types.NamespacedName
 	return string(parentRef.Name) == gateway.Name
 }
 
+// IsServiceParentRef returns true if the parent reference is to a Service, aka the GAMMA pattern for mesh routes
+func IsServiceParentRef(parentRef gwv1.ParentReference) bool {
+	return parentRef.Group != nil && string(*parentRef.Group) == constants.KubernetesCoreGroup &&
+		parentRef.Kind != nil && string(*parentRef.Kind) == constants.KubernetesServiceKind
+}
+
 func IsLocalObjRefToGateway(targetRef gwv1.LocalObjectReference, gateway types.NamespacedName) bool {
 	if string(targetRef.Group) != gwv1.GroupName {
 		return false
@@ -35,6 +35,7 @@ type ExtensionV1alpha1Interface interface {
 	FilterDefinitionsGetter
 	HTTPLogsGetter
 	IPRestrictionsGetter
+	JWTAuthsGetter
 	ListenerFiltersGetter
 	MetricsesGetter
 	ProxyTagsGetter
@@ -88,6 +89,10 @@ func (c *ExtensionV1alpha1Client) IPRestrictions(namespace string) IPRestriction
 	return newIPRestrictions(c, namespace)
 }
 
+func (c *ExtensionV1alpha1Client) JWTAuths(namespace string) JWTAuthInterface {
+	return newJWTAuths(c, namespace)
+}
+
 func (c *ExtensionV1alpha1Client) ListenerFilters(namespace string) ListenerFilterInterface {
 	return newListenerFilters(c, namespace)
 }
@@ -65,6 +65,10 @@ func (c *FakeExtensionV1alpha1) IPRestrictions(namespace string) v1alpha1.IPRest
 	return newFakeIPRestrictions(c, namespace)
 }
 
+func (c *FakeExtensionV1alpha1) JWTAuths(namespace string) v1alpha1.JWTAuthInterface {
+	return newFakeJWTAuths(c, namespace)
+}
+
 func (c *FakeExtensionV1alpha1) ListenerFilters(namespace string) v1alpha1.ListenerFilterInterface {
 	return newFakeListenerFilters(c, namespace)
 }
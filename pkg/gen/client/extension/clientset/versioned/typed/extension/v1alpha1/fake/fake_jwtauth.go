@@ -0,0 +1,47 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/flomesh-io/fsm/pkg/apis/extension/v1alpha1"
+	extensionv1alpha1 "github.com/flomesh-io/fsm/pkg/gen/client/extension/clientset/versioned/typed/extension/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeJWTAuths implements JWTAuthInterface
+type fakeJWTAuths struct {
+	*gentype.FakeClientWithList[*v1alpha1.JWTAuth, *v1alpha1.JWTAuthList]
+	Fake *FakeExtensionV1alpha1
+}
+
+func newFakeJWTAuths(fake *FakeExtensionV1alpha1, namespace string) extensionv1alpha1.JWTAuthInterface {
+	return &fakeJWTAuths{
+		gentype.NewFakeClientWithList[*v1alpha1.JWTAuth, *v1alpha1.JWTAuthList](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("jwtauths"),
+			v1alpha1.SchemeGroupVersion.WithKind("JWTAuth"),
+			func() *v1alpha1.JWTAuth { return &v1alpha1.JWTAuth{} },
+			func() *v1alpha1.JWTAuthList { return &v1alpha1.JWTAuthList{} },
+			func(dst, src *v1alpha1.JWTAuthList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.JWTAuthList) []*v1alpha1.JWTAuth { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1alpha1.JWTAuthList, items []*v1alpha1.JWTAuth) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -35,6 +35,8 @@ type HTTPLogExpansion interface{}
 
 type IPRestrictionExpansion interface{}
 
+type JWTAuthExpansion interface{}
+
 type ListenerFilterExpansion interface{}
 
 type MetricsExpansion interface{}
@@ -41,6 +41,8 @@ type Interface interface {
 	HTTPLogs() HTTPLogInformer
 	// IPRestrictions returns a IPRestrictionInformer.
 	IPRestrictions() IPRestrictionInformer
+	// JWTAuths returns a JWTAuthInformer.
+	JWTAuths() JWTAuthInformer
 	// ListenerFilters returns a ListenerFilterInformer.
 	ListenerFilters() ListenerFilterInformer
 	// Metricses returns a MetricsInformer.
@@ -116,6 +118,11 @@ func (v *version) IPRestrictions() IPRestrictionInformer {
 	return &iPRestrictionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// JWTAuths returns a JWTAuthInformer.
+func (v *version) JWTAuths() JWTAuthInformer {
+	return &jWTAuthInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ListenerFilters returns a ListenerFilterInformer.
 func (v *version) ListenerFilters() ListenerFilterInformer {
 	return &listenerFilterInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -70,6 +70,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Extension().V1alpha1().HTTPLogs().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("iprestrictions"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Extension().V1alpha1().IPRestrictions().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("jwtauths"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Extension().V1alpha1().JWTAuths().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("listenerfilters"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Extension().V1alpha1().ListenerFilters().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("metricses"):
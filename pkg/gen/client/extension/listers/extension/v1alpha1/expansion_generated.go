@@ -91,6 +91,14 @@ type IPRestrictionListerExpansion interface{}
 // IPRestrictionNamespaceLister.
 type IPRestrictionNamespaceListerExpansion interface{}
 
+// JWTAuthListerExpansion allows custom methods to be added to
+// JWTAuthLister.
+type JWTAuthListerExpansion interface{}
+
+// JWTAuthNamespaceListerExpansion allows custom methods to be added to
+// JWTAuthNamespaceLister.
+type JWTAuthNamespaceListerExpansion interface{}
+
 // ListenerFilterListerExpansion allows custom methods to be added to
 // ListenerFilterLister.
 type ListenerFilterListerExpansion interface{}
@@ -0,0 +1,67 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	extensionv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/extension/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// JWTAuthLister helps list JWTAuths.
+// All objects returned here must be treated as read-only.
+type JWTAuthLister interface {
+	// List lists all JWTAuths in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*extensionv1alpha1.JWTAuth, err error)
+	// JWTAuths returns an object that can list and get JWTAuths.
+	JWTAuths(namespace string) JWTAuthNamespaceLister
+	JWTAuthListerExpansion
+}
+
+// jWTAuthLister implements the JWTAuthLister interface.
+type jWTAuthLister struct {
+	listers.ResourceIndexer[*extensionv1alpha1.JWTAuth]
+}
+
+// NewJWTAuthLister returns a new JWTAuthLister.
+func NewJWTAuthLister(indexer cache.Indexer) JWTAuthLister {
+	return &jWTAuthLister{listers.New[*extensionv1alpha1.JWTAuth](indexer, extensionv1alpha1.Resource("jwtauth"))}
+}
+
+// JWTAuths returns an object that can list and get JWTAuths.
+func (s *jWTAuthLister) JWTAuths(namespace string) JWTAuthNamespaceLister {
+	return jWTAuthNamespaceLister{listers.NewNamespaced[*extensionv1alpha1.JWTAuth](s.ResourceIndexer, namespace)}
+}
+
+// JWTAuthNamespaceLister helps list and get JWTAuths.
+// All objects returned here must be treated as read-only.
+type JWTAuthNamespaceLister interface {
+	// List lists all JWTAuths in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*extensionv1alpha1.JWTAuth, err error)
+	// Get retrieves the JWTAuth from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*extensionv1alpha1.JWTAuth, error)
+	JWTAuthNamespaceListerExpansion
+}
+
+// jWTAuthNamespaceLister implements the JWTAuthNamespaceLister
+// interface.
+type jWTAuthNamespaceLister struct {
+	listers.ResourceIndexer[*extensionv1alpha1.JWTAuth]
+}
@@ -0,0 +1,67 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	scheme "github.com/flomesh-io/fsm/pkg/gen/client/policy/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// AuthorizationPoliciesGetter has a method to return a AuthorizationPolicyInterface.
+// A group's client should implement this interface.
+type AuthorizationPoliciesGetter interface {
+	AuthorizationPolicies(namespace string) AuthorizationPolicyInterface
+}
+
+// AuthorizationPolicyInterface has methods to work with AuthorizationPolicy resources.
+type AuthorizationPolicyInterface interface {
+	Create(ctx context.Context, authorizationPolicy *policyv1alpha1.AuthorizationPolicy, opts v1.CreateOptions) (*policyv1alpha1.AuthorizationPolicy, error)
+	Update(ctx context.Context, authorizationPolicy *policyv1alpha1.AuthorizationPolicy, opts v1.UpdateOptions) (*policyv1alpha1.AuthorizationPolicy, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, authorizationPolicy *policyv1alpha1.AuthorizationPolicy, opts v1.UpdateOptions) (*policyv1alpha1.AuthorizationPolicy, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*policyv1alpha1.AuthorizationPolicy, error)
+	List(ctx context.Context, opts v1.ListOptions) (*policyv1alpha1.AuthorizationPolicyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *policyv1alpha1.AuthorizationPolicy, err error)
+	AuthorizationPolicyExpansion
+}
+
+// authorizationPolicies implements AuthorizationPolicyInterface
+type authorizationPolicies struct {
+	*gentype.ClientWithList[*policyv1alpha1.AuthorizationPolicy, *policyv1alpha1.AuthorizationPolicyList]
+}
+
+// newAuthorizationPolicies returns a AuthorizationPolicies
+func newAuthorizationPolicies(c *PolicyV1alpha1Client, namespace string) *authorizationPolicies {
+	return &authorizationPolicies{
+		gentype.NewClientWithList[*policyv1alpha1.AuthorizationPolicy, *policyv1alpha1.AuthorizationPolicyList](
+			"authorizationpolicies",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *policyv1alpha1.AuthorizationPolicy { return &policyv1alpha1.AuthorizationPolicy{} },
+			func() *policyv1alpha1.AuthorizationPolicyList { return &policyv1alpha1.AuthorizationPolicyList{} },
+		),
+	}
+}
@@ -0,0 +1,47 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/gen/client/policy/clientset/versioned/typed/policy/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeFLBPolicies implements FLBPolicyInterface
+type fakeFLBPolicies struct {
+	*gentype.FakeClientWithList[*v1alpha1.FLBPolicy, *v1alpha1.FLBPolicyList]
+	Fake *FakePolicyV1alpha1
+}
+
+func newFakeFLBPolicies(fake *FakePolicyV1alpha1, namespace string) policyv1alpha1.FLBPolicyInterface {
+	return &fakeFLBPolicies{
+		gentype.NewFakeClientWithList[*v1alpha1.FLBPolicy, *v1alpha1.FLBPolicyList](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("flbpolicies"),
+			v1alpha1.SchemeGroupVersion.WithKind("FLBPolicy"),
+			func() *v1alpha1.FLBPolicy { return &v1alpha1.FLBPolicy{} },
+			func() *v1alpha1.FLBPolicyList { return &v1alpha1.FLBPolicyList{} },
+			func(dst, src *v1alpha1.FLBPolicyList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.FLBPolicyList) []*v1alpha1.FLBPolicy { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1alpha1.FLBPolicyList, items []*v1alpha1.FLBPolicy) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
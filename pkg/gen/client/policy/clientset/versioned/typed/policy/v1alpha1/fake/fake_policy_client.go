@@ -33,6 +33,10 @@ func (c *FakePolicyV1alpha1) AccessControls(namespace string) v1alpha1.AccessCon
 	return newFakeAccessControls(c, namespace)
 }
 
+func (c *FakePolicyV1alpha1) AuthorizationPolicies(namespace string) v1alpha1.AuthorizationPolicyInterface {
+	return newFakeAuthorizationPolicies(c, namespace)
+}
+
 func (c *FakePolicyV1alpha1) Egresses(namespace string) v1alpha1.EgressInterface {
 	return newFakeEgresses(c, namespace)
 }
@@ -41,6 +45,10 @@ func (c *FakePolicyV1alpha1) EgressGateways(namespace string) v1alpha1.EgressGat
 	return newFakeEgressGateways(c, namespace)
 }
 
+func (c *FakePolicyV1alpha1) FLBPolicies(namespace string) v1alpha1.FLBPolicyInterface {
+	return newFakeFLBPolicies(c, namespace)
+}
+
 func (c *FakePolicyV1alpha1) IngressBackends(namespace string) v1alpha1.IngressBackendInterface {
 	return newFakeIngressBackends(c, namespace)
 }
@@ -49,10 +57,22 @@ func (c *FakePolicyV1alpha1) Isolations(namespace string) v1alpha1.IsolationInte
 	return newFakeIsolations(c, namespace)
 }
 
+func (c *FakePolicyV1alpha1) MeshFaultInjections(namespace string) v1alpha1.MeshFaultInjectionInterface {
+	return newFakeMeshFaultInjections(c, namespace)
+}
+
+func (c *FakePolicyV1alpha1) RequestAuthentications(namespace string) v1alpha1.RequestAuthenticationInterface {
+	return newFakeRequestAuthentications(c, namespace)
+}
+
 func (c *FakePolicyV1alpha1) Retries(namespace string) v1alpha1.RetryInterface {
 	return newFakeRetries(c, namespace)
 }
 
+func (c *FakePolicyV1alpha1) SidecarScopes(namespace string) v1alpha1.SidecarScopeInterface {
+	return newFakeSidecarScopes(c, namespace)
+}
+
 func (c *FakePolicyV1alpha1) TrafficWarmups(namespace string) v1alpha1.TrafficWarmupInterface {
 	return newFakeTrafficWarmups(c, namespace)
 }
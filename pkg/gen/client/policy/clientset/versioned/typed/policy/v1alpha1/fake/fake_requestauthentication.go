@@ -0,0 +1,49 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/gen/client/policy/clientset/versioned/typed/policy/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeRequestAuthentications implements RequestAuthenticationInterface
+type fakeRequestAuthentications struct {
+	*gentype.FakeClientWithList[*v1alpha1.RequestAuthentication, *v1alpha1.RequestAuthenticationList]
+	Fake *FakePolicyV1alpha1
+}
+
+func newFakeRequestAuthentications(fake *FakePolicyV1alpha1, namespace string) policyv1alpha1.RequestAuthenticationInterface {
+	return &fakeRequestAuthentications{
+		gentype.NewFakeClientWithList[*v1alpha1.RequestAuthentication, *v1alpha1.RequestAuthenticationList](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("requestauthentications"),
+			v1alpha1.SchemeGroupVersion.WithKind("RequestAuthentication"),
+			func() *v1alpha1.RequestAuthentication { return &v1alpha1.RequestAuthentication{} },
+			func() *v1alpha1.RequestAuthenticationList { return &v1alpha1.RequestAuthenticationList{} },
+			func(dst, src *v1alpha1.RequestAuthenticationList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.RequestAuthenticationList) []*v1alpha1.RequestAuthentication {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.RequestAuthenticationList, items []*v1alpha1.RequestAuthentication) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -19,16 +19,26 @@ type AccessCertExpansion interface{}
 
 type AccessControlExpansion interface{}
 
+type AuthorizationPolicyExpansion interface{}
+
 type EgressExpansion interface{}
 
 type EgressGatewayExpansion interface{}
 
+type FLBPolicyExpansion interface{}
+
 type IngressBackendExpansion interface{}
 
 type IsolationExpansion interface{}
 
+type MeshFaultInjectionExpansion interface{}
+
+type RequestAuthenticationExpansion interface{}
+
 type RetryExpansion interface{}
 
+type SidecarScopeExpansion interface{}
+
 type TrafficWarmupExpansion interface{}
 
 type UpstreamTrafficSettingExpansion interface{}
@@ -27,11 +27,16 @@ type PolicyV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	AccessCertsGetter
 	AccessControlsGetter
+	AuthorizationPoliciesGetter
 	EgressesGetter
 	EgressGatewaysGetter
+	FLBPoliciesGetter
 	IngressBackendsGetter
 	IsolationsGetter
+	MeshFaultInjectionsGetter
+	RequestAuthenticationsGetter
 	RetriesGetter
+	SidecarScopesGetter
 	TrafficWarmupsGetter
 	UpstreamTrafficSettingsGetter
 }
@@ -49,6 +54,10 @@ func (c *PolicyV1alpha1Client) AccessControls(namespace string) AccessControlInt
 	return newAccessControls(c, namespace)
 }
 
+func (c *PolicyV1alpha1Client) AuthorizationPolicies(namespace string) AuthorizationPolicyInterface {
+	return newAuthorizationPolicies(c, namespace)
+}
+
 func (c *PolicyV1alpha1Client) Egresses(namespace string) EgressInterface {
 	return newEgresses(c, namespace)
 }
@@ -57,6 +66,10 @@ func (c *PolicyV1alpha1Client) EgressGateways(namespace string) EgressGatewayInt
 	return newEgressGateways(c, namespace)
 }
 
+func (c *PolicyV1alpha1Client) FLBPolicies(namespace string) FLBPolicyInterface {
+	return newFLBPolicies(c, namespace)
+}
+
 func (c *PolicyV1alpha1Client) IngressBackends(namespace string) IngressBackendInterface {
 	return newIngressBackends(c, namespace)
 }
@@ -65,10 +78,22 @@ func (c *PolicyV1alpha1Client) Isolations(namespace string) IsolationInterface {
 	return newIsolations(c, namespace)
 }
 
+func (c *PolicyV1alpha1Client) MeshFaultInjections(namespace string) MeshFaultInjectionInterface {
+	return newMeshFaultInjections(c, namespace)
+}
+
+func (c *PolicyV1alpha1Client) RequestAuthentications(namespace string) RequestAuthenticationInterface {
+	return newRequestAuthentications(c, namespace)
+}
+
 func (c *PolicyV1alpha1Client) Retries(namespace string) RetryInterface {
 	return newRetries(c, namespace)
 }
 
+func (c *PolicyV1alpha1Client) SidecarScopes(namespace string) SidecarScopeInterface {
+	return newSidecarScopes(c, namespace)
+}
+
 func (c *PolicyV1alpha1Client) TrafficWarmups(namespace string) TrafficWarmupInterface {
 	return newTrafficWarmups(c, namespace)
 }
@@ -0,0 +1,67 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	scheme "github.com/flomesh-io/fsm/pkg/gen/client/policy/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// RequestAuthenticationsGetter has a method to return a RequestAuthenticationInterface.
+// A group's client should implement this interface.
+type RequestAuthenticationsGetter interface {
+	RequestAuthentications(namespace string) RequestAuthenticationInterface
+}
+
+// RequestAuthenticationInterface has methods to work with RequestAuthentication resources.
+type RequestAuthenticationInterface interface {
+	Create(ctx context.Context, requestAuthentication *policyv1alpha1.RequestAuthentication, opts v1.CreateOptions) (*policyv1alpha1.RequestAuthentication, error)
+	Update(ctx context.Context, requestAuthentication *policyv1alpha1.RequestAuthentication, opts v1.UpdateOptions) (*policyv1alpha1.RequestAuthentication, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, requestAuthentication *policyv1alpha1.RequestAuthentication, opts v1.UpdateOptions) (*policyv1alpha1.RequestAuthentication, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*policyv1alpha1.RequestAuthentication, error)
+	List(ctx context.Context, opts v1.ListOptions) (*policyv1alpha1.RequestAuthenticationList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *policyv1alpha1.RequestAuthentication, err error)
+	RequestAuthenticationExpansion
+}
+
+// requestAuthentications implements RequestAuthenticationInterface
+type requestAuthentications struct {
+	*gentype.ClientWithList[*policyv1alpha1.RequestAuthentication, *policyv1alpha1.RequestAuthenticationList]
+}
+
+// newRequestAuthentications returns a RequestAuthentications
+func newRequestAuthentications(c *PolicyV1alpha1Client, namespace string) *requestAuthentications {
+	return &requestAuthentications{
+		gentype.NewClientWithList[*policyv1alpha1.RequestAuthentication, *policyv1alpha1.RequestAuthenticationList](
+			"requestauthentications",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *policyv1alpha1.RequestAuthentication { return &policyv1alpha1.RequestAuthentication{} },
+			func() *policyv1alpha1.RequestAuthenticationList { return &policyv1alpha1.RequestAuthenticationList{} },
+		),
+	}
+}
@@ -54,16 +54,26 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().AccessCerts().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("accesscontrols"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().AccessControls().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("authorizationpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().AuthorizationPolicies().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("egresses"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().Egresses().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("egressgateways"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().EgressGateways().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("flbpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().FLBPolicies().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("ingressbackends"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().IngressBackends().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("isolations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().Isolations().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("meshfaultinjections"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().MeshFaultInjections().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("requestauthentications"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().RequestAuthentications().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("retries"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().Retries().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("sidecarscopes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().SidecarScopes().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("trafficwarmups"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().TrafficWarmups().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("upstreamtrafficsettings"):
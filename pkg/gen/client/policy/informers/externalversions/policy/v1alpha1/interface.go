@@ -25,16 +25,26 @@ type Interface interface {
 	AccessCerts() AccessCertInformer
 	// AccessControls returns a AccessControlInformer.
 	AccessControls() AccessControlInformer
+	// AuthorizationPolicies returns a AuthorizationPolicyInformer.
+	AuthorizationPolicies() AuthorizationPolicyInformer
 	// Egresses returns a EgressInformer.
 	Egresses() EgressInformer
 	// EgressGateways returns a EgressGatewayInformer.
 	EgressGateways() EgressGatewayInformer
+	// FLBPolicies returns a FLBPolicyInformer.
+	FLBPolicies() FLBPolicyInformer
 	// IngressBackends returns a IngressBackendInformer.
 	IngressBackends() IngressBackendInformer
 	// Isolations returns a IsolationInformer.
 	Isolations() IsolationInformer
+	// MeshFaultInjections returns a MeshFaultInjectionInformer.
+	MeshFaultInjections() MeshFaultInjectionInformer
+	// RequestAuthentications returns a RequestAuthenticationInformer.
+	RequestAuthentications() RequestAuthenticationInformer
 	// Retries returns a RetryInformer.
 	Retries() RetryInformer
+	// SidecarScopes returns a SidecarScopeInformer.
+	SidecarScopes() SidecarScopeInformer
 	// TrafficWarmups returns a TrafficWarmupInformer.
 	TrafficWarmups() TrafficWarmupInformer
 	// UpstreamTrafficSettings returns a UpstreamTrafficSettingInformer.
@@ -62,6 +72,11 @@ func (v *version) AccessControls() AccessControlInformer {
 	return &accessControlInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// AuthorizationPolicies returns a AuthorizationPolicyInformer.
+func (v *version) AuthorizationPolicies() AuthorizationPolicyInformer {
+	return &authorizationPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Egresses returns a EgressInformer.
 func (v *version) Egresses() EgressInformer {
 	return &egressInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -72,6 +87,11 @@ func (v *version) EgressGateways() EgressGatewayInformer {
 	return &egressGatewayInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// FLBPolicies returns a FLBPolicyInformer.
+func (v *version) FLBPolicies() FLBPolicyInformer {
+	return &fLBPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // IngressBackends returns a IngressBackendInformer.
 func (v *version) IngressBackends() IngressBackendInformer {
 	return &ingressBackendInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -82,11 +102,26 @@ func (v *version) Isolations() IsolationInformer {
 	return &isolationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// MeshFaultInjections returns a MeshFaultInjectionInformer.
+func (v *version) MeshFaultInjections() MeshFaultInjectionInformer {
+	return &meshFaultInjectionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// RequestAuthentications returns a RequestAuthenticationInformer.
+func (v *version) RequestAuthentications() RequestAuthenticationInformer {
+	return &requestAuthenticationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Retries returns a RetryInformer.
 func (v *version) Retries() RetryInformer {
 	return &retryInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// SidecarScopes returns a SidecarScopeInformer.
+func (v *version) SidecarScopes() SidecarScopeInformer {
+	return &sidecarScopeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // TrafficWarmups returns a TrafficWarmupInformer.
 func (v *version) TrafficWarmups() TrafficWarmupInformer {
 	return &trafficWarmupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
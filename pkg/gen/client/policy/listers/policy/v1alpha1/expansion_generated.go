@@ -31,6 +31,14 @@ type AccessControlListerExpansion interface{}
 // AccessControlNamespaceLister.
 type AccessControlNamespaceListerExpansion interface{}
 
+// AuthorizationPolicyListerExpansion allows custom methods to be added to
+// AuthorizationPolicyLister.
+type AuthorizationPolicyListerExpansion interface{}
+
+// AuthorizationPolicyNamespaceListerExpansion allows custom methods to be added to
+// AuthorizationPolicyNamespaceLister.
+type AuthorizationPolicyNamespaceListerExpansion interface{}
+
 // EgressListerExpansion allows custom methods to be added to
 // EgressLister.
 type EgressListerExpansion interface{}
@@ -47,6 +55,14 @@ type EgressGatewayListerExpansion interface{}
 // EgressGatewayNamespaceLister.
 type EgressGatewayNamespaceListerExpansion interface{}
 
+// FLBPolicyListerExpansion allows custom methods to be added to
+// FLBPolicyLister.
+type FLBPolicyListerExpansion interface{}
+
+// FLBPolicyNamespaceListerExpansion allows custom methods to be added to
+// FLBPolicyNamespaceLister.
+type FLBPolicyNamespaceListerExpansion interface{}
+
 // IngressBackendListerExpansion allows custom methods to be added to
 // IngressBackendLister.
 type IngressBackendListerExpansion interface{}
@@ -63,6 +79,22 @@ type IsolationListerExpansion interface{}
 // IsolationNamespaceLister.
 type IsolationNamespaceListerExpansion interface{}
 
+// MeshFaultInjectionListerExpansion allows custom methods to be added to
+// MeshFaultInjectionLister.
+type MeshFaultInjectionListerExpansion interface{}
+
+// MeshFaultInjectionNamespaceListerExpansion allows custom methods to be added to
+// MeshFaultInjectionNamespaceLister.
+type MeshFaultInjectionNamespaceListerExpansion interface{}
+
+// RequestAuthenticationListerExpansion allows custom methods to be added to
+// RequestAuthenticationLister.
+type RequestAuthenticationListerExpansion interface{}
+
+// RequestAuthenticationNamespaceListerExpansion allows custom methods to be added to
+// RequestAuthenticationNamespaceLister.
+type RequestAuthenticationNamespaceListerExpansion interface{}
+
 // RetryListerExpansion allows custom methods to be added to
 // RetryLister.
 type RetryListerExpansion interface{}
@@ -71,6 +103,14 @@ type RetryListerExpansion interface{}
 // RetryNamespaceLister.
 type RetryNamespaceListerExpansion interface{}
 
+// SidecarScopeListerExpansion allows custom methods to be added to
+// SidecarScopeLister.
+type SidecarScopeListerExpansion interface{}
+
+// SidecarScopeNamespaceListerExpansion allows custom methods to be added to
+// SidecarScopeNamespaceLister.
+type SidecarScopeNamespaceListerExpansion interface{}
+
 // TrafficWarmupListerExpansion allows custom methods to be added to
 // TrafficWarmupLister.
 type TrafficWarmupListerExpansion interface{}
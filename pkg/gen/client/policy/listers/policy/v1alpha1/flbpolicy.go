@@ -0,0 +1,67 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// FLBPolicyLister helps list FLBPolicies.
+// All objects returned here must be treated as read-only.
+type FLBPolicyLister interface {
+	// List lists all FLBPolicies in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*policyv1alpha1.FLBPolicy, err error)
+	// FLBPolicies returns an object that can list and get FLBPolicies.
+	FLBPolicies(namespace string) FLBPolicyNamespaceLister
+	FLBPolicyListerExpansion
+}
+
+// fLBPolicyLister implements the FLBPolicyLister interface.
+type fLBPolicyLister struct {
+	listers.ResourceIndexer[*policyv1alpha1.FLBPolicy]
+}
+
+// NewFLBPolicyLister returns a new FLBPolicyLister.
+func NewFLBPolicyLister(indexer cache.Indexer) FLBPolicyLister {
+	return &fLBPolicyLister{listers.New[*policyv1alpha1.FLBPolicy](indexer, policyv1alpha1.Resource("flbpolicy"))}
+}
+
+// FLBPolicies returns an object that can list and get FLBPolicies.
+func (s *fLBPolicyLister) FLBPolicies(namespace string) FLBPolicyNamespaceLister {
+	return fLBPolicyNamespaceLister{listers.NewNamespaced[*policyv1alpha1.FLBPolicy](s.ResourceIndexer, namespace)}
+}
+
+// FLBPolicyNamespaceLister helps list and get FLBPolicies.
+// All objects returned here must be treated as read-only.
+type FLBPolicyNamespaceLister interface {
+	// List lists all FLBPolicies in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*policyv1alpha1.FLBPolicy, err error)
+	// Get retrieves the FLBPolicy from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*policyv1alpha1.FLBPolicy, error)
+	FLBPolicyNamespaceListerExpansion
+}
+
+// fLBPolicyNamespaceLister implements the FLBPolicyNamespaceLister
+// interface.
+type fLBPolicyNamespaceLister struct {
+	listers.ResourceIndexer[*policyv1alpha1.FLBPolicy]
+}
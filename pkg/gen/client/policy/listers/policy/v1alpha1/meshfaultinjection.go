@@ -0,0 +1,67 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// MeshFaultInjectionLister helps list MeshFaultInjections.
+// All objects returned here must be treated as read-only.
+type MeshFaultInjectionLister interface {
+	// List lists all MeshFaultInjections in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*policyv1alpha1.MeshFaultInjection, err error)
+	// MeshFaultInjections returns an object that can list and get MeshFaultInjections.
+	MeshFaultInjections(namespace string) MeshFaultInjectionNamespaceLister
+	MeshFaultInjectionListerExpansion
+}
+
+// meshFaultInjectionLister implements the MeshFaultInjectionLister interface.
+type meshFaultInjectionLister struct {
+	listers.ResourceIndexer[*policyv1alpha1.MeshFaultInjection]
+}
+
+// NewMeshFaultInjectionLister returns a new MeshFaultInjectionLister.
+func NewMeshFaultInjectionLister(indexer cache.Indexer) MeshFaultInjectionLister {
+	return &meshFaultInjectionLister{listers.New[*policyv1alpha1.MeshFaultInjection](indexer, policyv1alpha1.Resource("meshfaultinjection"))}
+}
+
+// MeshFaultInjections returns an object that can list and get MeshFaultInjections.
+func (s *meshFaultInjectionLister) MeshFaultInjections(namespace string) MeshFaultInjectionNamespaceLister {
+	return meshFaultInjectionNamespaceLister{listers.NewNamespaced[*policyv1alpha1.MeshFaultInjection](s.ResourceIndexer, namespace)}
+}
+
+// MeshFaultInjectionNamespaceLister helps list and get MeshFaultInjections.
+// All objects returned here must be treated as read-only.
+type MeshFaultInjectionNamespaceLister interface {
+	// List lists all MeshFaultInjections in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*policyv1alpha1.MeshFaultInjection, err error)
+	// Get retrieves the MeshFaultInjection from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*policyv1alpha1.MeshFaultInjection, error)
+	MeshFaultInjectionNamespaceListerExpansion
+}
+
+// meshFaultInjectionNamespaceLister implements the MeshFaultInjectionNamespaceLister
+// interface.
+type meshFaultInjectionNamespaceLister struct {
+	listers.ResourceIndexer[*policyv1alpha1.MeshFaultInjection]
+}
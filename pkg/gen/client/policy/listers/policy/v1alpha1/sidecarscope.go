@@ -0,0 +1,67 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// SidecarScopeLister helps list SidecarScopes.
+// All objects returned here must be treated as read-only.
+type SidecarScopeLister interface {
+	// List lists all SidecarScopes in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*policyv1alpha1.SidecarScope, err error)
+	// SidecarScopes returns an object that can list and get SidecarScopes.
+	SidecarScopes(namespace string) SidecarScopeNamespaceLister
+	SidecarScopeListerExpansion
+}
+
+// sidecarScopeLister implements the SidecarScopeLister interface.
+type sidecarScopeLister struct {
+	listers.ResourceIndexer[*policyv1alpha1.SidecarScope]
+}
+
+// NewSidecarScopeLister returns a new SidecarScopeLister.
+func NewSidecarScopeLister(indexer cache.Indexer) SidecarScopeLister {
+	return &sidecarScopeLister{listers.New[*policyv1alpha1.SidecarScope](indexer, policyv1alpha1.Resource("sidecarscope"))}
+}
+
+// SidecarScopes returns an object that can list and get SidecarScopes.
+func (s *sidecarScopeLister) SidecarScopes(namespace string) SidecarScopeNamespaceLister {
+	return sidecarScopeNamespaceLister{listers.NewNamespaced[*policyv1alpha1.SidecarScope](s.ResourceIndexer, namespace)}
+}
+
+// SidecarScopeNamespaceLister helps list and get SidecarScopes.
+// All objects returned here must be treated as read-only.
+type SidecarScopeNamespaceLister interface {
+	// List lists all SidecarScopes in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*policyv1alpha1.SidecarScope, err error)
+	// Get retrieves the SidecarScope from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*policyv1alpha1.SidecarScope, error)
+	SidecarScopeNamespaceListerExpansion
+}
+
+// sidecarScopeNamespaceLister implements the SidecarScopeNamespaceLister
+// interface.
+type sidecarScopeNamespaceLister struct {
+	listers.ResourceIndexer[*policyv1alpha1.SidecarScope]
+}
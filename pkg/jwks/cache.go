@@ -0,0 +1,254 @@
+// Package jwks implements a cache of the JSON Web Key Sets (JWKS) fetched from remote URIs,
+// the cached key sets are refreshed periodically so that key rotations are pushed to the proxies.
+package jwks
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/flomesh-io/fsm/pkg/announcements"
+	"github.com/flomesh-io/fsm/pkg/k8s/events"
+	"github.com/flomesh-io/fsm/pkg/logger"
+	"github.com/flomesh-io/fsm/pkg/messaging"
+)
+
+const (
+	// DefaultRefreshInterval is the default interval to refresh the cached key sets
+	DefaultRefreshInterval = 5 * time.Minute
+
+	// EmptyKeySet is a key set without any key, tokens can't be verified against it
+	EmptyKeySet = `{"keys":[]}`
+
+	// fetchTimeout is the timeout to fetch a key set
+	fetchTimeout = 10 * time.Second
+
+	// maxKeySetSize is the max size of a key set in bytes
+	maxKeySetSize = 1 << 20
+
+	// minRetryInterval is the interval to fetch a key set again after the first failure,
+	// the interval doubles on each consecutive failure up to the refresh interval
+	minRetryInterval = 5 * time.Second
+
+	// pruneAfterRefreshes is the number of refresh intervals a key set no longer referenced is kept
+	// without being used
+	pruneAfterRefreshes = 12
+)
+
+var (
+	log = logger.New("jwks")
+)
+
+// Cache is the type used to cache the JSON Web Key Sets fetched from remote URIs,
+// the key sets are fetched in the background so that looking them up never blocks
+type Cache struct {
+	mu              sync.Mutex
+	entries         map[string]*entry
+	client          *http.Client
+	msgBroker       *messaging.Broker
+	refreshInterval time.Duration
+	referencedURIs  func() []string
+	fetch           func(uri string) (string, error)
+}
+
+// entry is a cached key set along with the state of fetching it
+type entry struct {
+	keySet    string
+	err       error
+	fetching  bool
+	failures  int
+	nextFetch time.Time
+	lastUsed  time.Time
+}
+
+// NewCache returns a Cache which refreshes the cached key sets every refreshInterval until stop is closed,
+// an announcements.JWKSUpdated event is published through the message broker when a key set is changed.
+// The key sets of the URIs returned by referencedURIs are kept refreshed until they are no longer referenced.
+func NewCache(msgBroker *messaging.Broker, refreshInterval time.Duration, referencedURIs func() []string, stop <-chan struct{}) *Cache {
+	c := &Cache{
+		entries:         make(map[string]*entry),
+		client:          &http.Client{Timeout: fetchTimeout},
+		msgBroker:       msgBroker,
+		refreshInterval: refreshInterval,
+		referencedURIs:  referencedURIs,
+	}
+	c.fetch = c.fetchKeySet
+
+	if refreshInterval > 0 {
+		go c.run(stop)
+	}
+
+	return c
+}
+
+// Get returns the cached key set of the given URI. A key set not cached yet is fetched in the background
+// and an error is returned until it is, an announcements.JWKSUpdated event is published once it is fetched.
+func (c *Cache) Get(uri string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	e, ok := c.entries[uri]
+	if !ok {
+		e = &entry{nextFetch: now}
+		c.entries[uri] = e
+	}
+	e.lastUsed = now
+
+	if len(e.keySet) > 0 {
+		return e.keySet, nil
+	}
+	if !e.fetching && !now.Before(e.nextFetch) {
+		e.fetching = true
+		go c.update(uri)
+	}
+	if e.err != nil {
+		return "", e.err
+	}
+	return "", fmt.Errorf("JWKS from %s is not fetched yet", uri)
+}
+
+func (c *Cache) run(stop <-chan struct{}) {
+	// the cache is checked more often than the refresh interval so that failed fetches are retried with backoff
+	ticker := time.NewTicker(min(c.refreshInterval, minRetryInterval))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			c.refresh()
+		}
+	}
+}
+
+// refresh fetches again the key sets due to be refreshed or retried in the background,
+// and prunes the key sets no longer referenced nor used for a while
+func (c *Cache) refresh() {
+	referenced := make(map[string]bool)
+	if c.referencedURIs != nil {
+		for _, uri := range c.referencedURIs() {
+			referenced[uri] = true
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for uri := range referenced {
+		// fetched ahead of being looked up, so that the proxies are configured with it at once
+		if _, ok := c.entries[uri]; !ok {
+			c.entries[uri] = &entry{nextFetch: now, lastUsed: now}
+		}
+	}
+	for uri, e := range c.entries {
+		if referenced[uri] {
+			e.lastUsed = now
+		} else if c.refreshInterval > 0 && now.Sub(e.lastUsed) > pruneAfterRefreshes*c.refreshInterval {
+			log.Debug().Msgf("Pruning JWKS from %s not referenced since %s", uri, e.lastUsed)
+			delete(c.entries, uri)
+			continue
+		}
+		if !e.fetching && !now.Before(e.nextFetch) {
+			e.fetching = true
+			go c.update(uri)
+		}
+	}
+}
+
+// update fetches the key set of the given URI and caches it, a key set failed to be fetched is kept as is
+// and fetched again after a backoff
+func (c *Cache) update(uri string) {
+	keySet, err := c.fetch(uri)
+
+	c.mu.Lock()
+	e, ok := c.entries[uri]
+	if !ok {
+		// pruned meanwhile
+		c.mu.Unlock()
+		return
+	}
+	e.fetching = false
+	now := time.Now()
+	if err != nil {
+		e.err = err
+		e.failures++
+		e.nextFetch = now.Add(c.retryInterval(e.failures))
+		c.mu.Unlock()
+		log.Warn().Err(err).Msgf("Failed to fetch JWKS from %s, retrying in %s", uri, e.nextFetch.Sub(now))
+		return
+	}
+	changed := e.keySet != keySet
+	e.keySet = keySet
+	e.err = nil
+	e.failures = 0
+	e.nextFetch = now.Add(c.refreshInterval)
+	c.mu.Unlock()
+
+	if changed && c.msgBroker != nil {
+		log.Info().Msgf("JWKS from %s is changed", uri)
+		c.msgBroker.GetQueue().AddRateLimited(events.PubSubMessage{
+			Kind:   announcements.JWKSUpdated,
+			NewObj: uri,
+		})
+	}
+}
+
+// retryInterval returns the interval to fetch a key set again after the given number of consecutive failures
+func (c *Cache) retryInterval(failures int) time.Duration {
+	interval := minRetryInterval << min(failures-1, 16)
+	if c.refreshInterval > 0 && interval > c.refreshInterval {
+		interval = c.refreshInterval
+	}
+	return interval
+}
+
+// fetchKeySet fetches the key set of the given URI and returns it in compact JSON
+func (c *Cache) fetchKeySet(uri string) (string, error) {
+	resp, err := c.client.Get(uri)
+	if err != nil {
+		return "", fmt.Errorf("error fetching JWKS from %s: %w", uri, err)
+	}
+	defer resp.Body.Close() //nolint: errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error fetching JWKS from %s: unexpected status %d", uri, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxKeySetSize))
+	if err != nil {
+		return "", fmt.Errorf("error reading JWKS from %s: %w", uri, err)
+	}
+
+	return Parse(string(body))
+}
+
+// Parse validates the given key set and returns it in compact JSON
+func Parse(keySet string) (string, error) {
+	jwks := struct {
+		Keys []map[string]interface{} `json:"keys"`
+	}{}
+	if err := json.Unmarshal([]byte(keySet), &jwks); err != nil {
+		return "", fmt.Errorf("invalid JWKS: %w", err)
+	}
+	if len(jwks.Keys) == 0 {
+		return "", fmt.Errorf("invalid JWKS: no keys")
+	}
+	for i, key := range jwks.Keys {
+		if kty, ok := key["kty"].(string); !ok || kty == "" {
+			return "", fmt.Errorf("invalid JWKS: key %d has no kty", i)
+		}
+	}
+
+	compact, err := json.Marshal(jwks)
+	if err != nil {
+		return "", fmt.Errorf("invalid JWKS: %w", err)
+	}
+
+	return string(compact), nil
+}
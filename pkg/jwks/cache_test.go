@@ -0,0 +1,193 @@
+package jwks
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+)
+
+const (
+	keySetV1 = `{"keys":[{"e":"AQAB","kid":"v1","kty":"RSA","n":"AQAB"}]}`
+	keySetV2 = `{"keys":[{"e":"AQAB","kid":"v2","kty":"RSA","n":"AQAB"}]}`
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name        string
+		keySet      string
+		expectedErr bool
+	}{
+		{
+			name:   "valid key set",
+			keySet: "{\n  \"keys\": [ {\"kty\": \"EC\", \"crv\": \"P-256\", \"x\": \"x\", \"y\": \"y\"} ]\n}",
+		},
+		{
+			name:        "invalid JSON",
+			keySet:      "keys",
+			expectedErr: true,
+		},
+		{
+			name:        "no keys",
+			keySet:      `{"keys":[]}`,
+			expectedErr: true,
+		},
+		{
+			name:        "key without kty",
+			keySet:      `{"keys":[{"kid":"v1"}]}`,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			actual, err := Parse(tc.keySet)
+			if tc.expectedErr {
+				assert.Error(err)
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(`{"keys":[{"crv":"P-256","kty":"EC","x":"x","y":"y"}]}`, actual)
+		})
+	}
+}
+
+func TestCache(t *testing.T) {
+	assert := tassert.New(t)
+
+	var keySet atomic.Value
+	keySet.Store(keySetV1)
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.URL.Path != "/jwks" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(keySet.Load().(string)))
+	}))
+	defer server.Close()
+
+	c := NewCache(nil, 0, nil, nil)
+	uri := server.URL + "/jwks"
+
+	// fetched in the background
+	_, err := c.Get(uri)
+	assert.Error(err)
+	assert.Eventually(func() bool {
+		actual, err := c.Get(uri)
+		return err == nil && actual == keySetV1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// served from the cache
+	actual, err := c.Get(uri)
+	assert.NoError(err)
+	assert.Equal(keySetV1, actual)
+	assert.Equal(int32(1), atomic.LoadInt32(&requests))
+
+	// the key set is rotated
+	keySet.Store(keySetV2)
+	c.refresh()
+	assert.Eventually(func() bool {
+		actual, err := c.Get(uri)
+		return err == nil && actual == keySetV2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the cached key set is kept if the refresh fails
+	keySet.Store("invalid")
+	c.refresh()
+	assert.Eventually(func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return c.entries[uri].err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	actual, err = c.Get(uri)
+	assert.NoError(err)
+	assert.Equal(keySetV2, actual)
+}
+
+func TestCacheFailureBackoff(t *testing.T) {
+	assert := tassert.New(t)
+
+	var requests int32
+	c := NewCache(nil, time.Hour, nil, nil)
+	c.fetch = func(uri string) (string, error) {
+		atomic.AddInt32(&requests, 1)
+		return "", fmt.Errorf("unreachable")
+	}
+
+	_, err := c.Get("https://idp/jwks")
+	assert.Error(err)
+	assert.Eventually(func() bool {
+		_, err := c.Get("https://idp/jwks")
+		return err != nil && err.Error() == "unreachable"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the failure is cached until the retry interval elapses
+	for i := 0; i < 10; i++ {
+		_, err = c.Get("https://idp/jwks")
+		assert.Error(err)
+	}
+	c.refresh()
+	assert.Equal(int32(1), atomic.LoadInt32(&requests))
+
+	c.mu.Lock()
+	assert.Equal(1, c.entries["https://idp/jwks"].failures)
+	assert.WithinDuration(time.Now().Add(minRetryInterval), c.entries["https://idp/jwks"].nextFetch, time.Second)
+	c.mu.Unlock()
+
+	assert.Equal(minRetryInterval, c.retryInterval(1))
+	assert.Equal(4*minRetryInterval, c.retryInterval(3))
+	assert.Equal(time.Hour, c.retryInterval(20))
+}
+
+func TestCachePrune(t *testing.T) {
+	assert := tassert.New(t)
+
+	var requests int32
+	c := NewCache(nil, time.Minute, func() []string {
+		return []string{"https://idp/referenced", "https://idp/not-looked-up"}
+	}, nil)
+	c.fetch = func(uri string) (string, error) {
+		atomic.AddInt32(&requests, 1)
+		return keySetV1, nil
+	}
+
+	for _, uri := range []string{"https://idp/referenced", "https://idp/unreferenced", "https://idp/recent"} {
+		uri := uri
+		assert.Eventually(func() bool {
+			_, err := c.Get(uri)
+			return err == nil
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	// the key sets are not looked up for a while, as the proxy configs are not regenerated
+	c.mu.Lock()
+	for _, uri := range []string{"https://idp/referenced", "https://idp/unreferenced"} {
+		c.entries[uri].lastUsed = time.Now().Add(-pruneAfterRefreshes * time.Minute * 2)
+		c.entries[uri].nextFetch = time.Now()
+	}
+	c.mu.Unlock()
+
+	c.refresh()
+
+	// the referenced key sets are kept and refreshed, including the ones not looked up yet
+	assert.Eventually(func() bool {
+		return atomic.LoadInt32(&requests) == 5
+	}, 5*time.Second, 10*time.Millisecond)
+	for _, uri := range []string{"https://idp/referenced", "https://idp/not-looked-up", "https://idp/recent"} {
+		assert.Eventually(func() bool {
+			keySet, err := c.Get(uri)
+			return err == nil && keySet == keySetV1
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	assert.NotContains(c.entries, "https://idp/unreferenced")
+}
@@ -8,6 +8,7 @@ import (
 
 	mapset "github.com/deckarep/golang-set"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -53,11 +54,12 @@ func newClient(informerCollection *fsminformers.InformerCollection, policyClient
 		Pods:            c.initPodMonitor,
 		Endpoints:       c.initEndpointMonitor,
 		VirtualMachine:  c.initVirtualMachineMonitor,
+		Nodes:           c.initNodeMonitor,
 	}
 
 	// If specific informers are not selected to be initialized, initialize all informers
 	if len(selectInformers) == 0 {
-		selectInformers = []InformerKey{Namespaces, Services, ServiceAccounts, Pods, Endpoints, VirtualMachine}
+		selectInformers = []InformerKey{Namespaces, Services, ServiceAccounts, Pods, Endpoints, VirtualMachine, Nodes}
 	}
 
 	for _, informer := range selectInformers {
@@ -141,6 +143,12 @@ func (c *client) initVirtualMachineMonitor() {
 	c.informers.AddEventHandler(fsminformers.InformerKeyVirtualMachine, GetEventHandlerFuncs(c.shouldObserve, podEventTypes, c.msgBroker))
 }
 
+// Initializes Node monitoring, only the changes of the topology zone of the nodes are observed
+// as the zone is used to localize the endpoints residing on the nodes
+func (c *client) initNodeMonitor() {
+	c.informers.AddEventHandler(fsminformers.InformerKeyNode, GetNodeZoneEventHandlerFuncs(c.msgBroker))
+}
+
 func (c *client) AddObserveFilter(observeFilter func(obj interface{}) bool) {
 	c.observeFilters = append(c.observeFilters, observeFilter)
 }
@@ -267,6 +275,23 @@ func (c *client) ListPods() []*corev1.Pod {
 	return pods
 }
 
+// GetPodByIP returns the pod part of the mesh with the given IP, nil if not found.
+// The IP of a terminated pod may have been reused, running pods take precedence.
+func (c *client) GetPodByIP(ip string) *corev1.Pod {
+	var found *corev1.Pod
+	for _, podInterface := range c.informers.ByIndex(fsminformers.InformerKeyPod, fsminformers.PodIPIndex, ip) {
+		pod := podInterface.(*corev1.Pod)
+		if !c.IsMonitoredNamespace(pod.Namespace) {
+			continue
+		}
+		if pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed {
+			return pod
+		}
+		found = pod
+	}
+	return found
+}
+
 // ListVms returns a list of vms part of the mesh
 // Kubecontroller does not currently segment vm notifications, hence it receives notifications
 // for all k8s vms.
@@ -714,3 +739,28 @@ func (c *client) GetTargetPortForServicePort(namespacedSvc types.NamespacedName,
 
 	return 0, fmt.Errorf("error finding port name %s for endpoint %s", portName, namespacedSvc)
 }
+
+// ListEndpointSlicesForService returns the EndpointSlices of the given service
+func (c *client) ListEndpointSlicesForService(svc service.MeshService) []*discoveryv1.EndpointSlice {
+	var endpointSlices []*discoveryv1.EndpointSlice
+
+	for _, epsIf := range c.informers.List(fsminformers.InformerKeyEndpointSlices) {
+		eps := epsIf.(*discoveryv1.EndpointSlice)
+		if eps.Namespace != svc.Namespace || eps.Labels[discoveryv1.LabelServiceName] != svc.Name {
+			continue
+		}
+		endpointSlices = append(endpointSlices, eps)
+	}
+
+	return endpointSlices
+}
+
+// GetNode returns the node with the given name if found in cache, otherwise nil
+func (c *client) GetNode(name string) *corev1.Node {
+	nodeIf, exists, err := c.informers.GetByKey(fsminformers.InformerKeyNode, name)
+	if !exists || err != nil {
+		return nil
+	}
+
+	return nodeIf.(*corev1.Node)
+}
@@ -407,6 +407,45 @@ func TestListPods(t *testing.T) {
 	}
 }
 
+func TestGetPodByIP(t *testing.T) {
+	a := tassert.New(t)
+	ic, err := informers.NewInformerCollection(testMeshName, nil, informers.WithKubeClient(testclient.NewSimpleClientset()))
+	a.Nil(err)
+	c := newClient(ic, nil, nil, nil)
+	_ = ic.Add(informers.InformerKeyNamespace, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1"}}, t)
+	_ = ic.Add(informers.InformerKeyNamespace, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns2"}}, t)
+
+	completed := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "job"},
+		Status:     corev1.PodStatus{Phase: corev1.PodSucceeded, PodIPs: []corev1.PodIP{{IP: "10.0.0.1"}}},
+	}
+	_ = ic.Add(informers.InformerKeyPod, completed, t)
+	_ = ic.Add(informers.InformerKeyPod, &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns3", Name: "unmonitored"},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIPs: []corev1.PodIP{{IP: "10.0.0.2"}}},
+	}, t)
+
+	a.Equal(completed, c.GetPodByIP("10.0.0.1"))
+	a.Nil(c.GetPodByIP("10.0.0.2"))
+	a.Nil(c.GetPodByIP("10.0.0.3"))
+
+	// the IP of the completed pod is reused by a running pod
+	running := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "s2"},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIPs: []corev1.PodIP{{IP: "10.0.0.1"}, {IP: "fd00::1"}}},
+	}
+	_ = ic.Add(informers.InformerKeyPod, running, t)
+	a.Equal(running, c.GetPodByIP("10.0.0.1"))
+	a.Equal(running, c.GetPodByIP("fd00::1"))
+
+	// the index follows the pod updates
+	moved := running.DeepCopy()
+	moved.Status.PodIPs = []corev1.PodIP{{IP: "10.0.0.4"}}
+	_ = ic.Update(informers.InformerKeyPod, moved, t)
+	a.Equal(completed, c.GetPodByIP("10.0.0.1"))
+	a.Equal(moved, c.GetPodByIP("10.0.0.4"))
+}
+
 func TestGetEndpoints(t *testing.T) {
 	testCases := []struct {
 		name      string
@@ -4,6 +4,7 @@ import (
 	"reflect"
 
 	"github.com/rs/zerolog"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/client-go/tools/cache"
 
@@ -76,6 +77,33 @@ func GetEventHandlerFuncs(shouldObserve observeFilter, eventTypes EventTypes, ms
 	}
 }
 
+// GetNodeZoneEventHandlerFuncs returns the ResourceEventHandlerFuncs object used to receive events when
+// the topology zone label of a k8s node is changed.
+func GetNodeZoneEventHandlerFuncs(msgBroker *messaging.Broker) cache.ResourceEventHandlerFuncs {
+	return cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldNode, ok := oldObj.(*corev1.Node)
+			if !ok {
+				return
+			}
+			newNode, ok := newObj.(*corev1.Node)
+			if !ok {
+				return
+			}
+			if oldNode.Labels[corev1.LabelTopologyZone] == newNode.Labels[corev1.LabelTopologyZone] {
+				return
+			}
+			logResourceEvent(log, announcements.NodeZoneUpdated, newObj)
+			metricsstore.DefaultMetricsStore.K8sAPIEventCounter.WithLabelValues(announcements.NodeZoneUpdated.String(), "").Inc()
+			msgBroker.GetQueue().AddRateLimited(events.PubSubMessage{
+				Kind:   announcements.NodeZoneUpdated,
+				NewObj: newObj,
+				OldObj: oldObj,
+			})
+		},
+	}
+}
+
 func getNamespace(obj interface{}) string {
 	return reflect.ValueOf(obj).Elem().FieldByName("ObjectMeta").FieldByName("Namespace").String()
 }
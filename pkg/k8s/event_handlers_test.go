@@ -57,3 +57,58 @@ func TestGetEventHandlers(t *testing.T) {
 		})
 	}
 }
+
+func TestGetNodeZoneEventHandlers(t *testing.T) {
+	node := func(zone string) *corev1.Node {
+		n := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n1", Labels: map[string]string{}}}
+		if len(zone) > 0 {
+			n.Labels[corev1.LabelTopologyZone] = zone
+		}
+		return n
+	}
+
+	testCases := []struct {
+		name               string
+		oldNode            *corev1.Node
+		newNode            *corev1.Node
+		expectedEventCount uint64
+	}{
+		{
+			name:               "zone unchanged",
+			oldNode:            node("zone-a"),
+			newNode:            node("zone-a"),
+			expectedEventCount: 0,
+		},
+		{
+			name:               "zone changed",
+			oldNode:            node("zone-a"),
+			newNode:            node("zone-b"),
+			expectedEventCount: 1,
+		},
+		{
+			name:               "zone label added",
+			oldNode:            node(""),
+			newNode:            node("zone-a"),
+			expectedEventCount: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			stop := make(chan struct{})
+			defer close(stop)
+			msgBroker := messaging.NewBroker(stop)
+
+			eventFuncs := GetNodeZoneEventHandlerFuncs(msgBroker)
+			eventFuncs.OnAdd(tc.newNode, false)
+			eventFuncs.OnUpdate(tc.oldNode, tc.newNode)
+			eventFuncs.OnDelete(tc.newNode)
+
+			a.Eventually(func() bool {
+				return msgBroker.GetTotalQEventCount() == tc.expectedEventCount
+			}, 1*time.Second, 10*time.Millisecond)
+		})
+	}
+}
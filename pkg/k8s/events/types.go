@@ -3,6 +3,8 @@
 package events
 
 import (
+	"time"
+
 	"github.com/flomesh-io/fsm/pkg/announcements"
 	"github.com/flomesh-io/fsm/pkg/logger"
 )
@@ -33,4 +35,8 @@ type PubSubMessage struct {
 	Kind   announcements.Kind
 	OldObj interface{}
 	NewObj interface{}
+
+	// Timestamp is the time the message was first processed by the message broker,
+	// used to measure the propagation latency of proxy updates
+	Timestamp time.Time
 }
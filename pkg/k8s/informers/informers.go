@@ -17,6 +17,7 @@ import (
 	smiTrafficSpecInformers "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/specs/informers/externalversions"
 	smiTrafficSplitClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
 	smiTrafficSplitInformers "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/informers/externalversions"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/client-go/informers"
@@ -93,12 +94,13 @@ func WithKubeClient(kubeClient kubernetes.Interface) InformerCollectionOption {
 		ic.informers[InformerKeyNamespace] = nsInformerFactory.Core().V1().Namespaces().Informer()
 		ic.informers[InformerKeyService] = v1api.Services().Informer()
 		ic.informers[InformerKeyServiceAccount] = v1api.ServiceAccounts().Informer()
-		ic.informers[InformerKeyPod] = v1api.Pods().Informer()
+		ic.informers[InformerKeyPod] = newPodInformer(v1api.Pods().Informer())
 		ic.informers[InformerKeyEndpoints] = v1api.Endpoints().Informer()
 		ic.informers[InformerKeyK8sIngressClass] = informerFactory.Networking().V1().IngressClasses().Informer()
 		ic.informers[InformerKeyK8sIngress] = informerFactory.Networking().V1().Ingresses().Informer()
 		ic.informers[InformerKeySecret] = v1api.Secrets().Informer()
 		ic.informers[InformerKeyConfigMap] = v1api.ConfigMaps().Informer()
+		ic.informers[InformerKeyNode] = v1api.Nodes().Informer()
 		ic.informers[InformerKeyNamespaceAll] = v1api.Namespaces().Informer()
 
 		if version.IsEndpointSliceEnabled(kubeClient) {
@@ -114,12 +116,13 @@ func WithKubeClientWithoutNamespace(kubeClient kubernetes.Interface) InformerCol
 		v1api := informerFactory.Core().V1()
 		ic.informers[InformerKeyService] = v1api.Services().Informer()
 		ic.informers[InformerKeyServiceAccount] = v1api.ServiceAccounts().Informer()
-		ic.informers[InformerKeyPod] = v1api.Pods().Informer()
+		ic.informers[InformerKeyPod] = newPodInformer(v1api.Pods().Informer())
 		ic.informers[InformerKeyEndpoints] = v1api.Endpoints().Informer()
 		ic.informers[InformerKeyK8sIngressClass] = informerFactory.Networking().V1().IngressClasses().Informer()
 		ic.informers[InformerKeyK8sIngress] = informerFactory.Networking().V1().Ingresses().Informer()
 		ic.informers[InformerKeySecret] = v1api.Secrets().Informer()
 		ic.informers[InformerKeyConfigMap] = v1api.ConfigMaps().Informer()
+		ic.informers[InformerKeyNode] = v1api.Nodes().Informer()
 
 		if version.IsEndpointSliceEnabled(kubeClient) {
 			ic.informers[InformerKeyEndpointSlices] = informerFactory.Discovery().V1().EndpointSlices().Informer()
@@ -127,6 +130,27 @@ func WithKubeClientWithoutNamespace(kubeClient kubernetes.Interface) InformerCol
 	}
 }
 
+// newPodInformer adds the indexers of the Pod informer
+func newPodInformer(informer cache.SharedIndexInformer) cache.SharedIndexInformer {
+	if err := informer.AddIndexers(cache.Indexers{PodIPIndex: podIPIndexFunc}); err != nil {
+		log.Error().Err(err).Msgf("Error adding indexer %s to the Pod informer", PodIPIndex)
+	}
+	return informer
+}
+
+// podIPIndexFunc indexes the pods by their IPs
+func podIPIndexFunc(obj interface{}) ([]string, error) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return nil, nil
+	}
+	ips := make([]string, 0, len(pod.Status.PodIPs))
+	for _, podIP := range pod.Status.PodIPs {
+		ips = append(ips, podIP.IP)
+	}
+	return ips, nil
+}
+
 // WithSMIClients sets the SMI clients for the InformerCollection
 func WithSMIClients(smiTrafficSplitClient smiTrafficSplitClient.Interface, smiTrafficSpecClient smiTrafficSpecClient.Interface, smiAccessClient smiTrafficAccessClient.Interface) InformerCollectionOption {
 	return func(ic *InformerCollection) {
@@ -169,6 +193,10 @@ func WithPolicyClient(policyClient policyClientset.Interface) InformerCollection
 		ic.informers[InformerKeyAccessControl] = informerFactory.Policy().V1alpha1().AccessControls().Informer()
 		ic.informers[InformerKeyAccessCert] = informerFactory.Policy().V1alpha1().AccessCerts().Informer()
 		ic.informers[InformerKeyTrafficWarmup] = informerFactory.Policy().V1alpha1().TrafficWarmups().Informer()
+		ic.informers[InformerKeyRequestAuthentication] = informerFactory.Policy().V1alpha1().RequestAuthentications().Informer()
+		ic.informers[InformerKeyAuthorizationPolicy] = informerFactory.Policy().V1alpha1().AuthorizationPolicies().Informer()
+		ic.informers[InformerKeySidecarScope] = informerFactory.Policy().V1alpha1().SidecarScopes().Informer()
+		ic.informers[InformerKeyMeshFaultInjection] = informerFactory.Policy().V1alpha1().MeshFaultInjections().Informer()
 	}
 }
 
@@ -377,6 +405,22 @@ func (ic *InformerCollection) List(informerKey InformerKey) []interface{} {
 	return informer.GetStore().List()
 }
 
+// ByIndex returns the contents of the store of the informer indexed by the given InformerKey
+// whose indexed values of the given index include the given value
+func (ic *InformerCollection) ByIndex(informerKey InformerKey, indexName, indexedValue string) []interface{} {
+	informer, ok := ic.informers[informerKey]
+	if !ok {
+		return nil
+	}
+
+	objs, err := informer.GetIndexer().ByIndex(indexName, indexedValue)
+	if err != nil {
+		log.Error().Err(err).Msgf("Error listing %s by index %s", informerKey, indexName)
+		return nil
+	}
+	return objs
+}
+
 // IsMonitoredNamespace returns a boolean indicating if the namespace is among the list of monitored namespaces
 func (ic *InformerCollection) IsMonitoredNamespace(namespace string) bool {
 	_, exists, _ := ic.informers[InformerKeyNamespace].GetStore().GetByKey(namespace)
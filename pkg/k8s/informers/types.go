@@ -23,6 +23,8 @@ const (
 	InformerKeyEndpoints InformerKey = "Endpoints"
 	// InformerKeyEndpointSlices is the InformerKey for a EndpointSlices informer
 	InformerKeyEndpointSlices InformerKey = "EndpointSlices"
+	// InformerKeyNode is the InformerKey for a Node informer
+	InformerKeyNode InformerKey = "Node"
 	// InformerKeyServiceAccount is the InformerKey for a ServiceAccount informer
 	InformerKeyServiceAccount InformerKey = "ServiceAccount"
 	// InformerKeySecret is the InformerKey for a Secret informer
@@ -62,6 +64,15 @@ const (
 	InformerKeyAccessCert InformerKey = "AccessCert"
 	// InformerKeyTrafficWarmup is the InformerKey for a TrafficWarmup informer
 	InformerKeyTrafficWarmup InformerKey = "TrafficWarmup"
+	// InformerKeyRequestAuthentication is the InformerKey for a RequestAuthentication informer
+	InformerKeyRequestAuthentication InformerKey = "RequestAuthentication"
+
+	// InformerKeyAuthorizationPolicy is the InformerKey for a AuthorizationPolicy informer
+	InformerKeyAuthorizationPolicy InformerKey = "AuthorizationPolicy"
+	// InformerKeySidecarScope is the InformerKey for a SidecarScope informer
+	InformerKeySidecarScope InformerKey = "SidecarScope"
+	// InformerKeyMeshFaultInjection is the InformerKey for a MeshFaultInjection informer
+	InformerKeyMeshFaultInjection InformerKey = "MeshFaultInjection"
 	// InformerKeyServiceImport is the InformerKey for a ServiceImport informer
 	InformerKeyServiceImport InformerKey = "ServiceImport"
 	// InformerKeyServiceExport is the InformerKey for a ServiceExport informer
@@ -163,6 +174,8 @@ const (
 	InformerKeyGatewayExternalRateLimit InformerKey = "Gateway-ExternalRateLimit"
 	// InformerKeyGatewayDNSModifier is the InformerKey for a DNSModifier informer
 	InformerKeyGatewayDNSModifier InformerKey = "Gateway-DNSModifier"
+	// InformerKeyGatewayJWTAuth is the InformerKey for a JWTAuth informer
+	InformerKeyGatewayJWTAuth InformerKey = "Gateway-JWTAuth"
 
 	// InformerKeyXNetworkAccessControl is the InformerKey for a XNetwork AccessControl informer
 	InformerKeyXNetworkAccessControl InformerKey = "XNetwork-AccessControl"
@@ -178,6 +191,11 @@ const (
 	DefaultKubeEventResyncInterval = 0 * time.Second
 )
 
+const (
+	// PodIPIndex is the name of the index of the Pod informer keyed by the pod IPs
+	PodIPIndex = "podIP"
+)
+
 var (
 	errInitInformers = errors.New("informer not initialized")
 	errSyncingCaches = errors.New("failed initial cache sync for informers")
@@ -300,4 +318,7 @@ const (
 
 	// DNSModifierResourceType is the type used to represent the dns modifier resource
 	DNSModifierResourceType ResourceType = "dnsmodifiers"
+
+	// JWTAuthResourceType is the type used to represent the jwt auth resource
+	JWTAuthResourceType ResourceType = "jwtauths"
 )
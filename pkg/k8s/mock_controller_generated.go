@@ -13,6 +13,7 @@ import (
 	service "github.com/flomesh-io/fsm/pkg/service"
 	gomock "github.com/golang/mock/gomock"
 	v1 "k8s.io/api/core/v1"
+	v11 "k8s.io/api/discovery/v1"
 	v10 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 )
@@ -95,6 +96,34 @@ func (mr *MockControllerMockRecorder) GetNamespace(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespace", reflect.TypeOf((*MockController)(nil).GetNamespace), arg0)
 }
 
+// GetNode mocks base method.
+func (m *MockController) GetNode(arg0 string) *v1.Node {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNode", arg0)
+	ret0, _ := ret[0].(*v1.Node)
+	return ret0
+}
+
+// GetNode indicates an expected call of GetNode.
+func (mr *MockControllerMockRecorder) GetNode(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNode", reflect.TypeOf((*MockController)(nil).GetNode), arg0)
+}
+
+// GetPodByIP mocks base method.
+func (m *MockController) GetPodByIP(arg0 string) *v1.Pod {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPodByIP", arg0)
+	ret0, _ := ret[0].(*v1.Pod)
+	return ret0
+}
+
+// GetPodByIP indicates an expected call of GetPodByIP.
+func (mr *MockControllerMockRecorder) GetPodByIP(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodByIP", reflect.TypeOf((*MockController)(nil).GetPodByIP), arg0)
+}
+
 // GetPodForProxy mocks base method.
 func (m *MockController) GetPodForProxy(arg0 models.Proxy) (*v1.Pod, error) {
 	m.ctrl.T.Helper()
@@ -168,6 +197,20 @@ func (mr *MockControllerMockRecorder) IsMonitoredNamespace(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsMonitoredNamespace", reflect.TypeOf((*MockController)(nil).IsMonitoredNamespace), arg0)
 }
 
+// ListEndpointSlicesForService mocks base method.
+func (m *MockController) ListEndpointSlicesForService(arg0 service.MeshService) []*v11.EndpointSlice {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEndpointSlicesForService", arg0)
+	ret0, _ := ret[0].([]*v11.EndpointSlice)
+	return ret0
+}
+
+// ListEndpointSlicesForService indicates an expected call of ListEndpointSlicesForService.
+func (mr *MockControllerMockRecorder) ListEndpointSlicesForService(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEndpointSlicesForService", reflect.TypeOf((*MockController)(nil).ListEndpointSlicesForService), arg0)
+}
+
 // ListMonitoredNamespaces mocks base method.
 func (m *MockController) ListMonitoredNamespaces() ([]string, error) {
 	m.ctrl.T.Helper()
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -68,6 +69,8 @@ const (
 	ServiceAccounts InformerKey = "ServiceAccounts"
 	// EndpointSlices lookup identifier
 	EndpointSlices InformerKey = "EndpointSlices"
+	// Nodes lookup identifier
+	Nodes InformerKey = "Nodes"
 )
 
 // client is the type used to represent the k8s client for the native k8s resources
@@ -110,6 +113,9 @@ type Controller interface {
 	// ListPods returns a list of pods part of the mesh
 	ListPods() []*corev1.Pod
 
+	// GetPodByIP returns the pod part of the mesh with the given IP, nil if not found
+	GetPodByIP(ip string) *corev1.Pod
+
 	// ListVms returns a list of vms part of the mesh
 	ListVms() []*machinev1alpha1.VirtualMachine
 
@@ -130,4 +136,10 @@ type Controller interface {
 	GetVmForProxy(models.Proxy) (*machinev1alpha1.VirtualMachine, error)
 
 	GetTargetPortForServicePort(types.NamespacedName, uint16) (uint16, error)
+
+	// ListEndpointSlicesForService returns the EndpointSlices of the given service
+	ListEndpointSlicesForService(service.MeshService) []*discoveryv1.EndpointSlice
+
+	// GetNode returns the node with the given name if found in cache, otherwise nil
+	GetNode(string) *corev1.Node
 }
@@ -38,6 +38,11 @@ func Add(key string, value interface{}) bool {
 	return lruCache.Add(key, value)
 }
 
+// New creates a size bounded LRU cache whose entries are purged after ttl
+func New[V any](size int, ttl time.Duration) *expirable.LRU[string, V] {
+	return expirable.NewLRU[string, V](size, nil, ttl)
+}
+
 func MicroSvcMetaExists(svc *corev1.Service) bool {
 	hash := svc.Annotations[constants.AnnotationMeshEndpointHash]
 	key := fmt.Sprintf("%s.%s.%s", svc.Namespace, svc.Name, hash)
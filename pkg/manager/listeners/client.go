@@ -265,6 +265,16 @@ func (c *client) GetConfigResyncInterval() time.Duration {
 	panic("implement me")
 }
 
+func (c *client) GetConfigUpdateDebounceWindow() time.Duration {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (c *client) GetConfigUpdateMaxDelay() time.Duration {
+	//TODO implement me
+	panic("implement me")
+}
+
 func (c *client) GetProxyResources() corev1.ResourceRequirements {
 	//TODO implement me
 	panic("implement me")
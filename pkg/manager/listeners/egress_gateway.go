@@ -0,0 +1,276 @@
+package listeners
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/rs/zerolog/log"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/flomesh-io/fsm/pkg/announcements"
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/constants"
+	fsminformers "github.com/flomesh-io/fsm/pkg/k8s/informers"
+	"github.com/flomesh-io/fsm/pkg/messaging"
+	"github.com/flomesh-io/fsm/pkg/policy"
+)
+
+// egressGatewayRuleSet is the host rules rendered for the egress gateways, keyed by the namespaced name of the gateway service
+type egressGatewayRuleSet struct {
+	Gateways map[string]*egressGatewayRules `json:"Gateways"`
+}
+
+// egressGatewayRules is the host rules enforced by an egress gateway
+type egressGatewayRules struct {
+	Enforce bool                    `json:"Enforce"`
+	Hosts   []egressGatewayHostRule `json:"Hosts"`
+}
+
+type egressGatewayHostRule struct {
+	Host          string                      `json:"Host"`
+	Ports         []int32                     `json:"Ports,omitempty"`
+	TLS           *egressGatewayTLS           `json:"TLS,omitempty"`
+	UpstreamProxy *egressGatewayUpstreamProxy `json:"UpstreamProxy,omitempty"`
+}
+
+type egressGatewayTLS struct {
+	SNI                string `json:"SNI,omitempty"`
+	Port               *int32 `json:"Port,omitempty"`
+	CA                 string `json:"CA,omitempty"`
+	InsecureSkipVerify bool   `json:"InsecureSkipVerify,omitempty"`
+}
+
+type egressGatewayUpstreamProxy struct {
+	Address       string `json:"Address"`
+	Authorization string `json:"Authorization,omitempty"`
+}
+
+// WatchAndUpdateEgressGatewayRules watches for EgressGateway policies and the secrets they reference, renders their host rules into the secret mounted by egress gateway
+func WatchAndUpdateEgressGatewayRules(kubeClient kubernetes.Interface, informerCollection *fsminformers.InformerCollection, msgBroker *messaging.Broker, policyController policy.Controller, fsmNamespace string, stop <-chan struct{}) {
+	kubePubSub := msgBroker.GetKubeEventPubSub()
+	egressGatewayChan := kubePubSub.Sub(
+		announcements.EgressGatewayAdded.String(),
+		announcements.EgressGatewayUpdated.String(),
+		announcements.EgressGatewayDeleted.String(),
+	)
+	defer msgBroker.Unsub(kubePubSub, egressGatewayChan)
+
+	secretChan := make(chan struct{}, 1)
+	informerCollection.AddEventHandler(fsminformers.InformerKeySecret, getReferencedSecretEventHandlerFuncs(policyController, secretChan))
+
+	updateEgressGatewayRules(kubeClient, policyController, fsmNamespace)
+
+	for {
+		select {
+		case <-stop:
+			log.Info().Msg("Received stop signal, exiting egress gateway rules update routine")
+			return
+
+		case <-egressGatewayChan:
+			log.Info().Msgf("Updating egress gateway rules ...")
+			updateEgressGatewayRules(kubeClient, policyController, fsmNamespace)
+
+		case <-secretChan:
+			log.Info().Msgf("Updating egress gateway rules on referenced secret changes ...")
+			updateEgressGatewayRules(kubeClient, policyController, fsmNamespace)
+		}
+	}
+}
+
+// getReferencedSecretEventHandlerFuncs returns the event handlers notifying secretChan when a secret referenced by any EgressGateway is changed
+func getReferencedSecretEventHandlerFuncs(policyController policy.Controller, secretChan chan<- struct{}) cache.ResourceEventHandlerFuncs {
+	notify := func(obj interface{}) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		secret, ok := obj.(*corev1.Secret)
+		if !ok || !isReferencedByEgressGateways(policyController.ListEgressGateways(), secret) {
+			return
+		}
+
+		// coalesce the notifications, the rules are rendered from the latest secrets anyway
+		select {
+		case secretChan <- struct{}{}:
+		default:
+		}
+	}
+
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc:    notify,
+		UpdateFunc: func(_, newObj interface{}) { notify(newObj) },
+		DeleteFunc: notify,
+	}
+}
+
+// isReferencedByEgressGateways returns true if the secret is referenced by the host rules of any EgressGateway
+func isReferencedByEgressGateways(egressGateways []*policyv1alpha1.EgressGateway, secret *corev1.Secret) bool {
+	for _, egressGateway := range egressGateways {
+		if egressGateway.Namespace != secret.Namespace {
+			continue
+		}
+		for _, hostRule := range egressGateway.Spec.Hosts {
+			if hostRule.TLS != nil && hostRule.TLS.CACertificate != nil && hostRule.TLS.CACertificate.Name == secret.Name {
+				return true
+			}
+			if hostRule.UpstreamProxy != nil && hostRule.UpstreamProxy.CredentialsSecret != nil && hostRule.UpstreamProxy.CredentialsSecret.Name == secret.Name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func updateEgressGatewayRules(kubeClient kubernetes.Interface, policyController policy.Controller, fsmNamespace string) {
+	rules := buildEgressGatewayRules(kubeClient, policyController.ListEgressGateways())
+
+	bytes, err := json.Marshal(rules)
+	if err != nil {
+		log.Error().Msgf("Failed to marshal egress gateway rules: %s", err)
+		return
+	}
+
+	data := map[string][]byte{constants.EgressGatewayRulesSecretKey: bytes}
+	secrets := kubeClient.CoreV1().Secrets(fsmNamespace)
+
+	secret, err := secrets.Get(context.TODO(), constants.EgressGatewayRulesSecretName, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.Error().Msgf("Failed to get secret %s/%s: %s", fsmNamespace, constants.EgressGatewayRulesSecretName, err)
+			return
+		}
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      constants.EgressGatewayRulesSecretName,
+				Namespace: fsmNamespace,
+				Labels: map[string]string{
+					constants.AppLabel: "fsm-egress-gateway",
+				},
+			},
+			Data: data,
+		}
+		if _, err := secrets.Create(context.TODO(), secret, metav1.CreateOptions{}); err != nil {
+			log.Error().Msgf("Failed to create secret %s/%s: %s", fsmNamespace, constants.EgressGatewayRulesSecretName, err)
+		}
+		return
+	}
+
+	if reflect.DeepEqual(secret.Data, data) {
+		return
+	}
+
+	secret = secret.DeepCopy()
+	secret.Data = data
+	if _, err := secrets.Update(context.TODO(), secret, metav1.UpdateOptions{}); err != nil {
+		log.Error().Msgf("Failed to update secret %s/%s: %s", fsmNamespace, constants.EgressGatewayRulesSecretName, err)
+	}
+}
+
+// buildEgressGatewayRules merges the host rules of all EgressGateway policies per gateway service they bind,
+// a rule referencing a missing secret is dropped so the destination keeps being denied
+func buildEgressGatewayRules(kubeClient kubernetes.Interface, egressGateways []*policyv1alpha1.EgressGateway) *egressGatewayRuleSet {
+	sort.Slice(egressGateways, func(i, j int) bool {
+		if egressGateways[i].Namespace != egressGateways[j].Namespace {
+			return egressGateways[i].Namespace < egressGateways[j].Namespace
+		}
+		return egressGateways[i].Name < egressGateways[j].Name
+	})
+
+	ruleSet := &egressGatewayRuleSet{Gateways: map[string]*egressGatewayRules{}}
+	for _, egressGateway := range egressGateways {
+		hostRules := buildEgressGatewayHostRules(kubeClient, egressGateway)
+
+		for _, gateway := range egressGateway.Spec.GlobalEgressGateways {
+			key := fmt.Sprintf("%s/%s", gateway.Namespace, gateway.Service)
+			rules, ok := ruleSet.Gateways[key]
+			if !ok {
+				rules = &egressGatewayRules{Hosts: []egressGatewayHostRule{}}
+				ruleSet.Gateways[key] = rules
+			}
+
+			if len(egressGateway.Spec.Hosts) > 0 {
+				rules.Enforce = true
+			}
+			rules.Hosts = append(rules.Hosts, hostRules...)
+		}
+	}
+
+	return ruleSet
+}
+
+func buildEgressGatewayHostRules(kubeClient kubernetes.Interface, egressGateway *policyv1alpha1.EgressGateway) []egressGatewayHostRule {
+	var hostRules []egressGatewayHostRule
+	for _, hostRule := range egressGateway.Spec.Hosts {
+		rule := egressGatewayHostRule{
+			Host:  hostRule.Host,
+			Ports: hostRule.Ports,
+		}
+
+		if hostRule.TLS != nil {
+			rule.TLS = &egressGatewayTLS{
+				SNI:                hostRule.TLS.SNI,
+				Port:               hostRule.TLS.Port,
+				InsecureSkipVerify: hostRule.TLS.InsecureSkipVerify,
+			}
+			if ref := hostRule.TLS.CACertificate; ref != nil {
+				ca, err := getSecretValue(kubeClient, ref, egressGateway.Namespace, "ca.crt")
+				if err != nil {
+					log.Error().Msgf("Ignored host rule %s of EgressGateway %s/%s: %s", hostRule.Host, egressGateway.Namespace, egressGateway.Name, err)
+					continue
+				}
+				rule.TLS.CA = string(ca)
+			}
+		}
+
+		if hostRule.UpstreamProxy != nil {
+			rule.UpstreamProxy = &egressGatewayUpstreamProxy{
+				Address: hostRule.UpstreamProxy.Address,
+			}
+			if ref := hostRule.UpstreamProxy.CredentialsSecret; ref != nil {
+				username, err := getSecretValue(kubeClient, ref, egressGateway.Namespace, "username")
+				if err != nil {
+					log.Error().Msgf("Ignored host rule %s of EgressGateway %s/%s: %s", hostRule.Host, egressGateway.Namespace, egressGateway.Name, err)
+					continue
+				}
+				password, err := getSecretValue(kubeClient, ref, egressGateway.Namespace, "password")
+				if err != nil {
+					log.Error().Msgf("Ignored host rule %s of EgressGateway %s/%s: %s", hostRule.Host, egressGateway.Namespace, egressGateway.Name, err)
+					continue
+				}
+				rule.UpstreamProxy.Authorization = "Basic " + base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", username, password)))
+			}
+		}
+
+		hostRules = append(hostRules, rule)
+	}
+
+	return hostRules
+}
+
+// getSecretValue returns the value of the key in the referenced secret, which is resolved only in the namespace of the EgressGateway
+func getSecretValue(kubeClient kubernetes.Interface, ref *corev1.SecretReference, namespace, key string) ([]byte, error) {
+	if len(ref.Namespace) > 0 && ref.Namespace != namespace {
+		return nil, fmt.Errorf("secret %s/%s is not in namespace %s", ref.Namespace, ref.Name, namespace)
+	}
+
+	secret, err := kubeClient.CoreV1().Secrets(namespace).Get(context.TODO(), ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := secret.Data[key]
+	if !ok || len(value) == 0 {
+		return nil, fmt.Errorf("secret %s/%s has no %s", namespace, ref.Name, key)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,92 @@
+package listeners
+
+import (
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+)
+
+func TestBuildEgressGatewayRules(t *testing.T) {
+	assert := tassert.New(t)
+
+	kubeClient := fake.NewSimpleClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "ca"},
+			Data:       map[string][]byte{"ca.crt": []byte("ca1")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "ca"},
+			Data:       map[string][]byte{"ca.crt": []byte("ca2")},
+		},
+	)
+
+	egressGateways := []*policyv1alpha1.EgressGateway{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "eg1"},
+			Spec: policyv1alpha1.EgressGatewaySpec{
+				GlobalEgressGateways: []policyv1alpha1.GatewayBindingSubject{{Namespace: "fsm", Service: "gw1"}},
+				Hosts: []policyv1alpha1.EgressGatewayHostRule{
+					{
+						Host: "a.example.com",
+						TLS:  &policyv1alpha1.EgressGatewayTLSOrigination{CACertificate: &corev1.SecretReference{Name: "ca"}},
+					},
+					{
+						// the secret of another namespace is never resolved
+						Host: "b.example.com",
+						TLS:  &policyv1alpha1.EgressGatewayTLSOrigination{CACertificate: &corev1.SecretReference{Namespace: "ns2", Name: "ca"}},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "eg2"},
+			Spec: policyv1alpha1.EgressGatewaySpec{
+				GlobalEgressGateways: []policyv1alpha1.GatewayBindingSubject{{Namespace: "fsm", Service: "gw2"}},
+			},
+		},
+	}
+
+	ruleSet := buildEgressGatewayRules(kubeClient, egressGateways)
+	assert.Len(ruleSet.Gateways, 2)
+
+	gw1 := ruleSet.Gateways["fsm/gw1"]
+	if assert.NotNil(gw1) && assert.Len(gw1.Hosts, 1) {
+		assert.True(gw1.Enforce)
+		assert.Equal("a.example.com", gw1.Hosts[0].Host)
+		assert.Equal("ca1", gw1.Hosts[0].TLS.CA)
+	}
+
+	// a gateway bound by policies without host rules is not enforced
+	gw2 := ruleSet.Gateways["fsm/gw2"]
+	if assert.NotNil(gw2) {
+		assert.False(gw2.Enforce)
+		assert.Empty(gw2.Hosts)
+	}
+}
+
+func TestIsReferencedByEgressGateways(t *testing.T) {
+	assert := tassert.New(t)
+
+	egressGateways := []*policyv1alpha1.EgressGateway{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "eg1"},
+			Spec: policyv1alpha1.EgressGatewaySpec{
+				Hosts: []policyv1alpha1.EgressGatewayHostRule{
+					{
+						Host:          "a.example.com",
+						UpstreamProxy: &policyv1alpha1.EgressGatewayUpstreamProxy{CredentialsSecret: &corev1.SecretReference{Name: "creds"}},
+					},
+				},
+			},
+		},
+	}
+
+	assert.True(isReferencedByEgressGateways(egressGateways, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "creds"}}))
+	assert.False(isReferencedByEgressGateways(egressGateways, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "creds"}}))
+	assert.False(isReferencedByEgressGateways(egressGateways, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "other"}}))
+}
@@ -242,7 +242,9 @@ func getRegisters(regCfg *whtypes.RegisterConfig, mc configurator.Configurator)
 
 	// no reconcilers
 	webhooks[MCSServiceImport] = mcswhv1alpha1.NewServiceImportWebhook(regCfg)
+
 	webhooks[MCSGlobalTrafficPolicy] = mcswhv1alpha1.NewGlobalTrafficPolicyWebhook(regCfg)
+	reconcilers[MCSGlobalTrafficPolicy] = mcsv1alpha1.NewGlobalTrafficPolicyReconciler(ctx)
 
 	// Connectors, no webhooks
 	reconcilers[ConnectorConsulConnector] = ctv1.NewConsulConnectorReconciler(ctx)
@@ -335,6 +337,9 @@ func getRegisters(regCfg *whtypes.RegisterConfig, mc configurator.Configurator)
 
 		webhooks[GatewayAPIExtensionDNSModifier] = extwhv1alpha1.NewDNSModifierWebhook(regCfg)
 		reconcilers[GatewayAPIExtensionDNSModifier] = extensionv1alpha1.NewDNSModifierReconciler(ctx, webhooks[GatewayAPIExtensionDNSModifier])
+
+		webhooks[GatewayAPIExtensionJWTAuth] = extwhv1alpha1.NewJWTAuthWebhook(regCfg)
+		reconcilers[GatewayAPIExtensionJWTAuth] = extensionv1alpha1.NewJWTAuthReconciler(ctx, webhooks[GatewayAPIExtensionJWTAuth])
 	}
 
 	if mc.IsServiceLBEnabled() {
@@ -354,6 +359,9 @@ func getRegisters(regCfg *whtypes.RegisterConfig, mc configurator.Configurator)
 
 		webhooks[FLBTLSSecret] = flbwh.NewTLSSecretWebhook(regCfg)
 		reconcilers[FLBTLSSecret] = flb.NewSecretReconciler(ctx, webhooks[FLBTLSSecret], settingManager)
+
+		webhooks[FLBPolicy] = flbwh.NewPolicyWebhook(regCfg)
+		reconcilers[FLBPolicy] = flb.NewPolicyReconciler(ctx, webhooks[FLBPolicy])
 	}
 
 	return webhooks, reconcilers
@@ -38,6 +38,7 @@ const (
 	GatewayAPIExtensionRequestTermination ResourceType = "GatewayAPIExtension(RequestTermination)"
 	GatewayAPIExtensionConcurrencyLimit   ResourceType = "GatewayAPIExtension(ConcurrencyLimit)"
 	GatewayAPIExtensionDNSModifier        ResourceType = "GatewayAPIExtension(DNSModifier)"
+	GatewayAPIExtensionJWTAuth            ResourceType = "GatewayAPIExtension(JWTAuth)"
 	PolicyAttachmentHealthCheck           ResourceType = "PolicyAttachment(HealthCheck)"
 	PolicyAttachmentBackendLB             ResourceType = "PolicyAttachment(BackendLB)"
 	PolicyAttachmentBackendTLS            ResourceType = "PolicyAttachment(BackendTLS)"
@@ -55,4 +56,5 @@ const (
 	FLBService                            ResourceType = "FLB(Service)"
 	FLBSecret                             ResourceType = "FLB(Secret)"
 	FLBTLSSecret                          ResourceType = "FLB(TLSSecret)"
+	FLBPolicy                             ResourceType = "FLB(FLBPolicy)"
 )
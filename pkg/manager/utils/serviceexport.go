@@ -0,0 +1,53 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) since 2021,  flomesh.io Authors.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package utils
+
+import (
+	"github.com/tidwall/sjson"
+
+	"github.com/flomesh-io/fsm/pkg/repo"
+)
+
+// UpdateServiceExportRoutes updates the routes of the services exported as GRPC or TCP, which
+// are routed by SNI through the TLS port of ingress controller, routes are keyed by the SNI
+// and valued by the address of the exported service
+func UpdateServiceExportRoutes(basepath string, repoClient *repo.PipyRepoClient, routes map[string]string) error {
+	json, err := getMainJSON(basepath, repoClient)
+	if err != nil {
+		return err
+	}
+
+	newJSON, err := sjson.Set(json, "serviceExports", routes)
+	if err != nil {
+		log.Error().Msgf("Failed to update serviceExports: %s", err)
+		return err
+	}
+
+	if newJSON == json {
+		return nil
+	}
+
+	return updateMainJSON(basepath, repoClient, newJSON)
+}
@@ -45,13 +45,14 @@ type ConnectorConfig struct {
 	gatewayHost     string
 	gatewayIP       net.IP
 	gatewayPort     int32
+	gatewayTLSPort  int32
 	controlPlaneUID string
 }
 
 // NewConnectorConfig creates a new ConnectorConfig
 func NewConnectorConfig(
 	region, zone, group, name, gatewayHost string,
-	gatewayPort int32,
+	gatewayPort, gatewayTLSPort int32,
 	controlPlaneUID string,
 ) (*ConnectorConfig, error) {
 	clusterKey := utils.EvaluateTemplate(constants.ClusterIDTemplate, struct {
@@ -111,6 +112,7 @@ func NewConnectorConfig(
 
 	c.gatewayHost = gatewayHost
 	c.gatewayPort = gatewayPort
+	c.gatewayTLSPort = gatewayTLSPort
 	c.gatewayIP = gwIP
 	//}
 
@@ -170,6 +172,11 @@ func (c *ConnectorConfig) GatewayPort() int32 {
 	return c.gatewayPort
 }
 
+// GatewayTLSPort returns the gateway TLS port of the connected cluster
+func (c *ConnectorConfig) GatewayTLSPort() int32 {
+	return c.gatewayTLSPort
+}
+
 // ControlPlaneUID returns the control plane UID of the connected cluster
 func (c *ConnectorConfig) ControlPlaneUID() string {
 	return c.controlPlaneUID
@@ -153,3 +153,22 @@ func (s *ControlPlaneServer) DestroyBackground(key string) {
 	close(bg.Context.StopCh)
 	delete(s.backgrounds, key)
 }
+
+// WithdrawClusterEndpoints removes the endpoints exported by the given cluster from the ServiceImports
+// of all the other clusters
+func (s *ControlPlaneServer) WithdrawClusterEndpoints(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for bgKey, bg := range s.backgrounds {
+		if bgKey == key {
+			continue
+		}
+
+		if err := bg.Connector.WithdrawEndpoints(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
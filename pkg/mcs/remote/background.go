@@ -51,15 +51,13 @@ func NewBackground(cluster *mcsv1alpha1.Cluster, kubeconfig *rest.Config, mc con
 func clusterHash(cluster *mcsv1alpha1.Cluster) string {
 	return utils.SimpleHash(
 		struct {
-			spec            mcsv1alpha1.ClusterSpec
-			resourceVersion string
-			generation      int64
-			uuid            string
+			spec       mcsv1alpha1.ClusterSpec
+			generation int64
+			uuid       string
 		}{
-			spec:            cluster.Spec,
-			resourceVersion: cluster.ResourceVersion,
-			generation:      cluster.Generation,
-			uuid:            string(cluster.UID),
+			spec:       cluster.Spec,
+			generation: cluster.Generation,
+			uuid:       string(cluster.UID),
 		},
 	)
 }
@@ -72,6 +70,7 @@ func connectorConfig(cluster *mcsv1alpha1.Cluster, mc configurator.Configurator)
 		cluster.Name,
 		cluster.Spec.GatewayHost,
 		cluster.Spec.GatewayPort,
+		cluster.Spec.GatewayTLSPort,
 		mc.GetClusterUID(),
 	)
 }
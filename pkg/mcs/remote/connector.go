@@ -27,7 +27,6 @@ package remote
 import (
 	"context"
 	"fmt"
-	"net"
 	"reflect"
 	"time"
 
@@ -36,10 +35,12 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metautil "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/flomesh-io/fsm/pkg/announcements"
 	mcsv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/multicluster/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/constants"
 	"github.com/flomesh-io/fsm/pkg/k8s/events"
 	conn "github.com/flomesh-io/fsm/pkg/mcs/context"
 	mcsevent "github.com/flomesh-io/fsm/pkg/mcs/event"
@@ -248,7 +249,7 @@ func (c *Connector) upsertServiceImport(export *mcsevent.ServiceExportEvent) err
 		if len(p.Endpoints) == 0 {
 			for _, r := range svcExp.Spec.Rules {
 				if r.PortNumber == p.Port {
-					ep := newEndpoint(export, r, export.Geo.GatewayHost(), export.Geo.GatewayIP(), export.Geo.GatewayPort())
+					ep := newEndpoint(export, r)
 					log.Debug().Msgf("[%s] processing port %d, ep=%v", ctx.ClusterKey, p.Port, ep)
 					endpoints = append(endpoints, ep)
 				}
@@ -264,7 +265,7 @@ func (c *Connector) upsertServiceImport(export *mcsevent.ServiceExportEvent) err
 					}
 
 					// insert/update
-					epMap[exportClusterKey] = newEndpoint(export, r, export.Geo.GatewayHost(), export.Geo.GatewayIP(), export.Geo.GatewayPort())
+					epMap[exportClusterKey] = newEndpoint(export, r)
 				}
 			}
 
@@ -357,9 +358,9 @@ func (c *Connector) newServiceImport(export *mcsevent.ServiceExportEvent) *mcsv1
 					Name:        p.Name,
 					Port:        p.Port,
 					Protocol:    p.Protocol,
-					AppProtocol: p.AppProtocol,
+					AppProtocol: exportedAppProtocol(r, p.AppProtocol),
 					Endpoints: []mcsv1alpha1.Endpoint{
-						newEndpoint(export, r, export.Geo.GatewayHost(), export.Geo.GatewayIP(), export.Geo.GatewayPort()),
+						newEndpoint(export, r),
 					},
 				})
 			}
@@ -383,18 +384,40 @@ func (c *Connector) newServiceImport(export *mcsevent.ServiceExportEvent) *mcsv1
 	}
 }
 
-func newEndpoint(export *mcsevent.ServiceExportEvent, r mcsv1alpha1.ServiceExportRule, host string, ip net.IP, port int32) mcsv1alpha1.Endpoint {
+// exportedAppProtocol returns the app protocol of an imported port, the ports routed by SNI
+// are always proxied as the exported protocol regardless of the app protocol of the service
+func exportedAppProtocol(r mcsv1alpha1.ServiceExportRule, appProtocol *string) *string {
+	switch r.Protocol {
+	case mcsv1alpha1.ServiceExportProtocolGRPC:
+		return pointer.String(constants.ProtocolGRPC)
+	case mcsv1alpha1.ServiceExportProtocolTCP:
+		return pointer.String(constants.ProtocolTCP)
+	default:
+		return appProtocol
+	}
+}
+
+func newEndpoint(export *mcsevent.ServiceExportEvent, r mcsv1alpha1.ServiceExportRule) mcsv1alpha1.Endpoint {
+	target := mcsv1alpha1.Target{
+		Host: export.Geo.GatewayHost(),
+		IP:   export.Geo.GatewayIP().String(),
+		Port: export.Geo.GatewayPort(),
+		Path: r.Path,
+	}
+
+	// GRPC and TCP ports are routed by SNI through the TLS port of the gateway
+	if r.IsRoutedBySNI() {
+		target.Port = export.Geo.GatewayTLSPort()
+		target.Path = ""
+		target.ServerName = mcsv1alpha1.ServiceExportServerName(export.ServiceExport.Namespace, export.ServiceExport.Name, r.PortNumber)
+	}
+
 	return mcsv1alpha1.Endpoint{
 		ClusterKey: export.ClusterKey(),
 		//Targets: []string{
 		//	fmt.Sprintf("%s%s", export.Geo.Gateway(), r.Path),
 		//},
-		Target: mcsv1alpha1.Target{
-			Host: host,
-			IP:   ip.String(),
-			Port: port,
-			Path: r.Path,
-		},
+		Target: target,
 	}
 }
 
@@ -0,0 +1,126 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) since 2021,  flomesh.io Authors.
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package remote
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mcsv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/multicluster/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/k8s/informers"
+	conn "github.com/flomesh-io/fsm/pkg/mcs/context"
+)
+
+// CheckHealth checks if the API server and the gateway of the connected cluster are reachable
+func (c *Connector) CheckHealth(timeout time.Duration) error {
+	ctx := c.context.(*conn.ConnectorContext)
+
+	reqCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if err := c.kubeClient.Discovery().RESTClient().Get().AbsPath("/healthz").Do(reqCtx).Error(); err != nil {
+		return fmt.Errorf("[%s] API server is unhealthy: %w", ctx.ClusterKey, err)
+	}
+
+	gwCfg := ctx.ConnectorConfig
+	address := net.JoinHostPort(gwCfg.GatewayIP().String(), strconv.Itoa(int(gwCfg.GatewayPort())))
+	gwConn, err := net.DialTimeout("tcp", address, timeout)
+	if err != nil {
+		return fmt.Errorf("[%s] gateway %s is unreachable: %w", ctx.ClusterKey, address, err)
+	}
+	_ = gwConn.Close()
+
+	return nil
+}
+
+// CountServiceImports returns the number of the services imported into the connected cluster
+func (c *Connector) CountServiceImports() int {
+	return len(c.informers.List(informers.InformerKeyServiceImport))
+}
+
+// WithdrawEndpoints removes the endpoints exported by the given cluster from all the ServiceImports
+// of the connected cluster, the ServiceImports left without any endpoint are deleted
+func (c *Connector) WithdrawEndpoints(clusterKey string) error {
+	ctx := c.context.(*conn.ConnectorContext)
+	if clusterKey == ctx.ClusterKey {
+		return nil
+	}
+
+	for _, obj := range c.informers.List(informers.InformerKeyServiceImport) {
+		cached, ok := obj.(*mcsv1alpha1.ServiceImport)
+		if !ok || cached.DeletionTimestamp != nil {
+			continue
+		}
+		imp := cached.DeepCopy()
+
+		withdrawn := false
+		ports := make([]mcsv1alpha1.ServicePort, 0)
+		for _, p := range imp.Spec.Ports {
+			endpoints := make([]mcsv1alpha1.Endpoint, 0)
+			for _, ep := range p.Endpoints {
+				if ep.ClusterKey == clusterKey {
+					withdrawn = true
+					continue
+				}
+
+				endpoints = append(endpoints, *ep.DeepCopy())
+			}
+
+			if len(endpoints) > 0 {
+				p.Endpoints = endpoints
+				ports = append(ports, *p.DeepCopy())
+			}
+		}
+
+		if !withdrawn {
+			continue
+		}
+
+		if len(ports) > 0 {
+			imp.Spec.Ports = ports
+			if _, err := c.mcsClient.MulticlusterV1alpha1().
+				ServiceImports(imp.Namespace).
+				Update(context.TODO(), imp, metav1.UpdateOptions{}); err != nil {
+				log.Error().Msgf("[%s] Failed to withdraw endpoints of cluster %s from ServiceImport %s/%s: %s", ctx.ClusterKey, clusterKey, imp.Namespace, imp.Name, err)
+				return err
+			}
+		} else {
+			if err := c.mcsClient.MulticlusterV1alpha1().
+				ServiceImports(imp.Namespace).
+				Delete(context.TODO(), imp.Name, metav1.DeleteOptions{}); err != nil {
+				log.Error().Msgf("[%s] Failed to delete ServiceImport %s/%s: %s", ctx.ClusterKey, imp.Namespace, imp.Name, err)
+				return err
+			}
+		}
+		log.Info().Msgf("[%s] Endpoints of cluster %s are withdrawn from ServiceImport %s/%s", ctx.ClusterKey, clusterKey, imp.Namespace, imp.Name)
+	}
+
+	return nil
+}
@@ -63,6 +63,7 @@ type Connector struct {
 	kubeClient         kubernetes.Interface
 	configClient       configClientset.Interface
 	mcsClient          multiclusterClientset.Interface
+	informers          *informers.InformerCollection
 	cfg                *configurator.Client
 	controlPlaneBroker *messaging.Broker
 }
@@ -153,6 +154,7 @@ func NewConnector(ctx context.Context, controlPlaneBroker *messaging.Broker) (*C
 		kubeClient:         kubeClient,
 		configClient:       configClient,
 		mcsClient:          multiclusterClient,
+		informers:          informerCollection,
 		cfg:                mc,
 		controlPlaneBroker: controlPlaneBroker,
 	}
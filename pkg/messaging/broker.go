@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/cskr/pubsub"
+	smiSplit "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha4"
 	"golang.org/x/time/rate"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/flomesh-io/fsm/pkg/announcements"
 	configv1alpha3 "github.com/flomesh-io/fsm/pkg/apis/config/v1alpha3"
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
 	"github.com/flomesh-io/fsm/pkg/constants"
 	"github.com/flomesh-io/fsm/pkg/k8s/events"
 	"github.com/flomesh-io/fsm/pkg/lru"
@@ -22,10 +24,10 @@ import (
 )
 
 const (
-	// proxyUpdateSlidingWindow is the sliding window duration used to batch proxy update events
+	// proxyUpdateSlidingWindow is the default sliding window duration used to batch proxy update events
 	proxyUpdateSlidingWindow = 2 * time.Second
 
-	// proxyUpdateMaxWindow is the max window duration used to batch proxy update events, and is
+	// proxyUpdateMaxWindow is the default max window duration used to batch proxy update events, and is
 	// the max amount of time a proxy update event can be held for batching before being dispatched.
 	proxyUpdateMaxWindow = 10 * time.Second
 
@@ -125,6 +127,25 @@ func (b *Broker) GetProxyUpdatePubSub() *pubsub.PubSub {
 	return b.proxyUpdatePubSub
 }
 
+// SetProxyUpdateWindowFunc sets the function returning the sliding window and the max window
+// used to batch proxy update events, evaluated each time a new batch is started
+func (b *Broker) SetProxyUpdateWindowFunc(fn ProxyUpdateWindowFunc) {
+	b.proxyUpdateWindow.Store(&fn)
+}
+
+// getProxyUpdateWindow returns the sliding window and the max window used to batch proxy update events
+func (b *Broker) getProxyUpdateWindow() (time.Duration, time.Duration) {
+	if fn := b.proxyUpdateWindow.Load(); fn != nil {
+		if slidingWindow, maxWindow := (*fn)(); slidingWindow > 0 {
+			if maxWindow < slidingWindow {
+				maxWindow = slidingWindow
+			}
+			return slidingWindow, maxWindow
+		}
+	}
+	return proxyUpdateSlidingWindow, proxyUpdateMaxWindow
+}
+
 func (b *Broker) GetProxyCreationChan() chan *corev1.Pod {
 	b.proxyCreationChOn = true
 	return b.proxyCreationCh
@@ -272,18 +293,25 @@ func (b *Broker) runProxyUpdateDispatcher(stopCh <-chan struct{}) {
 
 	// dispatchPending indicates whether a proxy update event is pending
 	// from being published on the pub-sub. A proxy update event will
-	// be held for 'proxyUpdateSlidingWindow' duration to be able to
+	// be held for the sliding window duration to be able to
 	// coalesce multiple proxy update events within that duration, before
-	// it is dispatched on the pub-sub. The 'proxyUpdateSlidingWindow' duration
+	// it is dispatched on the pub-sub. The sliding window duration
 	// is a sliding window, which means each event received within a window
-	// slides the window further ahead in time, up to a max of 'proxyUpdateMaxWindow'.
+	// slides the window further ahead in time, up to a max of the max window.
+	// Both windows default to 'proxyUpdateSlidingWindow' and 'proxyUpdateMaxWindow'
+	// and are configurable with 'SetProxyUpdateWindowFunc'.
 	//
 	// This mechanism is necessary to avoid triggering proxy update pub-sub events in
 	// a hot loop, which would otherwise result in CPU spikes on the controller.
-	// We want to coalesce as many proxy update events within the 'proxyUpdateMaxWindow'
+	// We want to coalesce as many proxy update events within the max window
 	// duration.
 	dispatchPending := false
 	batchCount := 0 // number of proxy update events batched per dispatch
+	slidingWindow := proxyUpdateSlidingWindow
+
+	// batchStart is the time the first event of the pending batch was processed,
+	// the dispatched event carries it to measure the propagation latency of the batch
+	var batchStart time.Time
 
 	var event proxyUpdateEvent
 	for {
@@ -293,30 +321,38 @@ func (b *Broker) runProxyUpdateDispatcher(stopCh <-chan struct{}) {
 				log.Warn().Msgf("Proxy update event chan closed, exiting dispatcher")
 				return
 			}
-			event = e
+			if !dispatchPending {
+				event = e
+			} else {
+				event = coalesceProxyUpdateEvents(event, e)
+			}
 
 			if !dispatchPending {
 				// No proxy update events are pending send on the pub-sub.
 				// Reset the dispatch timers. The events will be dispatched
 				// when either of the timers expire.
+				var maxWindow time.Duration
+				slidingWindow, maxWindow = b.getProxyUpdateWindow()
 				if !slidingTimer.Stop() {
 					<-slidingTimer.C
 				}
-				slidingTimer.Reset(proxyUpdateSlidingWindow)
+				slidingTimer.Reset(slidingWindow)
 				if !maxTimer.Stop() {
 					<-maxTimer.C
 				}
-				maxTimer.Reset(proxyUpdateMaxWindow)
+				maxTimer.Reset(maxWindow)
 				dispatchPending = true
 				batchCount++
+				batchStart = event.msg.Timestamp
 				log.Trace().Msgf("Pending dispatch of msg kind %s", event.msg.Kind)
 			} else {
 				// A proxy update event is pending dispatch. Update the sliding window.
 				if !slidingTimer.Stop() {
 					<-slidingTimer.C
 				}
-				slidingTimer.Reset(proxyUpdateSlidingWindow)
+				slidingTimer.Reset(slidingWindow)
 				batchCount++
+				metricsstore.DefaultMetricsStore.ProxyConfigUpdateCoalescedCount.WithLabelValues("broker").Inc()
 				log.Trace().Msgf("Reset sliding window for msg kind %s", event.msg.Kind)
 			}
 
@@ -328,6 +364,7 @@ func (b *Broker) runProxyUpdateDispatcher(stopCh <-chan struct{}) {
 				<-maxTimer.C
 			}
 			maxTimer.Reset(noTimeout)
+			event.msg.Timestamp = batchStart
 			b.proxyUpdatePubSub.Pub(event.msg, event.topic)
 			atomic.AddUint64(&b.totalDispatchedProxyEventCount, 1)
 			metricsstore.DefaultMetricsStore.ProxyBroadcastEventCount.Inc()
@@ -343,6 +380,7 @@ func (b *Broker) runProxyUpdateDispatcher(stopCh <-chan struct{}) {
 				<-slidingTimer.C
 			}
 			slidingTimer.Reset(noTimeout)
+			event.msg.Timestamp = batchStart
 			b.proxyUpdatePubSub.Pub(event.msg, event.topic)
 			atomic.AddUint64(&b.totalDispatchedProxyEventCount, 1)
 			metricsstore.DefaultMetricsStore.ProxyBroadcastEventCount.Inc()
@@ -357,6 +395,16 @@ func (b *Broker) runProxyUpdateDispatcher(stopCh <-chan struct{}) {
 	}
 }
 
+// coalesceProxyUpdateEvents returns the event dispatched for a batch of proxy update events, the proxies
+// prioritize the updates triggered by changes over the periodic resyncs so an event of a change
+// coalesced with a resync must be dispatched whatever their order
+func coalesceProxyUpdateEvents(pending, received proxyUpdateEvent) proxyUpdateEvent {
+	if pending.msg.Kind != announcements.ProxyUpdate && received.msg.Kind == announcements.ProxyUpdate {
+		return pending
+	}
+	return received
+}
+
 // runIngressUpdateDispatcher runs the dispatcher responsible for batching
 // ingress update events received in close proximity.
 // It batches ingress update events with the use of 2 timers:
@@ -875,6 +923,9 @@ func (b *Broker) runXNetworkUpdateDispatcher(stopCh <-chan struct{}) {
 // 3. Updates metrics associated with the event
 func (b *Broker) processEvent(msg events.PubSubMessage) {
 	log.Trace().Msgf("Processing msg kind: %s", msg.Kind)
+	if msg.Timestamp.IsZero() {
+		msg.Timestamp = time.Now()
+	}
 	// Update proxies if applicable
 	if event := getProxyUpdateEvent(msg); event != nil {
 		log.Trace().Msgf("Msg kind %s will update proxies", msg.Kind)
@@ -1030,6 +1081,13 @@ func getProxyUpdateEvent(msg events.PubSubMessage) *proxyUpdateEvent {
 			msg:   msg,
 			topic: announcements.ProxyUpdate.String(),
 		}
+	case
+		// Node zone event
+		announcements.NodeZoneUpdated:
+		return &proxyUpdateEvent{
+			msg:   msg,
+			topic: announcements.ProxyUpdate.String(),
+		}
 	case
 		// Service event
 		announcements.ServiceAdded, announcements.ServiceDeleted, announcements.ServiceUpdated:
@@ -1060,6 +1118,14 @@ func getProxyUpdateEvent(msg events.PubSubMessage) *proxyUpdateEvent {
 			}
 		}
 		return nil
+	case
+		// AccessControl update event
+		announcements.AccessControlUpdated:
+		return accessControlUpdated(msg)
+	case
+		// SMI TrafficSplit update event
+		announcements.TrafficSplitUpdated:
+		return trafficSplitUpdated(msg)
 	case
 		//
 		// K8s native resource events
@@ -1078,7 +1144,7 @@ func getProxyUpdateEvent(msg events.PubSubMessage) *proxyUpdateEvent {
 		// IngressBackend event
 		announcements.IngressBackendAdded, announcements.IngressBackendDeleted, announcements.IngressBackendUpdated,
 		// AccessControl event
-		announcements.AccessControlAdded, announcements.AccessControlDeleted, announcements.AccessControlUpdated,
+		announcements.AccessControlAdded, announcements.AccessControlDeleted,
 		// Isolation event
 		announcements.IsolationPolicyAdded, announcements.IsolationPolicyDeleted, announcements.IsolationPolicyUpdated,
 		// Retry event
@@ -1087,6 +1153,16 @@ func getProxyUpdateEvent(msg events.PubSubMessage) *proxyUpdateEvent {
 		announcements.TrafficWarmupAdded, announcements.TrafficWarmupDeleted, announcements.TrafficWarmupUpdated,
 		// UpstreamTrafficSetting event
 		announcements.UpstreamTrafficSettingAdded, announcements.UpstreamTrafficSettingDeleted, announcements.UpstreamTrafficSettingUpdated,
+		// RequestAuthentication event
+		announcements.RequestAuthenticationAdded, announcements.RequestAuthenticationDeleted, announcements.RequestAuthenticationUpdated,
+		// AuthorizationPolicy event
+		announcements.AuthorizationPolicyAdded, announcements.AuthorizationPolicyDeleted, announcements.AuthorizationPolicyUpdated,
+		// SidecarScope event
+		announcements.SidecarScopeAdded, announcements.SidecarScopeDeleted, announcements.SidecarScopeUpdated,
+		// MeshFaultInjection event
+		announcements.MeshFaultInjectionAdded, announcements.MeshFaultInjectionDeleted, announcements.MeshFaultInjectionUpdated,
+		// JWKS refreshed
+		announcements.JWKSUpdated,
 		//
 		// SMI resource events
 		//
@@ -1095,10 +1171,19 @@ func getProxyUpdateEvent(msg events.PubSubMessage) *proxyUpdateEvent {
 		// SMI TCPRoute event
 		announcements.TCPRouteAdded, announcements.TCPRouteDeleted, announcements.TCPRouteUpdated,
 		// SMI TrafficSplit event
-		announcements.TrafficSplitAdded, announcements.TrafficSplitDeleted, announcements.TrafficSplitUpdated,
+		announcements.TrafficSplitAdded, announcements.TrafficSplitDeleted,
 		// SMI TrafficTarget event
 		announcements.TrafficTargetAdded, announcements.TrafficTargetDeleted, announcements.TrafficTargetUpdated,
 		//
+		// GatewayAPI resource events, routes with a Service parentRef (GAMMA) configure the mesh
+		//
+		// HTTPRoute event
+		announcements.GatewayAPIHTTPRouteAdded, announcements.GatewayAPIHTTPRouteDeleted, announcements.GatewayAPIHTTPRouteUpdated,
+		// GRPCRoute event
+		announcements.GatewayAPIGRPCRouteAdded, announcements.GatewayAPIGRPCRouteDeleted, announcements.GatewayAPIGRPCRouteUpdated,
+		// ReferenceGrant event, allowing the routes to refer to backends in other namespaces
+		announcements.GatewayAPIReferenceGrantAdded, announcements.GatewayAPIReferenceGrantDeleted, announcements.GatewayAPIReferenceGrantUpdated,
+		//
 		// MultiCluster events
 		//
 		// ServiceImport event
@@ -1163,6 +1248,34 @@ func namespaceUpdated(msg events.PubSubMessage) *proxyUpdateEvent {
 	return nil
 }
 
+// accessControlUpdated ignores the updates of the status of AccessControl policies,
+// which do not change the generation of the policies nor the config of the proxies
+func accessControlUpdated(msg events.PubSubMessage) *proxyUpdateEvent {
+	prevACL, okPrevCast := msg.OldObj.(*policyv1alpha1.AccessControl)
+	newACL, okNewCast := msg.NewObj.(*policyv1alpha1.AccessControl)
+	if okPrevCast && okNewCast && prevACL.Generation == newACL.Generation {
+		return nil
+	}
+	return &proxyUpdateEvent{
+		msg:   msg,
+		topic: announcements.ProxyUpdate.String(),
+	}
+}
+
+// trafficSplitUpdated ignores the updates of the metadata of TrafficSplit policies such as the Programmed
+// condition annotation, which do not change the generation of the policies nor the config of the proxies
+func trafficSplitUpdated(msg events.PubSubMessage) *proxyUpdateEvent {
+	prevSplit, okPrevCast := msg.OldObj.(*smiSplit.TrafficSplit)
+	newSplit, okNewCast := msg.NewObj.(*smiSplit.TrafficSplit)
+	if okPrevCast && okNewCast && prevSplit.Generation == newSplit.Generation {
+		return nil
+	}
+	return &proxyUpdateEvent{
+		msg:   msg,
+		topic: announcements.ProxyUpdate.String(),
+	}
+}
+
 func meshConfigUpdated(msg events.PubSubMessage) *proxyUpdateEvent {
 	prevMeshConfig, okPrevCast := msg.OldObj.(*configv1alpha3.MeshConfig)
 	newMeshConfig, okNewCast := msg.NewObj.(*configv1alpha3.MeshConfig)
@@ -1228,6 +1341,15 @@ func podUpdated(msg events.PubSubMessage) *proxyUpdateEvent {
 		return nil
 	}
 
+	// Label updates change the SidecarScope policies applied to the proxy of the pod
+	if okPreCast && okNewCast && len(newPod.Labels) > 0 && !reflect.DeepEqual(prePod.Labels, newPod.Labels) {
+		if proxyUUID := newPod.Labels[constants.SidecarUniqueIDLabelName]; len(proxyUUID) > 0 {
+			return &proxyUpdateEvent{
+				msg:   msg,
+				topic: GetPubSubTopicForProxyUUID(proxyUUID),
+			}
+		}
+	}
 	if okPreCast && okNewCast && len(prePod.Annotations) > 0 && len(newPod.Annotations) > 0 {
 		prevMetricAnnotation := prePod.Annotations[constants.PrometheusScrapeAnnotation]
 		newMetricAnnotation := newPod.Annotations[constants.PrometheusScrapeAnnotation]
@@ -1376,6 +1498,10 @@ func getGatewayUpdateEvent(msg events.PubSubMessage) *gatewayUpdateEvent {
 		announcements.GatewayRequestTerminationAdded, announcements.GatewayRequestTerminationDeleted, announcements.GatewayRequestTerminationUpdated,
 		// DNSModifier event
 		announcements.GatewayDNSModifierAdded, announcements.GatewayDNSModifierDeleted, announcements.GatewayDNSModifierUpdated,
+		// JWTAuth event
+		announcements.GatewayJWTAuthAdded, announcements.GatewayJWTAuthDeleted, announcements.GatewayJWTAuthUpdated,
+		// JWKS refreshed
+		announcements.JWKSUpdated,
 
 		//
 		// MultiCluster events
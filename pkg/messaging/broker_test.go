@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	smiSplit "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha4"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/flomesh-io/fsm/pkg/announcements"
 	configv1alpha3 "github.com/flomesh-io/fsm/pkg/apis/config/v1alpha3"
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
 	"github.com/flomesh-io/fsm/pkg/constants"
 	"github.com/flomesh-io/fsm/pkg/k8s/events"
 	"github.com/flomesh-io/fsm/pkg/metricsstore"
@@ -314,6 +316,25 @@ func TestGetProxyUpdateEvent(t *testing.T) {
 			expectEvent:   true,
 			expectedTopic: "proxy:foo",
 		},
+		{
+			// Label updates should update the relevant proxy
+			name: "Pod label update event resulting in proxy update",
+			msg: events.PubSubMessage{
+				OldObj: &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{constants.SidecarUniqueIDLabelName: "foo", "app": "v1"},
+					},
+				},
+				NewObj: &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{constants.SidecarUniqueIDLabelName: "foo", "app": "v2"},
+					},
+				},
+				Kind: announcements.PodUpdated,
+			},
+			expectEvent:   true,
+			expectedTopic: "proxy:foo",
+		},
 		{
 			name: "Pod delete event",
 			msg: events.PubSubMessage{
@@ -328,6 +349,44 @@ func TestGetProxyUpdateEvent(t *testing.T) {
 			},
 			expectEvent: false,
 		},
+		{
+			name: "AccessControl spec updated",
+			msg: events.PubSubMessage{
+				Kind:   announcements.AccessControlUpdated,
+				OldObj: &policyv1alpha1.AccessControl{ObjectMeta: metav1.ObjectMeta{Generation: 1}},
+				NewObj: &policyv1alpha1.AccessControl{ObjectMeta: metav1.ObjectMeta{Generation: 2}},
+			},
+			expectEvent:   true,
+			expectedTopic: announcements.ProxyUpdate.String(),
+		},
+		{
+			name: "AccessControl status updated",
+			msg: events.PubSubMessage{
+				Kind:   announcements.AccessControlUpdated,
+				OldObj: &policyv1alpha1.AccessControl{ObjectMeta: metav1.ObjectMeta{Generation: 2}},
+				NewObj: &policyv1alpha1.AccessControl{ObjectMeta: metav1.ObjectMeta{Generation: 2}},
+			},
+			expectEvent: false,
+		},
+		{
+			name: "TrafficSplit spec updated",
+			msg: events.PubSubMessage{
+				Kind:   announcements.TrafficSplitUpdated,
+				OldObj: &smiSplit.TrafficSplit{ObjectMeta: metav1.ObjectMeta{Generation: 1}},
+				NewObj: &smiSplit.TrafficSplit{ObjectMeta: metav1.ObjectMeta{Generation: 2}},
+			},
+			expectEvent:   true,
+			expectedTopic: announcements.ProxyUpdate.String(),
+		},
+		{
+			name: "TrafficSplit annotations updated",
+			msg: events.PubSubMessage{
+				Kind:   announcements.TrafficSplitUpdated,
+				OldObj: &smiSplit.TrafficSplit{ObjectMeta: metav1.ObjectMeta{Generation: 2}},
+				NewObj: &smiSplit.TrafficSplit{ObjectMeta: metav1.ObjectMeta{Generation: 2, Annotations: map[string]string{"a": "b"}}},
+			},
+			expectEvent: false,
+		},
 		{
 			name: "Service update event",
 			msg: events.PubSubMessage{
@@ -404,6 +463,65 @@ func TestRunProxyUpdateDispatcher(t *testing.T) {
 	a.EqualValues(b.GetTotalDispatchedProxyEventCount(), 2) // 1 carried over from sliding window test
 }
 
+func TestCoalesceProxyUpdateEvents(t *testing.T) {
+	a := assert.New(t)
+
+	change := proxyUpdateEvent{msg: events.PubSubMessage{Kind: announcements.ServiceUpdated}}
+	otherChange := proxyUpdateEvent{msg: events.PubSubMessage{Kind: announcements.EndpointUpdated}}
+	resync := proxyUpdateEvent{msg: events.PubSubMessage{Kind: announcements.ProxyUpdate}}
+
+	// A change coalesced with a resync is dispatched whatever their order
+	a.Equal(change, coalesceProxyUpdateEvents(change, resync))
+	a.Equal(change, coalesceProxyUpdateEvents(resync, change))
+
+	// The latest event is dispatched otherwise
+	a.Equal(otherChange, coalesceProxyUpdateEvents(change, otherChange))
+	a.Equal(resync, coalesceProxyUpdateEvents(resync, resync))
+}
+
+func TestProxyUpdateWindowFunc(t *testing.T) {
+	a := assert.New(t)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	b := NewBroker(stopCh)
+	proxyUpdateChan := b.GetProxyUpdatePubSub().Sub(announcements.ProxyUpdate.String())
+	defer b.Unsub(b.proxyUpdatePubSub, proxyUpdateChan)
+
+	slidingWindow, maxWindow := b.getProxyUpdateWindow()
+	a.Equal(proxyUpdateSlidingWindow, slidingWindow)
+	a.Equal(proxyUpdateMaxWindow, maxWindow)
+
+	// The max window is never shorter than the sliding window
+	b.SetProxyUpdateWindowFunc(func() (time.Duration, time.Duration) {
+		return 50 * time.Millisecond, 10 * time.Millisecond
+	})
+	slidingWindow, maxWindow = b.getProxyUpdateWindow()
+	a.Equal(50*time.Millisecond, slidingWindow)
+	a.Equal(50*time.Millisecond, maxWindow)
+
+	// The dispatched event carries the time of the first event of the batch
+	first := time.Now().Add(-time.Second)
+	b.proxyUpdateCh <- proxyUpdateEvent{
+		msg:   events.PubSubMessage{Kind: announcements.Kind("first"), Timestamp: first},
+		topic: announcements.ProxyUpdate.String(),
+	}
+	b.proxyUpdateCh <- proxyUpdateEvent{
+		msg:   events.PubSubMessage{Kind: announcements.Kind("second"), Timestamp: time.Now()},
+		topic: announcements.ProxyUpdate.String(),
+	}
+
+	select {
+	case msg := <-proxyUpdateChan:
+		event := msg.(events.PubSubMessage)
+		a.Equal(announcements.Kind("second"), event.Kind)
+		a.Equal(first, event.Timestamp)
+	case <-time.After(proxyUpdateSlidingWindow):
+		a.Fail("proxy update event not dispatched within the configured window")
+	}
+	a.EqualValues(1, b.GetTotalDispatchedProxyEventCount())
+}
+
 func TestGetPubSubTopicForProxyUUID(t *testing.T) {
 	a := assert.New(t)
 
@@ -3,6 +3,9 @@
 package messaging
 
 import (
+	"sync/atomic"
+	"time"
+
 	"github.com/cskr/pubsub"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/util/workqueue"
@@ -48,8 +51,12 @@ type Broker struct {
 	totalDispatchedGatewayEventCount   uint64
 	totalDispatchedServiceEventCount   uint64
 	totalDispatchedConnectorEventCount uint64
+	proxyUpdateWindow                  atomic.Pointer[ProxyUpdateWindowFunc]
 }
 
+// ProxyUpdateWindowFunc returns the sliding window and the max window used to batch proxy update events
+type ProxyUpdateWindowFunc func() (slidingWindow time.Duration, maxWindow time.Duration)
+
 // proxyUpdateEvent specifies the PubSubMessage and topic for an event that
 // results in a proxy config update
 type proxyUpdateEvent struct {
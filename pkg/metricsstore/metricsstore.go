@@ -54,6 +54,18 @@ type MetricsStore struct {
 	// ProxyXDSRequestCount counts XDS requests made by proxies
 	ProxyXDSRequestCount *prometheus.CounterVec
 
+	// ProxyConfigUpdateQueueDepth is the metric for the number of proxies pending a configuration update
+	ProxyConfigUpdateQueueDepth prometheus.Gauge
+
+	// ProxyConfigUpdateCoalescedCount is the metric for the total number of proxy update events coalesced
+	// with pending ones instead of triggering a configuration update of their own, either by the broker
+	// batching broadcast events or by the queue of the configuration updates pending per proxy
+	ProxyConfigUpdateCoalescedCount *prometheus.CounterVec
+
+	// ProxyConfigPropagationLatency is the histogram to track the time from an event being processed
+	// to the configuration of an affected proxy being updated
+	ProxyConfigPropagationLatency *prometheus.HistogramVec
+
 	// ProxyMaxConnectionsRejected counts the number of proxy connections
 	// rejected due to the max connections limit being reached
 	ProxyMaxConnectionsRejected prometheus.Counter
@@ -86,6 +98,21 @@ type MetricsStore struct {
 	// ConnectorBroadcastEventCounter is the metric for the total number of ConnectorBroadcast events published
 	ConnectorBroadcastEventCounter prometheus.Counter
 
+	/*
+	 * DNS proxy metrics
+	 */
+	// DNSCacheHitCount is the metric counter for the number of DNS queries answered from the local DNS proxy cache
+	DNSCacheHitCount *prometheus.CounterVec
+
+	// DNSCacheMissCount is the metric counter for the number of DNS queries not found in the local DNS proxy cache
+	DNSCacheMissCount prometheus.Counter
+
+	// DNSCachePrefetchCount is the metric counter for the number of cache entries refreshed before they expire
+	DNSCachePrefetchCount prometheus.Counter
+
+	// DNSCacheEntries is the metric for the number of responses kept in the local DNS proxy cache
+	DNSCacheEntries prometheus.Gauge
+
 	/*
 	 * Certificate metrics
 	 */
@@ -216,6 +243,36 @@ func init() {
 		Help:      "Represents the number of XDS requests made by proxies",
 	}, []string{"proxy_uuid", "identity", "type"})
 
+	defaultMetricsStore.ProxyConfigUpdateQueueDepth = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsRootNamespace,
+		Subsystem: "proxy",
+		Name:      "config_update_queue_depth",
+		Help:      "Represents the number of proxies pending a configuration update",
+	})
+
+	defaultMetricsStore.ProxyConfigUpdateCoalescedCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsRootNamespace,
+			Subsystem: "proxy",
+			Name:      "config_update_coalesced_count",
+			Help:      "Represents the number of proxy update events coalesced with pending configuration updates",
+		},
+		[]string{
+			"stage", // where the event was coalesced: broker for broadcast batches, proxy for the updates pending per proxy
+		})
+
+	defaultMetricsStore.ProxyConfigPropagationLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsRootNamespace,
+			Subsystem: "proxy",
+			Name:      "config_propagation_latency",
+			Buckets:   []float64{.1, .25, .5, 1, 2.5, 5, 10, 20, 40, 90},
+			Help:      "Histogram to track the time from an event being processed to the configuration of a proxy being updated",
+		},
+		[]string{
+			"priority", // the priority of the configuration update: new, update or resync
+		})
+
 	defaultMetricsStore.ProxyMaxConnectionsRejected = prometheus.NewCounter(prometheus.CounterOpts{
 		Namespace: metricsRootNamespace,
 		Subsystem: "proxy",
@@ -327,6 +384,37 @@ func init() {
 		Help:      "Represents the number of ConnectorBroadcast events published by the FSM controller",
 	})
 
+	/*
+	 * DNS proxy metrics
+	 */
+	defaultMetricsStore.DNSCacheHitCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsRootNamespace,
+		Subsystem: "dns",
+		Name:      "cache_hit_count",
+		Help:      "Represents the number of DNS queries answered from the local DNS proxy cache",
+	}, []string{"type"})
+
+	defaultMetricsStore.DNSCacheMissCount = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsRootNamespace,
+		Subsystem: "dns",
+		Name:      "cache_miss_count",
+		Help:      "Represents the number of DNS queries not found in the local DNS proxy cache",
+	})
+
+	defaultMetricsStore.DNSCachePrefetchCount = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsRootNamespace,
+		Subsystem: "dns",
+		Name:      "cache_prefetch_count",
+		Help:      "Represents the number of local DNS proxy cache entries refreshed before they expire",
+	})
+
+	defaultMetricsStore.DNSCacheEntries = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsRootNamespace,
+		Subsystem: "dns",
+		Name:      "cache_entries",
+		Help:      "Represents the number of responses kept in the local DNS proxy cache",
+	})
+
 	defaultMetricsStore.registry = prometheus.NewRegistry()
 }
 
@@ -218,6 +218,9 @@ func (c *Client) GetEndpoints(svc service.MeshService) (*corev1.Endpoints, error
 				}
 				targetEndpoints.Annotations[fmt.Sprintf(ServiceImportClusterKeyAnnotation, endpoint.Target.IP, endpoint.Target.Port)] = endpoint.ClusterKey
 				targetEndpoints.Annotations[fmt.Sprintf(ServiceImportContextPathAnnotation, endpoint.Target.IP, endpoint.Target.Port)] = endpoint.Target.Path
+				if len(endpoint.Target.ServerName) > 0 {
+					targetEndpoints.Annotations[fmt.Sprintf(ServiceImportServerNameAnnotation, endpoint.Target.IP, endpoint.Target.Port)] = endpoint.Target.ServerName
+				}
 				targetEndpoints.Annotations[fmt.Sprintf(ServiceImportLBTypeAnnotation, endpoint.Target.IP, endpoint.Target.Port)] = string(lbType)
 				targetEndpoints.Annotations[fmt.Sprintf(ServiceImportLBWeightAnnotation, endpoint.Target.IP, endpoint.Target.Port)] = fmt.Sprintf("%d", lbWeight)
 				targetEndpoints.Subsets = append(targetEndpoints.Subsets, corev1.EndpointSubset{
@@ -31,9 +31,12 @@ func (c *Client) getServiceTrafficPolicy(svc service.MeshService) (lbType multic
 		if len(gblTrafficPolicy.Spec.Targets) > 0 {
 			clusterKeys = make(map[string]int)
 			for _, lbt := range gblTrafficPolicy.Spec.Targets {
-				if lbt.Weight != nil {
+				switch {
+				case lbType == multiclusterv1alpha1.FailOverLbType:
+					clusterKeys[lbt.ClusterKey] = failOverWeight(gblTrafficPolicy, lbt.ClusterKey)
+				case lbt.Weight != nil:
 					clusterKeys[lbt.ClusterKey] = *lbt.Weight
-				} else {
+				default:
 					clusterKeys[lbt.ClusterKey] = 0
 				}
 			}
@@ -44,6 +47,15 @@ func (c *Client) getServiceTrafficPolicy(svc service.MeshService) (lbType multic
 	return
 }
 
+// failOverWeight returns the weight of the target in FailOver load balancing, only the
+// active target observed healthy accepts the traffic while the others stand by
+func failOverWeight(gblTrafficPolicy *multiclusterv1alpha1.GlobalTrafficPolicy, clusterKey string) int {
+	if len(gblTrafficPolicy.Status.ActiveCluster) > 0 && gblTrafficPolicy.Status.ActiveCluster == clusterKey {
+		return constants.ClusterWeightAcceptAll
+	}
+	return constants.ClusterWeightFailOver
+}
+
 // GetLbWeightForService retrieves load balancer type and weight for service
 func (c *Client) GetLbWeightForService(svc service.MeshService) (aa, fo, lc bool, weight int, clusterKeys map[string]int) {
 	gblTrafficPolicy := c.getGlobalTrafficPolicy(svc)
@@ -74,7 +86,7 @@ func (c *Client) GetLbWeightForService(svc service.MeshService) (aa, fo, lc bool
 			weight = constants.ClusterWeightFailOver
 			clusterKeys = make(map[string]int)
 			for _, lbt := range gblTrafficPolicy.Spec.Targets {
-				clusterKeys[lbt.ClusterKey] = constants.ClusterWeightFailOver
+				clusterKeys[lbt.ClusterKey] = failOverWeight(gblTrafficPolicy, lbt.ClusterKey)
 			}
 			return
 		}
@@ -21,6 +21,9 @@ const (
 	// ServiceImportContextPathAnnotation is the annotation used to configure context path for imported service
 	ServiceImportContextPathAnnotation = "flomesh.io/ServiceImport/ContextPath/%s/%d"
 
+	// ServiceImportServerNameAnnotation is the annotation used to configure the SNI for imported service
+	ServiceImportServerNameAnnotation = "flomesh.io/ServiceImport/ServerName/%s/%d"
+
 	// ServiceImportLBTypeAnnotation is the annotation used to configure load balancer type for imported service
 	ServiceImportLBTypeAnnotation = "flomesh.io/ServiceImport/LBType/%s/%d"
 
@@ -0,0 +1,126 @@
+package policy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+
+	"github.com/flomesh-io/fsm/pkg/identity"
+	"github.com/flomesh-io/fsm/pkg/service"
+	"github.com/flomesh-io/fsm/pkg/trafficpolicy"
+)
+
+// AuthorizationPolicyAppliesTo returns true if the given AuthorizationPolicy applies to the given backend MeshService
+func AuthorizationPolicyAppliesTo(authz *policyv1alpha1.AuthorizationPolicy, svc service.MeshService) bool {
+	if authz.Namespace != svc.Namespace {
+		return false
+	}
+
+	// A policy without backends applies to all the backends in the namespace
+	if len(authz.Spec.Backends) == 0 {
+		return true
+	}
+
+	for _, backend := range authz.Spec.Backends {
+		if backend.Name == svc.Name && backend.Port.Number == int(svc.TargetPort) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CompileAuthorizationPolicies compiles the given AuthorizationPolicy policies applied to a backend into
+// the ordered rules evaluated for the requests received by the backend, nil is returned if there is no policy
+func CompileAuthorizationPolicies(policies []*policyv1alpha1.AuthorizationPolicy, trustDomain string) *trafficpolicy.AuthorizationTrafficPolicy {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	sorted := make([]*policyv1alpha1.AuthorizationPolicy, len(policies))
+	copy(sorted, policies)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		x, y := sorted[i], sorted[j]
+		if x.Spec.Priority != y.Spec.Priority {
+			return x.Spec.Priority < y.Spec.Priority
+		}
+		if xDeny, yDeny := authorizationAction(x) == policyv1alpha1.AuthorizationActionDeny, authorizationAction(y) == policyv1alpha1.AuthorizationActionDeny; xDeny != yDeny {
+			return xDeny
+		}
+		if x.Namespace != y.Namespace {
+			return x.Namespace < y.Namespace
+		}
+		return x.Name < y.Name
+	})
+
+	authzPolicy := &trafficpolicy.AuthorizationTrafficPolicy{DefaultAllow: true}
+	for _, authz := range sorted {
+		action := authorizationAction(authz)
+		if action == policyv1alpha1.AuthorizationActionAllow {
+			authzPolicy.DefaultAllow = false
+		}
+
+		for i, rule := range authz.Spec.Rules {
+			r := &trafficpolicy.AuthorizationRule{
+				Policy:  fmt.Sprintf("%s/%s", authz.Namespace, authz.Name),
+				Index:   i,
+				Action:  action,
+				Methods: rule.Methods,
+				Paths:   rule.Paths,
+			}
+
+			for _, source := range rule.Sources {
+				r.Principals = append(r.Principals, authorizationPrincipal(source, authz.Namespace, trustDomain))
+			}
+
+			if len(rule.Headers) > 0 {
+				r.Headers = make(map[string][]string)
+				for _, header := range rule.Headers {
+					name := strings.ToLower(header.Name)
+					r.Headers[name] = append(r.Headers[name], header.Values...)
+				}
+			}
+
+			if len(rule.Claims) > 0 {
+				r.Claims = make(map[string][]string)
+				for _, claim := range rule.Claims {
+					r.Claims[claim.Name] = append(r.Claims[claim.Name], claim.Values...)
+				}
+			}
+
+			authzPolicy.Rules = append(authzPolicy.Rules, r)
+		}
+	}
+
+	return authzPolicy
+}
+
+func authorizationAction(authz *policyv1alpha1.AuthorizationPolicy) policyv1alpha1.AuthorizationAction {
+	if authz.Spec.Action == policyv1alpha1.AuthorizationActionDeny {
+		return policyv1alpha1.AuthorizationActionDeny
+	}
+
+	return policyv1alpha1.AuthorizationActionAllow
+}
+
+// authorizationPrincipal returns the service identity pattern of the given source
+func authorizationPrincipal(source policyv1alpha1.AuthorizationSource, namespace, trustDomain string) string {
+	switch source.Kind {
+	case policyv1alpha1.KindAuthenticatedPrincipal:
+		if source.Name == identity.WildcardPrincipal {
+			return identity.WildcardPrincipal
+		}
+		return identity.FromPrincipal(source.Name, trustDomain).String()
+	default:
+		ns := source.Namespace
+		if len(ns) == 0 {
+			ns = namespace
+		}
+		if source.Name == identity.WildcardPrincipal {
+			return fmt.Sprintf("*.%s", ns)
+		}
+		return identity.New(source.Name, ns).String()
+	}
+}
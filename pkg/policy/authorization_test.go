@@ -0,0 +1,171 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	policyV1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+
+	"github.com/flomesh-io/fsm/pkg/service"
+	"github.com/flomesh-io/fsm/pkg/trafficpolicy"
+)
+
+func TestAuthorizationPolicyAppliesTo(t *testing.T) {
+	svc := service.MeshService{Name: "s1", Namespace: "test", TargetPort: 8080}
+
+	testCases := []struct {
+		name     string
+		authz    *policyV1alpha1.AuthorizationPolicy
+		expected bool
+	}{
+		{
+			name: "policy without backends applies to the namespace",
+			authz: &policyV1alpha1.AuthorizationPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "test"},
+			},
+			expected: true,
+		},
+		{
+			name: "policy in another namespace",
+			authz: &policyV1alpha1.AuthorizationPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "other"},
+			},
+			expected: false,
+		},
+		{
+			name: "policy matching the backend and port",
+			authz: &policyV1alpha1.AuthorizationPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "test"},
+				Spec: policyV1alpha1.AuthorizationPolicySpec{
+					Backends: []policyV1alpha1.AuthorizationBackendSpec{{Name: "s1", Port: policyV1alpha1.PortSpec{Number: 8080}}},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "policy matching the backend on another port",
+			authz: &policyV1alpha1.AuthorizationPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "test"},
+				Spec: policyV1alpha1.AuthorizationPolicySpec{
+					Backends: []policyV1alpha1.AuthorizationBackendSpec{{Name: "s1", Port: policyV1alpha1.PortSpec{Number: 9090}}},
+				},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, AuthorizationPolicyAppliesTo(tc.authz, svc))
+		})
+	}
+}
+
+func TestCompileAuthorizationPolicies(t *testing.T) {
+	a := assert.New(t)
+
+	a.Nil(CompileAuthorizationPolicies(nil, "cluster.local"))
+
+	allowReaders := &policyV1alpha1.AuthorizationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "allow-readers", Namespace: "test"},
+		Spec: policyV1alpha1.AuthorizationPolicySpec{
+			Action: policyV1alpha1.AuthorizationActionAllow,
+			Rules: []policyV1alpha1.AuthorizationRule{
+				{
+					Sources: []policyV1alpha1.AuthorizationSource{{Kind: policyV1alpha1.KindServiceAccount, Name: "*", Namespace: "client"}},
+					Methods: []string{"GET"},
+					Paths:   []string{"/api"},
+				},
+				{
+					Headers: []policyV1alpha1.AuthorizationHeaderMatch{{Name: "X-Tenant", Values: []string{"foo"}}},
+					Claims:  []policyV1alpha1.AuthorizationClaimMatch{{Name: "realm.groups", Values: []string{"admin"}}},
+				},
+			},
+		},
+	}
+	denyAdmin := &policyV1alpha1.AuthorizationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "deny-admin", Namespace: "test"},
+		Spec: policyV1alpha1.AuthorizationPolicySpec{
+			Action: policyV1alpha1.AuthorizationActionDeny,
+			Rules: []policyV1alpha1.AuthorizationRule{
+				{
+					Sources: []policyV1alpha1.AuthorizationSource{{Kind: policyV1alpha1.KindServiceAccount, Name: "sa1"}},
+					Paths:   []string{"/api/admin"},
+				},
+			},
+		},
+	}
+
+	compiled := CompileAuthorizationPolicies([]*policyV1alpha1.AuthorizationPolicy{allowReaders, denyAdmin}, "cluster.local")
+	a.False(compiled.DefaultAllow)
+	a.Len(compiled.Rules, 3)
+
+	// DENY policies are evaluated first for the same priority
+	a.Equal("test/deny-admin", compiled.Rules[0].Policy)
+	a.Equal([]string{"sa1.test"}, compiled.Rules[0].Principals)
+	a.Equal("test/allow-readers", compiled.Rules[1].Policy)
+	a.Equal([]string{"*.client"}, compiled.Rules[1].Principals)
+	a.Equal(map[string][]string{"x-tenant": {"foo"}}, compiled.Rules[2].Headers)
+
+	testCases := []struct {
+		name          string
+		req           *trafficpolicy.AuthorizationRequest
+		expectAllowed bool
+		expectRule    *trafficpolicy.AuthorizationRule
+	}{
+		{
+			name:          "denied by the DENY rule",
+			req:           &trafficpolicy.AuthorizationRequest{Principal: "sa1.test", Method: "GET", Path: "/api/admin/users"},
+			expectAllowed: false,
+			expectRule:    compiled.Rules[0],
+		},
+		{
+			name:          "allowed by the source namespace",
+			req:           &trafficpolicy.AuthorizationRequest{Principal: "reader.client", Method: "get", Path: "/api/items?limit=1"},
+			expectAllowed: true,
+			expectRule:    compiled.Rules[1],
+		},
+		{
+			name:          "denied by default for a method not allowed",
+			req:           &trafficpolicy.AuthorizationRequest{Principal: "reader.client", Method: "DELETE", Path: "/api/items"},
+			expectAllowed: false,
+		},
+		{
+			name: "allowed by the header and nested list claim",
+			req: &trafficpolicy.AuthorizationRequest{
+				Method:  "DELETE",
+				Path:    "/api/items",
+				Headers: map[string]string{"x-tenant": "foo"},
+				Claims:  map[string]interface{}{"realm": map[string]interface{}{"groups": []interface{}{"dev", "admin"}}},
+			},
+			expectAllowed: true,
+			expectRule:    compiled.Rules[2],
+		},
+		{
+			name: "denied by default for a claim not matching",
+			req: &trafficpolicy.AuthorizationRequest{
+				Method:  "DELETE",
+				Path:    "/api/items",
+				Headers: map[string]string{"x-tenant": "foo"},
+				Claims:  map[string]interface{}{"realm": map[string]interface{}{"groups": []interface{}{"dev"}}},
+			},
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			allowed, rule := compiled.Evaluate(tc.req)
+			assert.Equal(t, tc.expectAllowed, allowed)
+			assert.Equal(t, tc.expectRule, rule)
+		})
+	}
+
+	// Only DENY policies allow the requests matching no rule
+	compiled = CompileAuthorizationPolicies([]*policyV1alpha1.AuthorizationPolicy{denyAdmin}, "cluster.local")
+	allowed, rule := compiled.Evaluate(&trafficpolicy.AuthorizationRequest{Principal: "sa2.test", Method: "GET", Path: "/api/admin"})
+	a.True(allowed)
+	a.Nil(rule)
+}
@@ -101,6 +101,34 @@ func NewPolicyController(informerCollection *informers.InformerCollection, kubeC
 	}
 	client.informers.AddEventHandler(informers.InformerKeyUpstreamTrafficSetting, k8s.GetEventHandlerFuncs(shouldObserve, upstreamTrafficSettingEventTypes, msgBroker))
 
+	requestAuthenticationEventTypes := k8s.EventTypes{
+		Add:    announcements.RequestAuthenticationAdded,
+		Update: announcements.RequestAuthenticationUpdated,
+		Delete: announcements.RequestAuthenticationDeleted,
+	}
+	client.informers.AddEventHandler(informers.InformerKeyRequestAuthentication, k8s.GetEventHandlerFuncs(shouldObserve, requestAuthenticationEventTypes, msgBroker))
+
+	authorizationPolicyEventTypes := k8s.EventTypes{
+		Add:    announcements.AuthorizationPolicyAdded,
+		Update: announcements.AuthorizationPolicyUpdated,
+		Delete: announcements.AuthorizationPolicyDeleted,
+	}
+	client.informers.AddEventHandler(informers.InformerKeyAuthorizationPolicy, k8s.GetEventHandlerFuncs(shouldObserve, authorizationPolicyEventTypes, msgBroker))
+
+	sidecarScopeEventTypes := k8s.EventTypes{
+		Add:    announcements.SidecarScopeAdded,
+		Update: announcements.SidecarScopeUpdated,
+		Delete: announcements.SidecarScopeDeleted,
+	}
+	client.informers.AddEventHandler(informers.InformerKeySidecarScope, k8s.GetEventHandlerFuncs(shouldObserve, sidecarScopeEventTypes, msgBroker))
+
+	meshFaultInjectionEventTypes := k8s.EventTypes{
+		Add:    announcements.MeshFaultInjectionAdded,
+		Update: announcements.MeshFaultInjectionUpdated,
+		Delete: announcements.MeshFaultInjectionDeleted,
+	}
+	client.informers.AddEventHandler(informers.InformerKeyMeshFaultInjection, k8s.GetEventHandlerFuncs(shouldObserve, meshFaultInjectionEventTypes, msgBroker))
+
 	return client
 }
 
@@ -219,6 +247,104 @@ func (c *Client) GetAccessControlPolicy(svc service.MeshService) *policyv1alpha1
 	return nil
 }
 
+// ListRequestAuthenticationPolicies returns the RequestAuthentication policies
+func (c *Client) ListRequestAuthenticationPolicies() []*policyv1alpha1.RequestAuthentication {
+	var authnPolicies []*policyv1alpha1.RequestAuthentication
+	for _, authnIface := range c.informers.List(informers.InformerKeyRequestAuthentication) {
+		authn := authnIface.(*policyv1alpha1.RequestAuthentication)
+		authnPolicies = append(authnPolicies, authn)
+	}
+
+	return authnPolicies
+}
+
+// GetRequestAuthenticationPolicy returns the RequestAuthentication policy for the given backend MeshService
+func (c *Client) GetRequestAuthenticationPolicy(svc service.MeshService) *policyv1alpha1.RequestAuthentication {
+	var namespaceWide *policyv1alpha1.RequestAuthentication
+	for _, authnIface := range c.informers.List(informers.InformerKeyRequestAuthentication) {
+		authn := authnIface.(*policyv1alpha1.RequestAuthentication)
+
+		if authn.Namespace != svc.Namespace {
+			continue
+		}
+
+		// A policy without backends applies to all the backends in the namespace,
+		// it is overridden by the policy specifying the given backend.
+		if len(authn.Spec.Backends) == 0 {
+			if namespaceWide == nil {
+				namespaceWide = authn
+			}
+			continue
+		}
+
+		for _, backend := range authn.Spec.Backends {
+			if backend.Name == svc.Name && backend.Port.Number == int(svc.TargetPort) {
+				return authn
+			}
+		}
+	}
+
+	return namespaceWide
+}
+
+// ListAuthorizationPolicies returns the AuthorizationPolicy policies for the given backend MeshService
+func (c *Client) ListAuthorizationPolicies(svc service.MeshService) []*policyv1alpha1.AuthorizationPolicy {
+	var policies []*policyv1alpha1.AuthorizationPolicy
+	for _, authzIface := range c.informers.List(informers.InformerKeyAuthorizationPolicy) {
+		authz := authzIface.(*policyv1alpha1.AuthorizationPolicy)
+
+		if AuthorizationPolicyAppliesTo(authz, svc) {
+			policies = append(policies, authz)
+		}
+	}
+
+	return policies
+}
+
+// GetSidecarScope returns the SidecarScope policy applied to a pod with the given labels in the given namespace,
+// a policy selecting the pod by its workload selector takes precedence over a namespace wide policy
+func (c *Client) GetSidecarScope(namespace string, podLabels map[string]string) *policyv1alpha1.SidecarScope {
+	var selected, namespaceWide *policyv1alpha1.SidecarScope
+	for _, scopeIface := range c.informers.List(informers.InformerKeySidecarScope) {
+		scope := scopeIface.(*policyv1alpha1.SidecarScope)
+		if scope.Namespace != namespace || !SidecarScopeSelects(scope, podLabels) {
+			continue
+		}
+
+		// Pick the policy sorted first by name when several policies apply
+		if scope.Spec.WorkloadSelector != nil {
+			if selected == nil || scope.Name < selected.Name {
+				selected = scope
+			}
+		} else if namespaceWide == nil || scope.Name < namespaceWide.Name {
+			namespaceWide = scope
+		}
+	}
+
+	if selected != nil {
+		return selected
+	}
+	return namespaceWide
+}
+
+// GetMeshFaultInjection returns the MeshFaultInjection policy applied to the requests to the given upstream
+// MeshService sent by a pod with the given labels in the given namespace
+func (c *Client) GetMeshFaultInjection(svc service.MeshService, namespace string, podLabels map[string]string) *policyv1alpha1.MeshFaultInjection {
+	var selected *policyv1alpha1.MeshFaultInjection
+	for _, faultIface := range c.informers.List(informers.InformerKeyMeshFaultInjection) {
+		fault := faultIface.(*policyv1alpha1.MeshFaultInjection)
+		if !MeshFaultInjectionTargets(fault, svc) || !MeshFaultInjectionSelects(fault, namespace, podLabels) {
+			continue
+		}
+
+		// Pick the policy sorted first by name when several policies apply
+		if selected == nil || fault.Name < selected.Name {
+			selected = fault
+		}
+	}
+	return selected
+}
+
 // GetTrafficWarmupPolicy returns the TrafficWarmup policy for the given backend MeshService
 func (c *Client) GetTrafficWarmupPolicy(svc service.MeshService) *configv1alpha3.TrafficWarmupSpec {
 	warmupIf, exists, err := c.informers.GetByKey(informers.InformerKeyTrafficWarmup, svc.NamespacedKey())
@@ -534,6 +534,242 @@ func TestGetAccessControlPolicy(t *testing.T) {
 	}
 }
 
+func TestGetRequestAuthenticationPolicy(t *testing.T) {
+	backendAuthn := &policyV1alpha1.RequestAuthentication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "authn-backend-1",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.RequestAuthenticationSpec{
+			Backends: []policyV1alpha1.RequestAuthenticationBackendSpec{
+				{
+					Name: "backend1",
+					Port: policyV1alpha1.PortSpec{
+						Number:   8080,
+						Protocol: "http",
+					},
+				},
+			},
+			JWTRules: []policyV1alpha1.JWTRule{{Issuer: "https://issuer.example.com"}},
+		},
+	}
+	namespaceAuthn := &policyV1alpha1.RequestAuthentication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "authn-namespace",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.RequestAuthenticationSpec{
+			JWTRules: []policyV1alpha1.JWTRule{{Issuer: "https://issuer.example.com"}},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		allResources  []*policyV1alpha1.RequestAuthentication
+		backend       service.MeshService
+		expectedAuthn *policyV1alpha1.RequestAuthentication
+	}{
+		{
+			name:          "RequestAuthentication policy not found",
+			allResources:  nil,
+			backend:       service.MeshService{Name: "backend1", Namespace: "test", TargetPort: 8080},
+			expectedAuthn: nil,
+		},
+		{
+			name:          "RequestAuthentication policy for the backend takes precedence over the namespace wide one",
+			allResources:  []*policyV1alpha1.RequestAuthentication{namespaceAuthn, backendAuthn},
+			backend:       service.MeshService{Name: "backend1", Namespace: "test", TargetPort: 8080},
+			expectedAuthn: backendAuthn,
+		},
+		{
+			name:          "namespace wide RequestAuthentication policy found",
+			allResources:  []*policyV1alpha1.RequestAuthentication{namespaceAuthn, backendAuthn},
+			backend:       service.MeshService{Name: "backend2", Namespace: "test", TargetPort: 8080},
+			expectedAuthn: namespaceAuthn,
+		},
+		{
+			name:          "RequestAuthentication policy in another namespace is ignored",
+			allResources:  []*policyV1alpha1.RequestAuthentication{namespaceAuthn, backendAuthn},
+			backend:       service.MeshService{Name: "backend1", Namespace: "test-1", TargetPort: 8080},
+			expectedAuthn: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			fakeClient := fakePolicyClient.NewSimpleClientset()
+			informerCollection, err := informers.NewInformerCollection("fsm", nil, informers.WithPolicyClient(fakeClient))
+			a.Nil(err)
+			c := NewPolicyController(informerCollection, nil, nil, nil)
+			a.NotNil(c)
+
+			for _, authn := range tc.allResources {
+				_ = c.informers.Add(informers.InformerKeyRequestAuthentication, authn, t)
+			}
+
+			actual := c.GetRequestAuthenticationPolicy(tc.backend)
+			a.Equal(tc.expectedAuthn, actual)
+		})
+	}
+}
+
+func TestGetSidecarScope(t *testing.T) {
+	workloadScope := &policyV1alpha1.SidecarScope{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "scope-bookbuyer",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.SidecarScopeSpec{
+			WorkloadSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "bookbuyer"}},
+			Namespaces:       []string{"bookstore"},
+		},
+	}
+	namespaceScope := &policyV1alpha1.SidecarScope{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "scope-namespace",
+			Namespace: "test",
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		allResources  []*policyV1alpha1.SidecarScope
+		namespace     string
+		podLabels     map[string]string
+		expectedScope *policyV1alpha1.SidecarScope
+	}{
+		{
+			name:          "SidecarScope policy not found",
+			allResources:  nil,
+			namespace:     "test",
+			podLabels:     map[string]string{"app": "bookbuyer"},
+			expectedScope: nil,
+		},
+		{
+			name:          "SidecarScope policy selecting the workload takes precedence over the namespace wide one",
+			allResources:  []*policyV1alpha1.SidecarScope{namespaceScope, workloadScope},
+			namespace:     "test",
+			podLabels:     map[string]string{"app": "bookbuyer"},
+			expectedScope: workloadScope,
+		},
+		{
+			name:          "namespace wide SidecarScope policy found",
+			allResources:  []*policyV1alpha1.SidecarScope{namespaceScope, workloadScope},
+			namespace:     "test",
+			podLabels:     map[string]string{"app": "bookthief"},
+			expectedScope: namespaceScope,
+		},
+		{
+			name:          "SidecarScope policy in another namespace is ignored",
+			allResources:  []*policyV1alpha1.SidecarScope{namespaceScope, workloadScope},
+			namespace:     "test-1",
+			podLabels:     map[string]string{"app": "bookbuyer"},
+			expectedScope: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			fakeClient := fakePolicyClient.NewSimpleClientset()
+			informerCollection, err := informers.NewInformerCollection("fsm", nil, informers.WithPolicyClient(fakeClient))
+			a.Nil(err)
+			c := NewPolicyController(informerCollection, nil, nil, nil)
+			a.NotNil(c)
+
+			for _, scope := range tc.allResources {
+				_ = c.informers.Add(informers.InformerKeySidecarScope, scope, t)
+			}
+
+			actual := c.GetSidecarScope(tc.namespace, tc.podLabels)
+			a.Equal(tc.expectedScope, actual)
+		})
+	}
+}
+
+func TestGetMeshFaultInjection(t *testing.T) {
+	bookbuyerFault := &policyV1alpha1.MeshFaultInjection{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fault-bookbuyer",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.MeshFaultInjectionSpec{
+			Destination: policyV1alpha1.MeshFaultInjectionDestinationSpec{Name: "bookstore"},
+			Sources: &policyV1alpha1.MeshFaultInjectionSourcesSpec{
+				PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "bookbuyer"}},
+			},
+		},
+	}
+	meshFault := &policyV1alpha1.MeshFaultInjection{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fault-mesh",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.MeshFaultInjectionSpec{
+			Destination: policyV1alpha1.MeshFaultInjectionDestinationSpec{Name: "bookstore"},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		allResources  []*policyV1alpha1.MeshFaultInjection
+		svc           service.MeshService
+		podLabels     map[string]string
+		expectedFault *policyV1alpha1.MeshFaultInjection
+	}{
+		{
+			name:          "MeshFaultInjection policy not found",
+			allResources:  nil,
+			svc:           service.MeshService{Name: "bookstore", Namespace: "test"},
+			podLabels:     map[string]string{"app": "bookbuyer"},
+			expectedFault: nil,
+		},
+		{
+			name:          "MeshFaultInjection policy sorted first by name is picked",
+			allResources:  []*policyV1alpha1.MeshFaultInjection{meshFault, bookbuyerFault},
+			svc:           service.MeshService{Name: "bookstore", Namespace: "test"},
+			podLabels:     map[string]string{"app": "bookbuyer"},
+			expectedFault: bookbuyerFault,
+		},
+		{
+			name:          "MeshFaultInjection policy not selecting the pod is ignored",
+			allResources:  []*policyV1alpha1.MeshFaultInjection{meshFault, bookbuyerFault},
+			svc:           service.MeshService{Name: "bookstore", Namespace: "test"},
+			podLabels:     map[string]string{"app": "bookthief"},
+			expectedFault: meshFault,
+		},
+		{
+			name:          "MeshFaultInjection policy for another service is ignored",
+			allResources:  []*policyV1alpha1.MeshFaultInjection{meshFault, bookbuyerFault},
+			svc:           service.MeshService{Name: "bookstore", Namespace: "test-1"},
+			podLabels:     map[string]string{"app": "bookbuyer"},
+			expectedFault: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			fakeClient := fakePolicyClient.NewSimpleClientset()
+			informerCollection, err := informers.NewInformerCollection("fsm", nil, informers.WithPolicyClient(fakeClient))
+			a.Nil(err)
+			c := NewPolicyController(informerCollection, nil, nil, nil)
+			a.NotNil(c)
+
+			for _, fault := range tc.allResources {
+				_ = c.informers.Add(informers.InformerKeyMeshFaultInjection, fault, t)
+			}
+
+			actual := c.GetMeshFaultInjection(tc.svc, "bookbuyer", tc.podLabels)
+			a.Equal(tc.expectedFault, actual)
+		})
+	}
+}
+
 func TestListRetryPolicy(t *testing.T) {
 	var thresholdUintVal uint32 = 3
 	thresholdTimeoutDuration := metav1.Duration{Duration: time.Duration(5 * time.Second)}
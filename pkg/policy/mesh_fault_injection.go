@@ -0,0 +1,51 @@
+package policy
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/service"
+)
+
+// MeshFaultInjectionTargets returns true if the given MeshFaultInjection injects faults into the requests
+// to the given upstream MeshService
+func MeshFaultInjectionTargets(fault *policyv1alpha1.MeshFaultInjection, svc service.MeshService) bool {
+	destination := fault.Spec.Destination
+	if !svc.SiblingTo(service.MeshService{Name: destination.Name, Namespace: fault.Namespace}) {
+		return false
+	}
+	return destination.Port == nil || *destination.Port == svc.Port
+}
+
+// MeshFaultInjectionSelects returns true if the given MeshFaultInjection applies to a downstream pod
+// with the given labels in the given namespace
+func MeshFaultInjectionSelects(fault *policyv1alpha1.MeshFaultInjection, namespace string, podLabels map[string]string) bool {
+	sources := fault.Spec.Sources
+	if sources == nil {
+		return true
+	}
+
+	if len(sources.Namespaces) > 0 {
+		matched := false
+		for _, ns := range sources.Namespaces {
+			if ns == namespace {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if sources.PodSelector == nil {
+		return true
+	}
+	selector, err := metav1.LabelSelectorAsSelector(sources.PodSelector)
+	if err != nil {
+		log.Error().Err(err).Msgf("Invalid pod selector of MeshFaultInjection %s/%s", fault.Namespace, fault.Name)
+		return false
+	}
+	return selector.Matches(labels.Set(podLabels))
+}
@@ -0,0 +1,46 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	policyV1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+
+	"github.com/flomesh-io/fsm/pkg/service"
+)
+
+func TestMeshFaultInjectionTargets(t *testing.T) {
+	fault := &policyV1alpha1.MeshFaultInjection{
+		ObjectMeta: metav1.ObjectMeta{Name: "fault", Namespace: "bookstore"},
+		Spec: policyV1alpha1.MeshFaultInjectionSpec{
+			Destination: policyV1alpha1.MeshFaultInjectionDestinationSpec{Name: "bookstore", Port: ptr.To(uint16(14001))},
+		},
+	}
+
+	assert.True(t, MeshFaultInjectionTargets(fault, service.MeshService{Name: "bookstore", Namespace: "bookstore", Port: 14001}))
+	assert.False(t, MeshFaultInjectionTargets(fault, service.MeshService{Name: "bookstore", Namespace: "bookstore", Port: 80}))
+	assert.False(t, MeshFaultInjectionTargets(fault, service.MeshService{Name: "bookstore", Namespace: "bookbuyer", Port: 14001}))
+
+	fault.Spec.Destination.Port = nil
+	assert.True(t, MeshFaultInjectionTargets(fault, service.MeshService{Name: "bookstore", Namespace: "bookstore", Port: 80}))
+}
+
+func TestMeshFaultInjectionSelects(t *testing.T) {
+	fault := &policyV1alpha1.MeshFaultInjection{
+		ObjectMeta: metav1.ObjectMeta{Name: "fault", Namespace: "bookstore"},
+	}
+	assert.True(t, MeshFaultInjectionSelects(fault, "bookbuyer", nil))
+
+	fault.Spec.Sources = &policyV1alpha1.MeshFaultInjectionSourcesSpec{
+		Namespaces: []string{"bookbuyer"},
+	}
+	assert.True(t, MeshFaultInjectionSelects(fault, "bookbuyer", nil))
+	assert.False(t, MeshFaultInjectionSelects(fault, "bookthief", nil))
+
+	fault.Spec.Sources.PodSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "bookbuyer"}}
+	assert.True(t, MeshFaultInjectionSelects(fault, "bookbuyer", map[string]string{"app": "bookbuyer", "version": "v1"}))
+	assert.False(t, MeshFaultInjectionSelects(fault, "bookbuyer", map[string]string{"app": "bookthief"}))
+}
@@ -81,6 +81,48 @@ func (mr *MockControllerMockRecorder) GetIngressBackendPolicy(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngressBackendPolicy", reflect.TypeOf((*MockController)(nil).GetIngressBackendPolicy), arg0)
 }
 
+// GetMeshFaultInjection mocks base method.
+func (m *MockController) GetMeshFaultInjection(arg0 service.MeshService, arg1 string, arg2 map[string]string) *v1alpha1.MeshFaultInjection {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMeshFaultInjection", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1alpha1.MeshFaultInjection)
+	return ret0
+}
+
+// GetMeshFaultInjection indicates an expected call of GetMeshFaultInjection.
+func (mr *MockControllerMockRecorder) GetMeshFaultInjection(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMeshFaultInjection", reflect.TypeOf((*MockController)(nil).GetMeshFaultInjection), arg0, arg1, arg2)
+}
+
+// GetRequestAuthenticationPolicy mocks base method.
+func (m *MockController) GetRequestAuthenticationPolicy(arg0 service.MeshService) *v1alpha1.RequestAuthentication {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestAuthenticationPolicy", arg0)
+	ret0, _ := ret[0].(*v1alpha1.RequestAuthentication)
+	return ret0
+}
+
+// GetRequestAuthenticationPolicy indicates an expected call of GetRequestAuthenticationPolicy.
+func (mr *MockControllerMockRecorder) GetRequestAuthenticationPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestAuthenticationPolicy", reflect.TypeOf((*MockController)(nil).GetRequestAuthenticationPolicy), arg0)
+}
+
+// GetSidecarScope mocks base method.
+func (m *MockController) GetSidecarScope(arg0 string, arg1 map[string]string) *v1alpha1.SidecarScope {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSidecarScope", arg0, arg1)
+	ret0, _ := ret[0].(*v1alpha1.SidecarScope)
+	return ret0
+}
+
+// GetSidecarScope indicates an expected call of GetSidecarScope.
+func (mr *MockControllerMockRecorder) GetSidecarScope(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSidecarScope", reflect.TypeOf((*MockController)(nil).GetSidecarScope), arg0, arg1)
+}
+
 // GetTrafficWarmupPolicy mocks base method.
 func (m *MockController) GetTrafficWarmupPolicy(arg0 service.MeshService) *v1alpha3.TrafficWarmupSpec {
 	m.ctrl.T.Helper()
@@ -109,6 +151,20 @@ func (mr *MockControllerMockRecorder) GetUpstreamTrafficSetting(arg0 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpstreamTrafficSetting", reflect.TypeOf((*MockController)(nil).GetUpstreamTrafficSetting), arg0)
 }
 
+// ListAuthorizationPolicies mocks base method.
+func (m *MockController) ListAuthorizationPolicies(arg0 service.MeshService) []*v1alpha1.AuthorizationPolicy {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuthorizationPolicies", arg0)
+	ret0, _ := ret[0].([]*v1alpha1.AuthorizationPolicy)
+	return ret0
+}
+
+// ListAuthorizationPolicies indicates an expected call of ListAuthorizationPolicies.
+func (mr *MockControllerMockRecorder) ListAuthorizationPolicies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuthorizationPolicies", reflect.TypeOf((*MockController)(nil).ListAuthorizationPolicies), arg0)
+}
+
 // ListEgressGateways mocks base method.
 func (m *MockController) ListEgressGateways() []*v1alpha1.EgressGateway {
 	m.ctrl.T.Helper()
@@ -151,6 +207,20 @@ func (mr *MockControllerMockRecorder) ListIsolationPolicies() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIsolationPolicies", reflect.TypeOf((*MockController)(nil).ListIsolationPolicies))
 }
 
+// ListRequestAuthenticationPolicies mocks base method.
+func (m *MockController) ListRequestAuthenticationPolicies() []*v1alpha1.RequestAuthentication {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRequestAuthenticationPolicies")
+	ret0, _ := ret[0].([]*v1alpha1.RequestAuthentication)
+	return ret0
+}
+
+// ListRequestAuthenticationPolicies indicates an expected call of ListRequestAuthenticationPolicies.
+func (mr *MockControllerMockRecorder) ListRequestAuthenticationPolicies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRequestAuthenticationPolicies", reflect.TypeOf((*MockController)(nil).ListRequestAuthenticationPolicies))
+}
+
 // ListRetryPolicies mocks base method.
 func (m *MockController) ListRetryPolicies(arg0 identity.K8sServiceAccount) []*v1alpha1.Retry {
 	m.ctrl.T.Helper()
@@ -0,0 +1,67 @@
+package policy
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/service"
+)
+
+// SidecarScopeSelects returns true if the given SidecarScope selects a pod with the given labels,
+// a SidecarScope without a workload selector selects all the pods in its namespace
+func SidecarScopeSelects(scope *policyv1alpha1.SidecarScope, podLabels map[string]string) bool {
+	if scope.Spec.WorkloadSelector == nil {
+		return true
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(scope.Spec.WorkloadSelector)
+	if err != nil {
+		log.Error().Err(err).Msgf("Invalid workload selector of SidecarScope %s/%s", scope.Namespace, scope.Name)
+		return false
+	}
+
+	return selector.Matches(labels.Set(podLabels))
+}
+
+// SidecarScopeAllows returns true if the given upstream MeshService is reachable within the given SidecarScope
+func SidecarScopeAllows(scope *policyv1alpha1.SidecarScope, svc service.MeshService) bool {
+	namespace := svc.Namespace
+	if len(svc.CloudAttachedNamespace) > 0 {
+		namespace = svc.CloudAttachedNamespace
+	}
+
+	if namespace == scope.Namespace {
+		return true
+	}
+
+	for _, ns := range scope.Spec.Namespaces {
+		if ns == "*" || ns == namespace {
+			return true
+		}
+	}
+
+	for _, scopeSvc := range scope.Spec.Services {
+		scopeNs := scopeSvc.Namespace
+		if len(scopeNs) == 0 {
+			scopeNs = scope.Namespace
+		}
+		if scopeNs == namespace && scopeSvc.Name == svc.ProviderKey() {
+			return true
+		}
+	}
+
+	fqdn := service.MeshService{Name: svc.ProviderKey(), Namespace: namespace}.FQDN()
+	for _, host := range scope.Spec.Hosts {
+		if host == fqdn {
+			return true
+		}
+		if strings.HasPrefix(host, "*.") && strings.HasSuffix(fqdn, host[1:]) {
+			return true
+		}
+	}
+
+	return false
+}
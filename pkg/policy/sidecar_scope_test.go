@@ -0,0 +1,75 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	policyV1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+
+	"github.com/flomesh-io/fsm/pkg/service"
+)
+
+func TestSidecarScopeAllows(t *testing.T) {
+	scope := &policyV1alpha1.SidecarScope{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "scope",
+			Namespace: "bookbuyer",
+		},
+		Spec: policyV1alpha1.SidecarScopeSpec{
+			Namespaces: []string{"bookstore"},
+			Services: []policyV1alpha1.SidecarScopeServiceSpec{
+				{Name: "bookwarehouse", Namespace: "bookwarehouse"},
+			},
+			Hosts: []string{"*.mysql.svc.cluster.local"},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		svc      service.MeshService
+		expected bool
+	}{
+		{
+			name:     "service in the namespace of the scope",
+			svc:      service.MeshService{Name: "bookbuyer", Namespace: "bookbuyer"},
+			expected: true,
+		},
+		{
+			name:     "service in a listed namespace",
+			svc:      service.MeshService{Name: "bookstore-v1", Namespace: "bookstore"},
+			expected: true,
+		},
+		{
+			name:     "listed service",
+			svc:      service.MeshService{Name: "bookwarehouse", Namespace: "bookwarehouse"},
+			expected: true,
+		},
+		{
+			name:     "service not listed in a namespace with a listed service",
+			svc:      service.MeshService{Name: "other", Namespace: "bookwarehouse"},
+			expected: false,
+		},
+		{
+			name:     "service matching a wildcard host",
+			svc:      service.MeshService{Name: "mysql-0.mysql", Namespace: "mysql"},
+			expected: true,
+		},
+		{
+			name:     "service out of the scope",
+			svc:      service.MeshService{Name: "bookthief", Namespace: "bookthief"},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, SidecarScopeAllows(scope, tc.svc))
+		})
+	}
+
+	wildcard := scope.DeepCopy()
+	wildcard.Spec.Namespaces = []string{"*"}
+	assert.True(t, SidecarScopeAllows(wildcard, service.MeshService{Name: "bookthief", Namespace: "bookthief"}))
+}
@@ -50,6 +50,22 @@ type Controller interface {
 	// GetAccessControlPolicy returns the AccessControl policy for the given backend MeshService
 	GetAccessControlPolicy(service.MeshService) *policyv1alpha1.AccessControl
 
+	// GetRequestAuthenticationPolicy returns the RequestAuthentication policy for the given backend MeshService
+	GetRequestAuthenticationPolicy(service.MeshService) *policyv1alpha1.RequestAuthentication
+
+	// ListRequestAuthenticationPolicies returns the RequestAuthentication policies
+	ListRequestAuthenticationPolicies() []*policyv1alpha1.RequestAuthentication
+
+	// ListAuthorizationPolicies returns the AuthorizationPolicy policies for the given backend MeshService
+	ListAuthorizationPolicies(service.MeshService) []*policyv1alpha1.AuthorizationPolicy
+
+	// GetSidecarScope returns the SidecarScope policy applied to a pod with the given labels in the given namespace
+	GetSidecarScope(string, map[string]string) *policyv1alpha1.SidecarScope
+
+	// GetMeshFaultInjection returns the MeshFaultInjection policy applied to the requests to the given upstream MeshService
+	// sent by a pod with the given labels in the given namespace
+	GetMeshFaultInjection(service.MeshService, string, map[string]string) *policyv1alpha1.MeshFaultInjection
+
 	// GetTrafficWarmupPolicy returns the TrafficWarmup policy for the given backend MeshService
 	GetTrafficWarmupPolicy(svc service.MeshService) *configv1alpha3.TrafficWarmupSpec
 
@@ -0,0 +1,372 @@
+package propagation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	smiSplit "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha4"
+	smiSplitClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/flomesh-io/fsm/pkg/announcements"
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/k8s"
+	"github.com/flomesh-io/fsm/pkg/k8s/events"
+	"github.com/flomesh-io/fsm/pkg/messaging"
+	"github.com/flomesh-io/fsm/pkg/telemetry"
+)
+
+// NewTracker returns a tracker of the propagation of policy changes to the proxies
+func NewTracker(kubeController k8s.Controller, splitClient smiSplitClient.Interface, msgBroker *messaging.Broker) *Tracker {
+	return &Tracker{
+		kubeController: kubeController,
+		splitClient:    splitClient,
+		msgBroker:      msgBroker,
+		scraper:        telemetry.NewScraper(configVersionMetric),
+		policies:       make(map[Policy]*policyGeneration),
+		proxies:        make(map[proxyKey]*proxyConfig),
+	}
+}
+
+// Run observes the changes of the tracked policies and periodically checks which proxies loaded them
+func (t *Tracker) Run(stop <-chan struct{}) {
+	kubePubSub := t.msgBroker.GetKubeEventPubSub()
+	policyUpdateChan := kubePubSub.Sub(
+		announcements.TrafficSplitAdded.String(),
+		announcements.TrafficSplitUpdated.String(),
+		announcements.TrafficSplitDeleted.String(),
+		announcements.AccessControlAdded.String(),
+		announcements.AccessControlUpdated.String(),
+		announcements.AccessControlDeleted.String(),
+	)
+	defer t.msgBroker.Unsub(kubePubSub, policyUpdateChan)
+
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			log.Info().Msg("Received stop signal, exiting config propagation tracker")
+			return
+
+		case msg, ok := <-policyUpdateChan:
+			if !ok {
+				log.Warn().Msgf("Notification channel closed for policy updates")
+				continue
+			}
+
+			event, ok := msg.(events.PubSubMessage)
+			if !ok {
+				log.Error().Msgf("Received unexpected message %T on channel, expected PubSubMessage", msg)
+				continue
+			}
+			t.observe(event)
+
+		case <-ticker.C:
+			t.refresh()
+		}
+	}
+}
+
+// observe records the generation of the policy of the given event
+func (t *Tracker) observe(msg events.PubSubMessage) {
+	obj := msg.NewObj
+	if obj == nil {
+		obj = msg.OldObj
+	}
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	var policy Policy
+	var object metav1.Object
+	var accessControl *policyv1alpha1.AccessControl
+	var trafficSplit *smiSplit.TrafficSplit
+	switch o := obj.(type) {
+	case *smiSplit.TrafficSplit:
+		policy = Policy{Kind: KindTrafficSplit, Namespace: o.Namespace, Name: o.Name}
+		object = o
+		trafficSplit = o
+	case *policyv1alpha1.AccessControl:
+		policy = Policy{Kind: KindAccessControl, Namespace: o.Namespace, Name: o.Name}
+		object = o
+		accessControl = o
+	default:
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if msg.NewObj == nil {
+		delete(t.policies, policy)
+		return
+	}
+
+	gen, ok := t.policies[policy]
+	if !ok || gen.generation != object.GetGeneration() {
+		observedAt := msg.Timestamp
+		if observedAt.IsZero() {
+			observedAt = time.Now()
+		}
+		gen = &policyGeneration{generation: object.GetGeneration(), observedAt: observedAt}
+		t.policies[policy] = gen
+	}
+	gen.accessControl = accessControl
+	gen.trafficSplit = trafficSplit
+}
+
+// RecordConfig records the version of the config generated for the proxy of the given pod,
+// generatedAt is the time the generation of the config started
+func (t *Tracker) RecordConfig(namespace, name string, generatedAt time.Time, version uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := proxyKey{namespace: namespace, name: name}
+	config, ok := t.proxies[key]
+	if !ok {
+		config = &proxyConfig{}
+		t.proxies[key] = config
+	}
+	if config.version != version {
+		config.version = version
+		config.loaded = false
+	}
+	if generatedAt.After(config.generatedAt) {
+		config.generatedAt = generatedAt
+	}
+}
+
+// refresh forgets the proxies whose pods are gone, checks which proxies loaded their latest
+// config and updates the status of the policies accordingly
+func (t *Tracker) refresh() {
+	pods := make(map[proxyKey]*corev1.Pod)
+	for _, pod := range t.kubeController.ListPods() {
+		pods[proxyKey{namespace: pod.Namespace, name: pod.Name}] = pod
+	}
+
+	var unloaded []*corev1.Pod
+	t.mu.Lock()
+	for key, config := range t.proxies {
+		pod, ok := pods[key]
+		if !ok {
+			delete(t.proxies, key)
+			continue
+		}
+		if !config.loaded && telemetry.IsScrapable(pod) {
+			unloaded = append(unloaded, pod)
+		}
+	}
+	t.mu.Unlock()
+
+	t.scrape(unloaded)
+	t.updateStatuses()
+}
+
+// scrape checks which of the given pods loaded their latest config
+func (t *Tracker) scrape(pods []*corev1.Pod) {
+	t.scraper.ScrapeAll(pods, func(pod *corev1.Pod, samples []telemetry.Sample) {
+		version, ok := loadedVersion(samples)
+		if !ok {
+			log.Debug().Msgf("Metric %s not found for pod %s/%s", configVersionMetric, pod.Namespace, pod.Name)
+			return
+		}
+		t.setLoaded(proxyKey{namespace: pod.Namespace, name: pod.Name}, version)
+	})
+}
+
+// loadedVersion returns the version of the config loaded by a sidecar from its samples
+func loadedVersion(samples []telemetry.Sample) (uint64, bool) {
+	for _, sample := range samples {
+		if sample.Name == configVersionMetric && sample.Value >= 0 {
+			return uint64(sample.Value), true
+		}
+	}
+	return 0, false
+}
+
+// setLoaded marks the config of the given proxy as loaded if the version loaded by the proxy matches it
+func (t *Tracker) setLoaded(key proxyKey, loadedVersion uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if config, ok := t.proxies[key]; ok && config.version%configVersionModulus == loadedVersion {
+		config.loaded = true
+	}
+}
+
+// updateStatuses sets the Programmed condition of the AccessControl and TrafficSplit policies whose propagation changed,
+// the condition of TrafficSplit policies is set as an annotation as they have no status
+func (t *Tracker) updateStatuses() {
+	var updates []*policyv1alpha1.AccessControl
+	splitUpdates := make(map[*smiSplit.TrafficSplit]metav1.Condition)
+
+	t.mu.RLock()
+	for policy, gen := range t.policies {
+		if gen.trafficSplit != nil {
+			condition := programmedCondition(t.status(policy, gen))
+			if !programmedConditionAnnotated(gen.trafficSplit, condition) {
+				splitUpdates[gen.trafficSplit] = condition
+			}
+			continue
+		}
+		if gen.accessControl == nil {
+			continue
+		}
+
+		condition := programmedCondition(t.status(policy, gen))
+		if current := meta.FindStatusCondition(gen.accessControl.Status.Conditions, condition.Type); current != nil &&
+			current.Status == condition.Status &&
+			current.ObservedGeneration == condition.ObservedGeneration &&
+			current.Message == condition.Message {
+			continue
+		}
+
+		acl := gen.accessControl.DeepCopy()
+		meta.SetStatusCondition(&acl.Status.Conditions, condition)
+		updates = append(updates, acl)
+	}
+	t.mu.RUnlock()
+
+	for _, acl := range updates {
+		if _, err := t.kubeController.UpdateStatus(acl); err != nil {
+			log.Warn().Err(err).Msgf("Error updating the %s condition of AccessControl %s/%s", ProgrammedConditionType, acl.Namespace, acl.Name)
+		}
+	}
+
+	for split, condition := range splitUpdates {
+		if err := t.annotateProgrammedCondition(split, condition); err != nil {
+			log.Warn().Err(err).Msgf("Error updating the %s condition of TrafficSplit %s/%s", ProgrammedConditionType, split.Namespace, split.Name)
+		}
+	}
+}
+
+// programmedConditionAnnotated returns true if the TrafficSplit is annotated with the given Programmed condition
+func programmedConditionAnnotated(split *smiSplit.TrafficSplit, condition metav1.Condition) bool {
+	value, ok := split.Annotations[ProgrammedConditionAnnotation]
+	if !ok {
+		return false
+	}
+
+	var current metav1.Condition
+	if err := json.Unmarshal([]byte(value), &current); err != nil {
+		return false
+	}
+	return current.Status == condition.Status &&
+		current.ObservedGeneration == condition.ObservedGeneration &&
+		current.Message == condition.Message
+}
+
+// annotateProgrammedCondition sets the Programmed condition annotation of the TrafficSplit with a merge patch,
+// the transition time is kept while the status of the condition is unchanged
+func (t *Tracker) annotateProgrammedCondition(split *smiSplit.TrafficSplit, condition metav1.Condition) error {
+	var current metav1.Condition
+	if value, ok := split.Annotations[ProgrammedConditionAnnotation]; ok && json.Unmarshal([]byte(value), &current) == nil &&
+		current.Status == condition.Status {
+		condition.LastTransitionTime = current.LastTransitionTime
+	} else {
+		condition.LastTransitionTime = metav1.Now()
+	}
+
+	value, err := json.Marshal(condition)
+	if err != nil {
+		return err
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{ProgrammedConditionAnnotation: string(value)},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = t.splitClient.SplitV1alpha4().TrafficSplits(split.Namespace).Patch(context.Background(), split.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// status returns the propagation of the given policy generation, the caller must hold the lock
+func (t *Tracker) status(policy Policy, gen *policyGeneration) PolicyStatus {
+	status := PolicyStatus{
+		Policy:     policy,
+		Generation: gen.generation,
+		ObservedAt: gen.observedAt,
+		Proxies:    len(t.proxies),
+	}
+
+	for key, config := range t.proxies {
+		if !config.generatedAt.Before(gen.observedAt) {
+			status.Generated++
+			if config.loaded {
+				status.Programmed++
+				continue
+			}
+		}
+		status.Pending = append(status.Pending, key.namespace+"/"+key.name)
+	}
+	sort.Strings(status.Pending)
+	return status
+}
+
+// programmedCondition returns the Programmed condition of a policy with the given propagation
+func programmedCondition(status PolicyStatus) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               ProgrammedConditionType,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: status.Generation,
+		Reason:             "Pending",
+		Message:            fmt.Sprintf("%d/%d proxies", status.Programmed, status.Proxies),
+	}
+	if status.Programmed == status.Proxies {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "Programmed"
+	}
+	return condition
+}
+
+// ListPolicies returns the propagation of the latest generation of the tracked policies,
+// optionally filtered by the namespace of the policies
+func (t *Tracker) ListPolicies(namespace string) []PolicyStatus {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	list := make([]PolicyStatus, 0, len(t.policies))
+	for policy, gen := range t.policies {
+		if len(namespace) > 0 && policy.Namespace != namespace {
+			continue
+		}
+		list = append(list, t.status(policy, gen))
+	}
+	sort.Slice(list, func(i, j int) bool {
+		a, b := list[i].Policy, list[j].Policy
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	return list
+}
+
+// Handler returns the HTTP handler serving the propagation of the tracked policies as JSON,
+// the namespace query parameter limits the policies to the policies of a namespace
+func (t *Tracker) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		policies := t.ListPolicies(req.URL.Query().Get("namespace"))
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(policies); err != nil {
+			log.Error().Err(err).Msg("Error encoding config propagation status")
+		}
+	})
+}
@@ -0,0 +1,125 @@
+package propagation
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	smiSplit "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha4"
+	smiSplitFake "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned/fake"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/flomesh-io/fsm/pkg/announcements"
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/k8s/events"
+	"github.com/flomesh-io/fsm/pkg/telemetry"
+)
+
+func TestListPolicies(t *testing.T) {
+	assert := tassert.New(t)
+
+	tracker := NewTracker(nil, nil, nil)
+	observedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	acl := &policyv1alpha1.AccessControl{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "acl", Generation: 2}}
+	tracker.observe(events.PubSubMessage{Kind: announcements.AccessControlAdded, NewObj: acl, Timestamp: observedAt})
+	split := &smiSplit.TrafficSplit{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "split", Generation: 1}}
+	tracker.observe(events.PubSubMessage{Kind: announcements.TrafficSplitAdded, NewObj: split, Timestamp: observedAt})
+
+	// generated before the change
+	tracker.RecordConfig("ns", "pod-1", observedAt.Add(-time.Second), 1_000_000_001)
+	// generated after the change, loaded
+	tracker.RecordConfig("ns", "pod-2", observedAt.Add(time.Second), 2_000_000_002)
+	tracker.setLoaded(proxyKey{namespace: "ns", name: "pod-2"}, 2)
+	// generated after the change, not loaded yet
+	tracker.RecordConfig("ns", "pod-3", observedAt.Add(time.Second), 3_000_000_003)
+	tracker.setLoaded(proxyKey{namespace: "ns", name: "pod-3"}, 4)
+
+	policies := tracker.ListPolicies("")
+	assert.Len(policies, 2)
+	assert.Equal(Policy{Kind: KindAccessControl, Namespace: "ns", Name: "acl"}, policies[0].Policy)
+	assert.Equal(int64(2), policies[0].Generation)
+	assert.Equal(3, policies[0].Proxies)
+	assert.Equal(2, policies[0].Generated)
+	assert.Equal(1, policies[0].Programmed)
+	assert.Equal([]string{"ns/pod-1", "ns/pod-3"}, policies[0].Pending)
+
+	condition := programmedCondition(policies[0])
+	assert.Equal(metav1.ConditionFalse, condition.Status)
+	assert.Equal("1/3 proxies", condition.Message)
+	assert.Equal(int64(2), condition.ObservedGeneration)
+
+	// a status update does not reset the tracked generation
+	tracker.observe(events.PubSubMessage{Kind: announcements.AccessControlUpdated, OldObj: acl, NewObj: acl.DeepCopy(), Timestamp: observedAt.Add(time.Hour)})
+	assert.Equal(observedAt, tracker.ListPolicies("ns")[0].ObservedAt)
+
+	// a regenerated config with the same version stays loaded
+	tracker.RecordConfig("ns", "pod-2", observedAt.Add(time.Minute), 2_000_000_002)
+	// a new version must be loaded again
+	tracker.RecordConfig("ns", "pod-3", observedAt.Add(time.Minute), 5_000_000_005)
+	tracker.setLoaded(proxyKey{namespace: "ns", name: "pod-3"}, 5)
+	tracker.RecordConfig("ns", "pod-1", observedAt.Add(time.Minute), 1_000_000_001)
+	tracker.setLoaded(proxyKey{namespace: "ns", name: "pod-1"}, 1)
+
+	status := tracker.ListPolicies("ns")[0]
+	assert.Equal(3, status.Programmed)
+	assert.Empty(status.Pending)
+	assert.Equal(metav1.ConditionTrue, programmedCondition(status).Status)
+
+	tracker.observe(events.PubSubMessage{Kind: announcements.AccessControlDeleted, OldObj: acl})
+	assert.Empty(tracker.ListPolicies("ns"))
+	assert.Len(tracker.ListPolicies(""), 1)
+}
+
+func TestTrafficSplitProgrammedCondition(t *testing.T) {
+	assert := tassert.New(t)
+
+	split := &smiSplit.TrafficSplit{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "split", Generation: 1}}
+	splitClient := smiSplitFake.NewSimpleClientset(split)
+	tracker := NewTracker(nil, splitClient, nil)
+	observedAt := time.Now()
+
+	tracker.observe(events.PubSubMessage{Kind: announcements.TrafficSplitAdded, NewObj: split, Timestamp: observedAt})
+	tracker.RecordConfig("ns", "pod-1", observedAt.Add(time.Second), 1_000_000_001)
+
+	getCondition := func() metav1.Condition {
+		updated, err := splitClient.SplitV1alpha4().TrafficSplits("ns").Get(context.Background(), "split", metav1.GetOptions{})
+		assert.Nil(err)
+		var condition metav1.Condition
+		assert.Nil(json.Unmarshal([]byte(updated.Annotations[ProgrammedConditionAnnotation]), &condition))
+		return condition
+	}
+
+	tracker.updateStatuses()
+	condition := getCondition()
+	assert.Equal(ProgrammedConditionType, condition.Type)
+	assert.Equal(metav1.ConditionFalse, condition.Status)
+	assert.Equal(int64(1), condition.ObservedGeneration)
+	assert.Equal("0/1 proxies", condition.Message)
+
+	// the annotated TrafficSplit is observed again, its condition is unchanged
+	updated, _ := splitClient.SplitV1alpha4().TrafficSplits("ns").Get(context.Background(), "split", metav1.GetOptions{})
+	tracker.observe(events.PubSubMessage{Kind: announcements.TrafficSplitUpdated, OldObj: split, NewObj: updated})
+	tracker.mu.RLock()
+	assert.True(programmedConditionAnnotated(tracker.policies[Policy{Kind: KindTrafficSplit, Namespace: "ns", Name: "split"}].trafficSplit, condition))
+	tracker.mu.RUnlock()
+
+	tracker.setLoaded(proxyKey{namespace: "ns", name: "pod-1"}, 1)
+	tracker.updateStatuses()
+	condition = getCondition()
+	assert.Equal(metav1.ConditionTrue, condition.Status)
+	assert.Equal("1/1 proxies", condition.Message)
+}
+
+func TestLoadedVersion(t *testing.T) {
+	assert := tassert.New(t)
+
+	version, ok := loadedVersion([]telemetry.Sample{{Name: configVersionMetric, Value: 42}})
+	assert.True(ok)
+	assert.Equal(uint64(42), version)
+
+	_, ok = loadedVersion([]telemetry.Sample{{Name: "other", Value: 42}})
+	assert.False(ok)
+}
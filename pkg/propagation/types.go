@@ -0,0 +1,112 @@
+// Package propagation tracks the propagation of policy changes to the sidecar proxies, from the
+// generation of the proxy configurations to the proxies loading them from the repo.
+package propagation
+
+import (
+	"sync"
+	"time"
+
+	smiSplit "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha4"
+	smiSplitClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/k8s"
+	"github.com/flomesh-io/fsm/pkg/logger"
+	"github.com/flomesh-io/fsm/pkg/messaging"
+	"github.com/flomesh-io/fsm/pkg/telemetry"
+)
+
+var log = logger.New("config-propagation")
+
+const (
+	// configVersionMetric is the sidecar metric exposing the version of the loaded config
+	configVersionMetric = "sidecar_config_version"
+
+	// configVersionModulus is the modulus of the config version exposed by sidecars,
+	// sidecars only expose the last 9 digits of the version to be exact as a metric value
+	configVersionModulus = 1_000_000_000
+
+	// refreshInterval is the interval at which the loaded config versions of the proxies are scraped
+	// while a policy is not programmed on every proxy
+	refreshInterval = 10 * time.Second
+
+	// ProgrammedConditionType is the type of the policy status condition reporting
+	// how many proxies have loaded the latest generation of the policy
+	ProgrammedConditionType = "Programmed"
+
+	// ProgrammedConditionAnnotation is the annotation holding the Programmed condition as JSON
+	// for the policies without a status, such as SMI TrafficSplit policies
+	ProgrammedConditionAnnotation = "flomesh.io/programmed-condition"
+
+	// KindTrafficSplit is the kind of SMI TrafficSplit policies
+	KindTrafficSplit = "TrafficSplit"
+
+	// KindAccessControl is the kind of AccessControl policies
+	KindAccessControl = "AccessControl"
+)
+
+// Policy identifies a policy object whose propagation is tracked
+type Policy struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// PolicyStatus is the propagation of the latest generation of a policy to the proxies
+type PolicyStatus struct {
+	Policy
+
+	Generation int64     `json:"generation"`
+	ObservedAt time.Time `json:"observedAt"`
+
+	// Proxies is the number of proxies connected to the controller
+	Proxies int `json:"proxies"`
+
+	// Generated is the number of proxies whose config was generated after the generation was observed
+	Generated int `json:"generated"`
+
+	// Programmed is the number of proxies that loaded a config including the generation
+	Programmed int `json:"programmed"`
+
+	// Pending is the proxies that have not loaded a config including the generation, as namespace/pod
+	Pending []string `json:"pending,omitempty"`
+}
+
+// policyGeneration is the latest generation of a policy
+type policyGeneration struct {
+	generation int64
+	observedAt time.Time
+
+	// accessControl is the latest observed AccessControl, used to update its status
+	accessControl *policyv1alpha1.AccessControl
+
+	// trafficSplit is the latest observed TrafficSplit, used to update its Programmed condition annotation
+	trafficSplit *smiSplit.TrafficSplit
+}
+
+// proxyKey identifies the pod of a proxy
+type proxyKey struct {
+	namespace string
+	name      string
+}
+
+// proxyConfig is the latest config generated for a proxy
+type proxyConfig struct {
+	generatedAt time.Time
+	version     uint64
+
+	// loaded is true once the proxy exposes the version of the config as loaded
+	loaded bool
+}
+
+// Tracker tracks which proxies have their config generated and loaded since policies changed
+type Tracker struct {
+	kubeController k8s.Controller
+	splitClient    smiSplitClient.Interface
+	msgBroker      *messaging.Broker
+	scraper        *telemetry.Scraper
+
+	mu       sync.RWMutex
+	policies map[Policy]*policyGeneration
+	proxies  map[proxyKey]*proxyConfig
+}
@@ -76,6 +76,7 @@ func (c *client) ListEndpointsForService(svc service.MeshService) []endpoint.End
 					LBType:     kubernetesEndpoints.Annotations[fmt.Sprintf(multicluster.ServiceImportLBTypeAnnotation, address.IP, port.Port)],
 					Weight:     endpoint.Weight(weight),
 					Path:       kubernetesEndpoints.Annotations[fmt.Sprintf(multicluster.ServiceImportContextPathAnnotation, address.IP, port.Port)],
+					ServerName: kubernetesEndpoints.Annotations[fmt.Sprintf(multicluster.ServiceImportServerNameAnnotation, address.IP, port.Port)],
 				}
 				endpoints = append(endpoints, ept)
 			}
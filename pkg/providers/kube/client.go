@@ -80,6 +80,11 @@ func (c *client) ListEndpointsForService(svc service.MeshService) []endpoint.End
 		return nil
 	}
 
+	var zones map[string]string
+	if c.meshConfigurator.GetMeshConfig().Spec.LocalityLoadBalancing.Enable {
+		zones = c.getEndpointZones(svc, kubernetesEndpoints)
+	}
+
 	for _, kubernetesEndpoint := range kubernetesEndpoints.Subsets {
 		for _, port := range kubernetesEndpoint.Ports {
 			// If a TargetPort is specified for the service, filter the endpoint by this port.
@@ -102,6 +107,7 @@ func (c *client) ListEndpointsForService(svc service.MeshService) []endpoint.End
 				ept := endpoint.Endpoint{
 					IP:   ip,
 					Port: endpoint.Port(port.Port),
+					Zone: zones[address.IP],
 				}
 				if port.AppProtocol != nil {
 					ept.AppProtocol = *port.AppProtocol
@@ -122,6 +128,45 @@ func (c *client) ListEndpointsForService(svc service.MeshService) []endpoint.End
 	return endpoints
 }
 
+// getEndpointZones returns the topology zones of the endpoints of the given service keyed by IP address.
+// The zone of the endpoint takes precedence over the zone hinted by the EndpointSlice, which falls back
+// to the zone label of the node the endpoint resides on.
+func (c *client) getEndpointZones(svc service.MeshService, kubernetesEndpoints *corev1.Endpoints) map[string]string {
+	zones := make(map[string]string)
+
+	for _, eps := range c.kubeController.ListEndpointSlicesForService(svc) {
+		for _, ep := range eps.Endpoints {
+			var zone string
+			switch {
+			case ep.Zone != nil && len(*ep.Zone) > 0:
+				zone = *ep.Zone
+			case ep.Hints != nil && len(ep.Hints.ForZones) > 0 && len(ep.Hints.ForZones[0].Name) > 0:
+				zone = ep.Hints.ForZones[0].Name
+			default:
+				continue
+			}
+			for _, addr := range ep.Addresses {
+				zones[addr] = zone
+			}
+		}
+	}
+
+	for _, subset := range kubernetesEndpoints.Subsets {
+		for _, address := range subset.Addresses {
+			if _, exists := zones[address.IP]; exists || address.NodeName == nil {
+				continue
+			}
+			if node := c.kubeController.GetNode(*address.NodeName); node != nil {
+				if zone := node.Labels[corev1.LabelTopologyZone]; len(zone) > 0 {
+					zones[address.IP] = zone
+				}
+			}
+		}
+	}
+
+	return zones
+}
+
 // ListEndpointsForIdentity retrieves the list of IP addresses for the given service account
 // Note: ServiceIdentity must be in the format "name.namespace" [https://github.com/flomesh-io/fsm/issues/3188]
 func (c *client) ListEndpointsForIdentity(serviceIdentity identity.ServiceIdentity) []endpoint.Endpoint {
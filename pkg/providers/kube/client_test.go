@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 	tassert "github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	testclient "k8s.io/client-go/kubernetes/fake"
@@ -37,6 +38,7 @@ var _ = Describe("Test Kube client Provider (w/o kubecontroller)", func() {
 	mockKubeController = k8s.NewMockController(mockCtrl)
 	mockConfigurator = configurator.NewMockConfigurator(mockCtrl)
 	mockConfigurator.EXPECT().GetServiceAccessMode().Return(configv1alpha3.ServiceAccessModeDomain).AnyTimes()
+	mockConfigurator.EXPECT().GetMeshConfig().Return(configv1alpha3.MeshConfig{}).AnyTimes()
 
 	mockKubeController.EXPECT().IsMonitoredNamespace(tests.BookbuyerService.Namespace).Return(true).AnyTimes()
 
@@ -499,3 +501,83 @@ func TestListEndpointsForIdentity(t *testing.T) {
 		})
 	}
 }
+
+func TestListEndpointsForServiceWithZones(t *testing.T) {
+	assert := tassert.New(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockConfigurator := configurator.NewMockConfigurator(mockCtrl)
+	c := NewClient(mockKubeController, mockConfigurator)
+
+	svc := service.MeshService{Name: "s1", Namespace: "ns1", TargetPort: 80}
+	node := "node-2"
+	zoneA := "zone-a"
+	noZone := ""
+
+	mockConfigurator.EXPECT().GetMeshConfig().Return(configv1alpha3.MeshConfig{
+		Spec: configv1alpha3.MeshConfigSpec{
+			LocalityLoadBalancing: configv1alpha3.LocalityLoadBalancingSpec{Enable: true},
+		},
+	}).AnyTimes()
+	mockKubeController.EXPECT().GetService(svc).Return(nil)
+	mockKubeController.EXPECT().GetEndpoints(svc).Return(&corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Name: svc.Name, Namespace: svc.Namespace},
+		Subsets: []corev1.EndpointSubset{
+			{
+				Addresses: []corev1.EndpointAddress{
+					{IP: "10.0.0.1"},
+					{IP: "10.0.0.2", NodeName: &node},
+					{IP: "10.0.0.3"},
+					{IP: "10.0.0.4"},
+					{IP: "10.0.0.5"},
+				},
+				Ports: []corev1.EndpointPort{{Port: 80}},
+			},
+		},
+	}, nil)
+	mockKubeController.EXPECT().ListEndpointSlicesForService(svc).Return([]*discoveryv1.EndpointSlice{
+		{
+			Endpoints: []discoveryv1.Endpoint{
+				{
+					Addresses: []string{"10.0.0.1"},
+					Zone:      &zoneA,
+					Hints:     &discoveryv1.EndpointHints{ForZones: []discoveryv1.ForZone{{Name: "zone-c"}}},
+				},
+				{
+					Addresses: []string{"10.0.0.3"},
+					Zone:      &zoneA,
+				},
+				{
+					Addresses: []string{"10.0.0.4"},
+					Zone:      &noZone,
+					Hints:     &discoveryv1.EndpointHints{ForZones: []discoveryv1.ForZone{{Name: "zone-c"}}},
+				},
+				{
+					Addresses: []string{"10.0.0.5"},
+					Zone:      &noZone,
+				},
+			},
+		},
+	})
+	mockKubeController.EXPECT().GetNode(node).Return(&corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   node,
+			Labels: map[string]string{corev1.LabelTopologyZone: "zone-b"},
+		},
+	})
+
+	zones := make(map[string]string)
+	for _, ep := range c.ListEndpointsForService(svc) {
+		zones[ep.IP.String()] = ep.Zone
+	}
+
+	assert.Equal(map[string]string{
+		"10.0.0.1": "zone-a",
+		"10.0.0.2": "zone-b",
+		"10.0.0.3": "zone-a",
+		"10.0.0.4": "zone-c",
+		"10.0.0.5": "",
+	}, zones)
+}
@@ -18,7 +18,6 @@ import (
 	"github.com/flomesh-io/fsm/pkg/models"
 	"github.com/flomesh-io/fsm/pkg/sidecar/v1/providers/pipy"
 	"github.com/flomesh-io/fsm/pkg/sidecar/v1/providers/pipy/registry"
-	"github.com/flomesh-io/fsm/pkg/workerpool"
 )
 
 // Routine which fulfills listening to proxy broadcasts
@@ -30,7 +29,6 @@ func (s *Server) broadcastListener() {
 
 	proxyCreationChan := s.msgBroker.GetProxyCreationChan()
 	proxyDeletionChan := s.msgBroker.GetProxyDeletionChan()
-	proxyWorkQueues := workerpool.NewWorkerPool(64)
 
 	timerDuration := time.Second * 20
 
@@ -54,7 +52,7 @@ func (s *Server) broadcastListener() {
 		select {
 		case creationPod, ok := <-proxyCreationChan:
 			if ok {
-				s.fireNewConnectProxy(creationPod, proxyWorkQueues)
+				s.fireNewConnectProxy(creationPod)
 			}
 		case deletionPod, ok := <-proxyDeletionChan:
 			if ok {
@@ -98,7 +96,7 @@ func (s *Server) broadcastListener() {
 	}
 }
 
-func (s *Server) fireNewConnectProxy(pod *corev1.Pod, proxyNewWorkQueues *workerpool.WorkerPool) {
+func (s *Server) fireNewConnectProxy(pod *corev1.Pod) {
 	if proxy, err := s.fireExistProxy(pod); err == nil {
 		if proxy.Metadata == nil || proxy.Addr == nil {
 			_ = s.recordPodMetadata(proxy, pod)
@@ -106,14 +104,8 @@ func (s *Server) fireNewConnectProxy(pod *corev1.Pod, proxyNewWorkQueues *worker
 		if backlogs := atomic.LoadInt32(&proxy.Backlogs); backlogs > 0 {
 			return
 		}
-		newJob := func() *PipyConfGeneratorJob {
-			return &PipyConfGeneratorJob{
-				proxy:      proxy,
-				repoServer: s,
-				done:       make(chan struct{}),
-			}
-		}
-		<-proxyNewWorkQueues.AddJob(newJob())
+		// New proxies are configured ahead of the updates pending for connected proxies
+		s.queueConfigUpdate(proxy, newProxyPriority, time.Now())
 	}
 }
 
@@ -163,14 +155,7 @@ func (s *Server) fireConnectedProxies() (map[string]*pipy.Proxy, bool) {
 			if backlogs := atomic.LoadInt32(&proxy.Backlogs); backlogs > 0 {
 				continue
 			}
-			newJob := func() *PipyConfGeneratorJob {
-				return &PipyConfGeneratorJob{
-					proxy:      proxy,
-					repoServer: s,
-					done:       make(chan struct{}),
-				}
-			}
-			<-s.workQueues.AddJob(newJob())
+			s.queueConfigUpdate(proxy, resyncPriority, time.Now())
 		}
 	}
 	return disconnectedProxies, missing
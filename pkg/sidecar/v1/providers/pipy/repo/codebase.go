@@ -21,6 +21,9 @@ var codebaseConnectUpstreamJs []byte
 //go:embed codebase/dns-main.js
 var codebaseDNSMainJs []byte
 
+//go:embed codebase/egress-logging.js
+var codebaseEgressLoggingJs []byte
+
 //go:embed codebase/logging.js
 var codebaseLoggingJs []byte
 
@@ -30,6 +33,9 @@ var codebaseMainJs []byte
 //go:embed codebase/metrics.js
 var codebaseMetricsJs []byte
 
+//go:embed codebase/modules/inbound-http-authz.js
+var codebaseModulesInboundHTTPAuthzJs []byte
+
 //go:embed codebase/modules/inbound-http-default.js
 var codebaseModulesInboundHTTPDefaultJs []byte
 
@@ -39,6 +45,9 @@ var codebaseModulesInboundHTTPLoadBalancingJs []byte
 //go:embed codebase/modules/inbound-http-routing.js
 var codebaseModulesInboundHTTPRoutingJs []byte
 
+//go:embed codebase/modules/inbound-jwt-authn.js
+var codebaseModulesInboundJWTAuthnJs []byte
+
 //go:embed codebase/modules/inbound-logging-http.js
 var codebaseModulesInboundLoggingHTTPJs []byte
 
@@ -75,9 +84,15 @@ var codebaseModulesOutboundCircuitBreakerJs []byte
 //go:embed codebase/modules/outbound-http-default.js
 var codebaseModulesOutboundHTTPDefaultJs []byte
 
+//go:embed codebase/modules/outbound-http-fault-injection.js
+var codebaseModulesOutboundHTTPFaultInjectionJs []byte
+
 //go:embed codebase/modules/outbound-http-load-balancing.js
 var codebaseModulesOutboundHTTPLoadBalancingJs []byte
 
+//go:embed codebase/modules/outbound-http-mirror.js
+var codebaseModulesOutboundHTTPMirrorJs []byte
+
 //go:embed codebase/modules/outbound-http-routing.js
 var codebaseModulesOutboundHTTPRoutingJs []byte
 
@@ -123,12 +138,15 @@ var fsmCodebaseItems = []client.BatchItem{
 	{Filename: "connect-tls.js", Content: codebaseConnectTLSJs},
 	{Filename: "connect-upstream.js", Content: codebaseConnectUpstreamJs},
 	{Filename: "dns-main.js", Content: codebaseDNSMainJs},
+	{Filename: "egress-logging.js", Content: codebaseEgressLoggingJs},
 	{Filename: "logging.js", Content: codebaseLoggingJs},
 	{Filename: "main.js", Content: codebaseMainJs},
 	{Filename: "metrics.js", Content: codebaseMetricsJs},
+	{Filename: "modules/inbound-http-authz.js", Content: codebaseModulesInboundHTTPAuthzJs},
 	{Filename: "modules/inbound-http-default.js", Content: codebaseModulesInboundHTTPDefaultJs},
 	{Filename: "modules/inbound-http-load-balancing.js", Content: codebaseModulesInboundHTTPLoadBalancingJs},
 	{Filename: "modules/inbound-http-routing.js", Content: codebaseModulesInboundHTTPRoutingJs},
+	{Filename: "modules/inbound-jwt-authn.js", Content: codebaseModulesInboundJWTAuthnJs},
 	{Filename: "modules/inbound-logging-http.js", Content: codebaseModulesInboundLoggingHTTPJs},
 	{Filename: "modules/inbound-main.js", Content: codebaseModulesInboundMainJs},
 	{Filename: "modules/inbound-metrics-http.js", Content: codebaseModulesInboundMetricsHTTPJs},
@@ -141,7 +159,9 @@ var fsmCodebaseItems = []client.BatchItem{
 	{Filename: "modules/inbound-tracing-http.js", Content: codebaseModulesInboundTracingHTTPJs},
 	{Filename: "modules/outbound-circuit-breaker.js", Content: codebaseModulesOutboundCircuitBreakerJs},
 	{Filename: "modules/outbound-http-default.js", Content: codebaseModulesOutboundHTTPDefaultJs},
+	{Filename: "modules/outbound-http-fault-injection.js", Content: codebaseModulesOutboundHTTPFaultInjectionJs},
 	{Filename: "modules/outbound-http-load-balancing.js", Content: codebaseModulesOutboundHTTPLoadBalancingJs},
+	{Filename: "modules/outbound-http-mirror.js", Content: codebaseModulesOutboundHTTPMirrorJs},
 	{Filename: "modules/outbound-http-routing.js", Content: codebaseModulesOutboundHTTPRoutingJs},
 	{Filename: "modules/outbound-logging-http.js", Content: codebaseModulesOutboundLoggingHTTPJs},
 	{Filename: "modules/outbound-main.js", Content: codebaseModulesOutboundMainJs},
@@ -14,6 +14,12 @@
     }
   ) : {},
 
+  idleTimeoutOptions = new algo.Cache(
+    idleTimeout => Object.assign({}, connectOptions, { idleTimeout })
+  ),
+
+  getConnectOptions = () => __idleTimeout > 0 ? idleTimeoutOptions.get(__idleTimeout) : connectOptions,
+
 ) => (
 
 pipy({
@@ -23,6 +29,7 @@ pipy({
 .export('connect-tcp', {
   __target: null,
   __metricLabel: null,
+  __idleTimeout: 0,
 })
 
 .pipeline()
@@ -54,10 +61,10 @@ pipy({
 )
 .branch(
   () => __target.startsWith('127.0.0.1:'), (
-    $=>$.connect(() => __target, { bind: '127.0.0.6', ...connectOptions })
+    $=>$.connect(() => __target, () => Object.assign({ bind: '127.0.0.6' }, getConnectOptions()))
   ),
   (
-    $=>$.connect(() => __target, connectOptions)
+    $=>$.connect(() => __target, getConnectOptions)
   )
 )
 .handleData(
@@ -35,6 +35,10 @@ pipy({
   _key: null,
 })
 
+.export('connect-tls', {
+  __sni: null,
+})
+
 .import({
   __cert: 'outbound',
 })
@@ -58,6 +62,7 @@ pipy({
     key: _key,
   }),
   trusted: listIssuingCA,
+  sni: () => __sni || undefined,
 }).to($=>$.use('connect-tcp.js'))
 
 ))()
\ No newline at end of file
@@ -3,6 +3,12 @@
 
   certChain = config?.Certificate?.CertChain,
 
+  {
+    egressLoggingEnabled,
+    makeEgressLoggingData,
+    saveEgressLoggingData,
+  } = pipy.solve('egress-logging.js'),
+
   forwardMatches = config?.Forward?.ForwardMatches && Object.fromEntries(
     Object.entries(config.Forward.ForwardMatches).map(
       ([k, v]) => [
@@ -28,6 +34,8 @@ pipy({
   _origTarget: null,
   _egressType: '',
   _egressEndpoint: null,
+  _egressLoggingData: null,
+  _egressProtocol: undefined,
 })
 
 .import({
@@ -49,7 +57,27 @@ pipy({
           _egressEndpoint = forwardEgressGateways?.[egw]?.balancer?.borrow?.()?.id
         )
       )
-    )()
+    )(),
+    egressLoggingEnabled && __isEgress && (
+      _egressLoggingData = makeEgressLoggingData(__target)
+    )
+  )
+)
+.onEnd(
+  () => void (
+    _egressLoggingData && saveEgressLoggingData(_egressLoggingData, Boolean(_egressEndpoint))
+  )
+)
+.branch(
+  egressLoggingEnabled, (
+    $=>$
+    .fork('egress-destination')
+    .handleData(
+      data => _egressLoggingData && (_egressLoggingData.sendBytes += data.size)
+    )
+  ),
+  (
+    $=>$
   )
 )
 .branch(
@@ -84,5 +112,46 @@ pipy({
     $=>$.use('connect-tcp.js')
   )
 )
+.branch(
+  egressLoggingEnabled, (
+    $=>$.handleData(
+      data => _egressLoggingData && (_egressLoggingData.receiveBytes += data.size)
+    )
+  ),
+  (
+    $=>$
+  )
+)
+
+//
+// Logging SNI or HTTP host of egress traffic
+//
+.pipeline('egress-destination')
+.detectProtocol(
+  protocol => (
+    _egressProtocol = protocol,
+    _egressLoggingData && (_egressLoggingData.protocol = protocol)
+  )
+)
+.branch(
+  () => _egressLoggingData && _egressProtocol === 'TLS', (
+    $=>$.handleTLSClientHello(
+      hello => _egressLoggingData.sni = hello.serverNames?.[0] || ''
+    )
+  ),
+  () => _egressLoggingData && _egressProtocol === 'HTTP', (
+    $=>$.demuxHTTP().to(
+      $=>$.handleMessageStart(
+        msg => !_egressLoggingData.httpHost && (
+          _egressLoggingData.httpHost = (msg.head.headers.host || '').split(':')[0]
+        )
+      )
+    )
+  ),
+  (
+    $=>$
+  )
+)
+.dummy()
 
 ))()
\ No newline at end of file
@@ -0,0 +1,80 @@
+(
+  (
+    config = pipy.solve('config.js'),
+    {
+      namespace,
+      kind,
+      name,
+      pod,
+    } = pipy.solve('utils.js'),
+    {
+      egressMetricsCache,
+      egressConnectionTotalCounter,
+    } = pipy.solve('metrics.js'),
+    specEnableEgress = config?.Spec?.Traffic?.EnableEgress,
+    egressPolicies = config?.Outbound?.EgressPolicies || {},
+    egressLogging = config?.Spec?.Observability?.egressLogging,
+    logEgress = egressLogging && new logging.JSONLogger('egress-access-logger').toFile('/dev/stdout').log,
+  ) => (
+    {
+      egressLoggingEnabled: Boolean(logEgress),
+
+      egressAuditMode: Boolean(egressLogging?.auditMode),
+
+      makeEgressLoggingData: target => (
+        {
+          startTime: Date.now(),
+          target: target || '',
+          protocol: '',
+          sni: '',
+          httpHost: '',
+          sendBytes: 0,
+          receiveBytes: 0,
+        }
+      ),
+
+      saveEgressLoggingData: (loggingData, viaGateway) => (
+        (
+          port = loggingData.target.split(':').pop(),
+          host = loggingData.sni || loggingData.httpHost || loggingData.target.substring(0, loggingData.target.lastIndexOf(':')),
+          protocol = loggingData.protocol === 'TLS' ? 'https' : (loggingData.protocol === 'HTTP' ? 'http' : 'tcp'),
+          policies = egressPolicies[host + ':' + port] || egressPolicies[port] || [],
+          decision = (policies.length > 0 || specEnableEgress) ? 'allow' : 'audit',
+          endTime = Date.now(),
+          metrics = egressMetricsCache.get(host),
+        ) => (
+          egressConnectionTotalCounter.withLabels(host, port, protocol, policies.join(','), decision).increase(),
+          metrics.sendBytesTotalCounter.increase(loggingData.sendBytes),
+          metrics.receiveBytesTotalCounter.increase(loggingData.receiveBytes),
+          metrics.connectionLengthHist.observe(endTime - loggingData.startTime),
+          logEgress({
+            type: 'egress',
+            startTime: new Date(loggingData.startTime).toISOString(),
+            endTime: new Date(endTime).toISOString(),
+            duration: endTime - loggingData.startTime,
+            source: {
+              namespace,
+              kind,
+              name,
+              pod,
+              ip: os.env.POD_IP || '127.0.0.1',
+              identity: config?.Spec?.ServiceIdentity || '',
+            },
+            destination: {
+              host,
+              sni: loggingData.sni,
+              address: loggingData.target,
+              port,
+              protocol,
+            },
+            sendBytes: loggingData.sendBytes,
+            receiveBytes: loggingData.receiveBytes,
+            policies,
+            decision,
+            viaGateway,
+          })
+        )
+      )(),
+    }
+  )
+)()
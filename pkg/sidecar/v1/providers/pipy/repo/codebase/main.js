@@ -22,7 +22,7 @@
 )
 
 .branch(
-  Boolean(config?.Outbound || config?.Spec?.Traffic?.EnableEgress), (
+  Boolean(config?.Outbound || config?.Spec?.Traffic?.EnableEgress || config?.Spec?.Observability?.egressLogging?.auditMode), (
     $=>$
     .listen(15001, { transparent: true, ...connectOptions })
     .onStart(() => new Data)
@@ -113,7 +113,37 @@
       )
     )()),
 
+    egressConnectionTotalCounter = new stats.Counter('sidecar_egress_cx_total', [
+      'destination_host',
+      'destination_port',
+      'destination_protocol',
+      'egress_policy',
+      'egress_decision'
+    ]),
+    egressSendBytesTotalCounter = new stats.Counter('sidecar_egress_cx_tx_bytes_total', [
+      'destination_host'
+    ]),
+    egressReceiveBytesTotalCounter = new stats.Counter('sidecar_egress_cx_rx_bytes_total', [
+      'destination_host'
+    ]),
+    egressConnectionLengthHist = new stats.Histogram('sidecar_egress_cx_length_ms', [
+      5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000, 30000, 60000, 300000, 600000, 1800000, 3600000, Infinity
+    ], [
+      'destination_host'
+    ]),
+
+    egressMetricsCache = new algo.Cache(host => (
+      {
+        sendBytesTotalCounter: egressSendBytesTotalCounter.withLabels(host),
+        receiveBytesTotalCounter: egressReceiveBytesTotalCounter.withLabels(host),
+        connectionLengthHist: egressConnectionLengthHist.withLabels(host),
+      }
+    )),
+
     serverLiveGauge = new stats.Gauge('sidecar_server_live'),
+
+    // The last 9 digits of the version of the loaded config, used by the controller to track config propagation
+    configVersionGauge = new stats.Gauge('sidecar_config_version'),
   ) => (
 
     Object.keys(config?.Inbound?.ClustersConfigs || {}).concat(Object.keys(config?.Outbound?.ClustersConfigs || {})).forEach(
@@ -140,11 +170,14 @@
 
     // Turn On Activity Metrics
     serverLiveGauge.increase(),
+    configVersionGauge.set(Number(`${config?.Version || 0}`.slice(-9))),
 
     {
       identity,
       metricsCache,
       identityCache,
+      egressMetricsCache,
+      egressConnectionTotalCounter,
       rateLimitCounter: new stats.Counter('http_local_rate_limiter', [
         'http_local_rate_limit'
       ]),
@@ -0,0 +1,141 @@
+((
+  denied = () => new Message({ status: 403 }, 'RBAC: access denied'),
+
+  matchPrincipal = (principals, principal) => (
+    !principals || (
+      Boolean(principal) && principals.some(
+        p => p === '*' || (p.startsWith('*.') ? principal.endsWith(p.substring(1)) : p === principal)
+      )
+    )
+  ),
+
+  matchMethod = (methods, method) => (
+    !methods || methods.some(m => m.toUpperCase() === method)
+  ),
+
+  // the same as trafficpolicy.NormalizePath: the query is stripped, the percent-encoded unreserved
+  // characters are decoded, the repeated slashes are collapsed and the dot segments are resolved
+  normalizePath = path => (
+    (
+      segments = [],
+      trailingSlash = false,
+    ) => (
+      (path || '').split('?')[0].replace(
+        /%([0-9a-fA-F]{2})/g,
+        (escape, hex) => (
+          (c = String.fromCharCode(parseInt(hex, 16))) => /[A-Za-z0-9\-._~]/.test(c) ? c : escape
+        )()
+      ).split('/').forEach(
+        segment => (
+          trailingSlash = segment === '' || segment === '.' || segment === '..',
+          segment === '..' ? segments.pop() : (segment !== '' && segment !== '.' && segments.push(segment))
+        )
+      ),
+      segments.length === 0 ? '/' : '/' + segments.join('/') + (trailingSlash ? '/' : '')
+    )
+  )(),
+
+  matchPath = (paths, path) => (
+    !paths || paths.some(p => path.startsWith(p))
+  ),
+
+  matchHeaders = (headers, reqHeaders) => (
+    !headers || Object.entries(headers).every(
+      ([name, values]) => (
+        (value = reqHeaders[name]) => (
+          value !== undefined && (!values || values.length === 0 || values.includes(value))
+        )
+      )()
+    )
+  ),
+
+  matchClaims = (claims, payload) => (
+    !claims || Object.entries(claims).every(
+      ([name, values]) => (
+        (claim = name.split('.').reduce((v, k) => v?.[k], payload)) => (
+          claim !== undefined && claim !== null && (
+            Array.isArray(claim) ? claim.some(c => values.includes(`${c}`)) : values.includes(`${claim}`)
+          )
+        )
+      )()
+    )
+  ),
+
+  makeRule = rule => (
+    (
+      deny = rule.Action === 'DENY',
+      principals = rule.Principals?.length > 0 ? rule.Principals : null,
+      methods = rule.Methods?.length > 0 ? rule.Methods : null,
+      paths = rule.Paths?.length > 0 ? rule.Paths : null,
+      headers = rule.Headers && Object.keys(rule.Headers).length > 0 ? rule.Headers : null,
+      claims = rule.Claims && Object.keys(rule.Claims).length > 0 ? rule.Claims : null,
+    ) => (
+      (req) => (
+        matchPrincipal(principals, req.principal) &&
+        matchMethod(methods, req.method) &&
+        matchPath(paths, req.path) &&
+        matchHeaders(headers, req.headers) &&
+        matchClaims(claims, req.claims)
+      ) ? { deny } : null
+    )
+  )(),
+
+  makeAuthorizer = authz => (
+    (
+      rules = (authz.Rules || []).map(makeRule),
+      defaultAllow = Boolean(authz.DefaultAllow),
+    ) => (
+      req => (
+        (
+          decision = null,
+        ) => (
+          rules.find(rule => decision = rule(req)),
+          decision ? !decision.deny : defaultAllow
+        )
+      )()
+    )
+  )(),
+
+  authorizers = new algo.Cache(makeAuthorizer),
+
+) => pipy({
+  _denied: false,
+})
+
+.import({
+  __port: 'inbound',
+  __jwtClaims: 'inbound-jwt-authn',
+  __peerIdentity: 'inbound-tls-termination',
+})
+
+.pipeline()
+.branch(
+  () => __port?.Authorization, (
+    $=>$
+    .handleMessageStart(
+      msg => _denied = !authorizers.get(__port.Authorization)({
+        // the principal is authenticated by mTLS, the serviceidentity header set by the client is not trusted
+        principal: __peerIdentity,
+        method: msg.head.method,
+        path: normalizePath(msg.head.path),
+        headers: msg.head.headers,
+        claims: __jwtClaims,
+      })
+    )
+    .branch(
+      () => _denied, (
+        $=>$
+        .replaceData()
+        .replaceMessage(
+          () => [denied(), new StreamEnd]
+        )
+      ), (
+        $=>$.chain()
+      )
+    )
+  ), (
+    $=>$.chain()
+  )
+)
+
+)()
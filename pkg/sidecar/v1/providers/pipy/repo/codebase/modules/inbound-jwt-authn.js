@@ -0,0 +1,161 @@
+((
+  defaultFromHeaders = [{ Name: 'authorization', Prefix: 'Bearer ' }],
+
+  unauthorized = reason => new Message({ status: 401, headers: { 'www-authenticate': `Bearer error="invalid_token", error_description="${reason}"` } }, 'Unauthorized'),
+
+  missingToken = new Message({ status: 401, headers: { 'www-authenticate': 'Bearer' } }, 'Unauthorized'),
+
+  // no key is made of an empty key set, the tokens are rejected then
+  makeKeys = jwks => (
+    (keys = {}) => (
+      (JSON.parse(jwks || '{}')?.keys || []).forEach(
+        (k, i) => keys[k.kid || `#${i}`] = new crypto.JWK(k)
+      ),
+      keys
+    )
+  )(),
+
+  makeRule = rule => ({
+    issuer: rule.Issuer,
+    audiences: rule.Audiences?.length > 0 ? rule.Audiences : null,
+    keys: makeKeys(rule.JWKS),
+    fromHeaders: (rule.FromHeaders?.length > 0 || rule.FromParams?.length > 0) ? (rule.FromHeaders || []) : defaultFromHeaders,
+    fromParams: rule.FromParams || [],
+    claimToHeaders: rule.OutputClaimToHeaders || [],
+    forwardOriginalToken: Boolean(rule.ForwardOriginalToken),
+  }),
+
+  extractToken = (rule, head, params) => (
+    (
+      header = rule.fromHeaders.find(
+        h => head.headers[h.Name]?.startsWith?.(h.Prefix || '')
+      ),
+      param = !header && rule.fromParams.find(p => params()?.get?.(p)),
+    ) => (
+      header ? { token: head.headers[header.Name].substring((header.Prefix || '').length).trim(), header: header.Name } : (
+        param ? { token: params().get(param), param } : null
+      )
+    )
+  )(),
+
+  matchAudience = (rule, aud) => (
+    !rule.audiences || (Array.isArray(aud) ? aud : [aud]).some(a => rule.audiences.includes(a))
+  ),
+
+  verifyToken = (rule, token) => (
+    (
+      jwt = new crypto.JWT(token),
+      payload = jwt.isValid ? jwt.payload : null,
+      key = payload && (
+        rule.keys[jwt.header?.kid] || (
+          !jwt.header?.kid && Object.values(rule.keys).length === 1 ? Object.values(rule.keys)[0] : null
+        )
+      ),
+      now = Date.now() / 1000,
+    ) => (
+      !payload ? 'malformed token' : (
+        payload.iss !== rule.issuer ? 'issuer mismatch' : (
+          !key ? 'no matching key' : (
+            !jwt.verify(key) ? 'signature verification failed' : (
+              payload.exp && payload.exp < now ? 'token expired' : (
+                payload.nbf && payload.nbf > now ? 'token not yet valid' : (
+                  !matchAudience(rule, payload.aud) ? 'audience mismatch' : payload
+                )
+              )
+            )
+          )
+        )
+      )
+    )
+  )(),
+
+  claimValue = (payload, claim) => (
+    (
+      value = claim.split('.').reduce((v, k) => v?.[k], payload)
+    ) => (
+      value === undefined || value === null ? null : (
+        typeof value === 'object' ? JSON.stringify(value) : `${value}`
+      )
+    )
+  )(),
+
+  applyClaims = (rule, extracted, head, payload) => (
+    __jwtClaims = payload,
+    rule.claimToHeaders.forEach(
+      c => (
+        (value = claimValue(payload, c.Claim)) => (
+          value !== null ? (head.headers[c.Header] = value) : delete head.headers[c.Header]
+        )
+      )()
+    ),
+    !rule.forwardOriginalToken && extracted.header && delete head.headers[extracted.header]
+  ),
+
+  makeAuthenticator = authn => (
+    (
+      rules = (authn.JWTRules || []).map(makeRule),
+      allowMissingToken = Boolean(authn.AllowMissingToken),
+    ) => (
+      head => (
+        (
+          query = undefined,
+          params = () => query !== undefined ? query : (query = head.path?.includes?.('?') ? new URL(`http://localhost${head.path}`).searchParams : null),
+          candidates = rules.map(rule => ({ rule, extracted: extractToken(rule, head, params) })).filter(c => c.extracted?.token),
+          reason = null,
+          accepted = candidates.find(
+            c => (
+              (result = verifyToken(c.rule, c.extracted.token)) => (
+                typeof result === 'string' ? (reason = result, false) : (
+                  applyClaims(c.rule, c.extracted, head, result),
+                  true
+                )
+              )
+            )()
+          ),
+        ) => (
+          candidates.length === 0 ? (allowMissingToken ? null : missingToken) : (
+            accepted ? null : unauthorized(reason)
+          )
+        )
+      )
+    )
+  )(),
+
+  authenticators = new algo.Cache(makeAuthenticator),
+
+) => pipy({
+  _reject: null,
+})
+
+.import({
+  __port: 'inbound',
+})
+
+.export('inbound-jwt-authn', {
+  __jwtClaims: null,
+})
+
+.pipeline()
+.branch(
+  () => __port?.RequestAuthentication, (
+    $=>$
+    .handleMessageStart(
+      msg => _reject = authenticators.get(__port.RequestAuthentication)(msg.head)
+    )
+    .branch(
+      () => _reject, (
+        $=>$
+        .replaceData()
+        .replaceMessage(
+          () => [_reject, new StreamEnd]
+        )
+      ), (
+        $=>$.chain()
+      )
+    )
+  ), (
+    $=>$.chain()
+  )
+)
+
+)()
@@ -22,6 +22,15 @@
     ) : null
   )),
 
+  // the peer identity is <name>.<namespace> of the certificate common name <name>.<namespace>.<trust domain>
+  peerIdentity = cert => (
+    (
+      cn = cert?.subject?.commonName,
+    ) => (
+      cn ? cn.split('.').slice(0, 2).join('.') : null
+    )
+  )(),
+
 ) => pipy({
   _tlsConfig: null,
   _forbiddenTLS: false,
@@ -32,6 +41,10 @@
   __protocol: 'inbound',
 })
 
+.export('inbound-tls-termination', {
+  __peerIdentity: null,
+})
+
 .pipeline()
 .branch(
   () => certChain && __port && (
@@ -44,6 +57,8 @@
       }),
       trusted: issuingCA ? [new crypto.Certificate(issuingCA)] : [],
       verify: (ok, cert) => (
+        // the chain is verified down to the peer certificate, which is the last one
+        __peerIdentity = ok ? peerIdentity(cert) : null,
         _tlsConfig?.mTLS && !_tlsConfig?.skipClientCertValidation && (
           _tlsConfig?.authenticatedPrincipals && (_forbiddenTLS = true),
           (_tlsConfig?.authenticatedPrincipals?.[cert?.subject?.commonName] || (
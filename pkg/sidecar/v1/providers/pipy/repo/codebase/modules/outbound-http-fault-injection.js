@@ -0,0 +1,89 @@
+((
+  faultDelayCounter = new stats.Counter('sidecar_cluster_upstream_rq_fault_delay', ['sidecar_cluster_name']),
+  faultAbortCounter = new stats.Counter('sidecar_cluster_upstream_rq_fault_abort', ['sidecar_cluster_name']),
+
+  makeAbortResponse = (abort, isGRPC) => (isGRPC && abort.GRPCStatus !== undefined) ? (
+    new Message({
+      status: 200,
+      headers: {
+        'content-type': 'application/grpc',
+        'grpc-status': `${abort.GRPCStatus}`,
+        'grpc-message': 'fault filter abort',
+      }
+    })
+  ) : (
+    new Message({ status: abort.Status || 503 }, 'fault filter abort')
+  ),
+
+  makeFaultConfig = (fault) => ({
+    delay: fault.Delay?.Delay > 0 && (fault.Delay.Percent ?? 0) > 0 && {
+      ratio: fault.Delay.Percent / 100,
+      seconds: fault.Delay.Delay,
+    },
+    abort: fault.Abort && (fault.Abort.Percent ?? 0) > 0 && {
+      ratio: fault.Abort.Percent / 100,
+      response: makeAbortResponse(fault.Abort, false),
+      grpcResponse: makeAbortResponse(fault.Abort, true),
+    },
+  }),
+
+  faultConfigs = new algo.Cache(makeFaultConfig),
+
+) => pipy({
+  _faultConfig: null,
+  _delayed: false,
+  _abortResponse: null,
+})
+
+.import({
+  __cluster: 'outbound-http-routing',
+  __route: 'outbound-http-routing',
+})
+
+.pipeline()
+.handleMessageStart(
+  msg => (
+    _delayed = false,
+    _abortResponse = null,
+    __route?.Fault && (_faultConfig = faultConfigs.get(__route.Fault)) && (
+      _faultConfig.delay && (Math.random() < _faultConfig.delay.ratio) && (
+        _delayed = true,
+        faultDelayCounter.withLabels(__cluster?.name || '').increase()
+      ),
+      _faultConfig.abort && (Math.random() < _faultConfig.abort.ratio) && (
+        _abortResponse = msg.head.headers?.['content-type']?.startsWith?.('application/grpc') ? (
+          _faultConfig.abort.grpcResponse
+        ) : (
+          _faultConfig.abort.response
+        ),
+        faultAbortCounter.withLabels(__cluster?.name || '').increase()
+      )
+    )
+  )
+)
+
+//
+// The delay is applied before the abort, a request can be both delayed and aborted
+//
+.branch(
+  () => _delayed, (
+    $=>$.wait(() => new Timeout(_faultConfig.delay.seconds).wait())
+  ),
+  (
+    $=>$
+  )
+)
+.branch(
+  () => _abortResponse, (
+    $=>$
+    .replaceData()
+    .replaceMessage(
+      () => [_abortResponse, new StreamEnd]
+    )
+  ),
+  (
+    $=>$.chain()
+  )
+)
+
+)()
@@ -9,6 +9,9 @@
     shuffle,
     failover,
   } = pipy.solve('utils.js'),
+  {
+    metricsCache,
+  } = pipy.solve('metrics.js'),
 
   retryCounter = new stats.Counter('sidecar_cluster_upstream_rq_retry', ['sidecar_cluster_name']),
   retrySuccessCounter = new stats.Counter('sidecar_cluster_upstream_rq_retry_success', ['sidecar_cluster_name']),
@@ -16,6 +19,35 @@
   retryOverflowCounter = new stats.Counter('sidecar_cluster_upstream_rq_retry_overflow', ['sidecar_cluster_name']),
   retryBackoffCounter = new stats.Counter('sidecar_cluster_upstream_rq_retry_backoff_exponential', ['sidecar_cluster_name']),
   retryBackoffLimitCounter = new stats.Counter('sidecar_cluster_upstream_rq_retry_backoff_ratelimited', ['sidecar_cluster_name']),
+  hedgeCounter = new stats.Counter('sidecar_cluster_upstream_rq_hedged', ['sidecar_cluster_name']),
+  ejectionsEnforcedCounter = new stats.Counter('sidecar_cluster_outlier_detection_ejections_enforced_total', ['sidecar_cluster_name']),
+  ejectionsOverflowCounter = new stats.Counter('sidecar_cluster_outlier_detection_ejections_overflow', ['sidecar_cluster_name']),
+  ejectionsActiveGauge = new stats.Gauge('sidecar_cluster_outlier_detection_ejections_active', ['sidecar_cluster_name']),
+
+  makeOutlierDetection = (clusterName, outlierDetection, endpoints) => (
+    (
+      size = Object.keys(endpoints).length,
+      obj = {
+        size,
+        consecutive5xxErrors: outlierDetection.Consecutive5xxErrors || 0,
+        consecutiveGatewayErrors: outlierDetection.ConsecutiveGatewayErrors || 0,
+        baseEjectionTime: outlierDetection.BaseEjectionTime * 1000,
+        maxEjectionTime: outlierDetection.MaxEjectionTime * 1000,
+        // at least one endpoint can be ejected
+        maxEjections: outlierDetection.MaxEjectionPercent > 0 ? Math.max(1, Math.floor(size * outlierDetection.MaxEjectionPercent / 100)) : 0,
+        ejections: 0,
+        endpoints: {},
+        ejectionsEnforcedCounter: ejectionsEnforcedCounter.withLabels(clusterName),
+        ejectionsOverflowCounter: ejectionsOverflowCounter.withLabels(clusterName),
+        ejectionsActiveGauge: ejectionsActiveGauge.withLabels(clusterName),
+      },
+    ) => (
+      obj.ejectionsEnforcedCounter.zero(),
+      obj.ejectionsOverflowCounter.zero(),
+      obj.ejectionsActiveGauge.zero(),
+      obj
+    )
+  )(),
 
   makeClusterConfig = (clusterConfig) => (
     clusterConfig && (
@@ -38,15 +70,36 @@
               ),
               lut
             ),
-            []
+            (clusterConfig.RetryPolicy?.RetriableStatusCodes || []).reduce((lut, code) => (lut[code] = true, lut), [])
           ),
+          retriableHeaders: clusterConfig.RetryPolicy?.RetriableHeaders?.length > 0 ? (
+            clusterConfig.RetryPolicy.RetriableHeaders.map(name => name.toLowerCase())
+          ) : null,
           retryBackoffBaseInterval: clusterConfig.RetryPolicy?.RetryBackoffBaseInterval > 1 ? 1 : clusterConfig.RetryPolicy?.RetryBackoffBaseInterval,
+          retryBackoffMaxInterval: clusterConfig.RetryPolicy?.RetryBackoffMaxInterval,
+          retryBudget: clusterConfig.RetryPolicy?.Budget && {
+            ratio: clusterConfig.RetryPolicy.Budget.BudgetPercent / 100,
+            minRetriesPerSecond: clusterConfig.RetryPolicy.Budget.MinRetriesPerSecond,
+            activeRequests: 0,
+            activeRetries: 0,
+            windowStart: 0,
+            windowRetries: 0,
+          },
+          perTryTimeout: clusterConfig.RetryPolicy?.PerTryTimeout > 0 ? clusterConfig.RetryPolicy.PerTryTimeout : 0,
+          hedging: clusterConfig.RetryPolicy?.Hedging?.Delay > 0 && {
+            delay: clusterConfig.RetryPolicy.Hedging.Delay,
+            maxAttempts: clusterConfig.RetryPolicy.Hedging.MaxAttempts,
+          },
+          hedgeCounter: hedgeCounter.withLabels(clusterConfig.name),
           retryCounter: retryCounter.withLabels(clusterConfig.name),
           retrySuccessCounter: retrySuccessCounter.withLabels(clusterConfig.name),
           retryLimitCounter: retryLimitCounter.withLabels(clusterConfig.name),
           retryOverflowCounter: retryOverflowCounter.withLabels(clusterConfig.name),
           retryBackoffCounter: retryBackoffCounter.withLabels(clusterConfig.name),
           retryBackoffLimitCounter: retryBackoffLimitCounter.withLabels(clusterConfig.name),
+          outlierDetection: clusterConfig.OutlierDetection && clusterConfig.Endpoints && (
+            makeOutlierDetection(clusterConfig.name, clusterConfig.OutlierDetection, clusterConfig.Endpoints)
+          ),
           muxHttpOptions: {
             version: () => __isHTTP2 ? 2 : 1,
             maxMessages: clusterConfig.ConnectionSettings?.http?.MaxRequestsPerConnection
@@ -59,6 +112,7 @@
         obj.retryOverflowCounter.zero(),
         obj.retryBackoffCounter.zero(),
         obj.retryBackoffLimitCounter.zero(),
+        obj.hedgeCounter.zero(),
         obj
       )
     )()
@@ -66,13 +120,194 @@
 
   clusterConfigs = new algo.Cache(makeClusterConfig),
 
-  shouldRetry = (statusCode) => (
-    _clusterConfig.retryStatusCodes[statusCode] ? (
-      (_retryCount < _clusterConfig.numRetries) ? (
-        _clusterConfig.retryCounter.increase(),
-        _clusterConfig.retryBackoffCounter.increase(),
-        _retryCount++,
+  isEjected = (outlierDetection, target) => (
+    (
+      endpoint = outlierDetection.endpoints[target]
+    ) => (
+      endpoint?.ejectedUntil > 0 && (
+        (endpoint.ejectedUntil > Date.now()) || (
+          // the ejection expired, the endpoint is back
+          endpoint.ejectedUntil = 0,
+          outlierDetection.ejections--,
+          outlierDetection.ejectionsActiveGauge.decrease(),
+          false
+        )
+      )
+    )
+  )(),
+
+  // picks another endpoint if the given one is ejected, unless all the endpoints are
+  pickEndpoint = (outlierDetection, balancer, targetObject) => (
+    (
+      picked = null,
+    ) => (
+      targetObject && isEjected(outlierDetection, targetObject.id) ? (
+        new Array(outlierDetection.size).fill(0).some(
+          () => (picked = balancer.borrow()) && !isEjected(outlierDetection, picked.id)
+        ) ? picked : targetObject
+      ) : targetObject
+    )
+  )(),
+
+  // records the outcome of a request to an endpoint, a status of 0 is a connection failure
+  recordOutcome = (outlierDetection, target, status) => (
+    (
+      endpoint = outlierDetection.endpoints[target] || (
+        outlierDetection.endpoints[target] = { errors5xx: 0, gatewayErrors: 0, ejectionCount: 0, ejectedUntil: 0, decayAt: 0 }
+      ),
+      now = Date.now(),
+    ) => (
+      (!status || status >= 500) ? (
+        endpoint.errors5xx++,
+        // the gateway errors must be consecutive
+        (!status || status === 502 || status === 503 || status === 504) ? endpoint.gatewayErrors++ : (endpoint.gatewayErrors = 0),
+        endpoint.ejectedUntil === 0 && (
+          (outlierDetection.consecutive5xxErrors > 0 && endpoint.errors5xx >= outlierDetection.consecutive5xxErrors) ||
+          (outlierDetection.consecutiveGatewayErrors > 0 && endpoint.gatewayErrors >= outlierDetection.consecutiveGatewayErrors)
+        ) && (
+          endpoint.errors5xx = 0,
+          endpoint.gatewayErrors = 0,
+          outlierDetection.ejections < outlierDetection.maxEjections ? (
+            endpoint.ejectionCount++,
+            endpoint.ejectedUntil = now + Math.min(outlierDetection.baseEjectionTime * endpoint.ejectionCount, outlierDetection.maxEjectionTime),
+            endpoint.decayAt = endpoint.ejectedUntil + outlierDetection.baseEjectionTime,
+            outlierDetection.ejections++,
+            outlierDetection.ejectionsEnforcedCounter.increase(),
+            outlierDetection.ejectionsActiveGauge.increase(),
+            isDebugEnabled && console.log('outbound-http # outlier ejected :', target, endpoint.ejectedUntil - now, 'ms')
+          ) : (
+            outlierDetection.ejectionsOverflowCounter.increase()
+          )
+        )
+      ) : (
+        endpoint.errors5xx = 0,
+        endpoint.gatewayErrors = 0,
+        // the ejection backoff decays while the endpoint stays healthy
+        endpoint.ejectionCount > 0 && now >= endpoint.decayAt && (
+          endpoint.ejectionCount--,
+          endpoint.decayAt = now + outlierDetection.baseEjectionTime
+        )
+      )
+    )
+  )(),
+
+  grpcTimeoutUnits = { H: 3600, M: 60, S: 1, m: 0.001, u: 0.000001, n: 0.000000001 },
+
+  // grpc-timeout deadlines in seconds, a zero or sub-millisecond deadline being kept as one millisecond
+  parseGRPCTimeout = (value) => (
+    (
+      match = /^(\d{1,8})([HMSmun])$/.exec(value || '')
+    ) => (
+      match ? Math.max(0.001, match[1] * grpcTimeoutUnits[match[2]]) : 0
+    )
+  )(),
+
+  minTimeout = (a, b) => (a > 0 && b > 0) ? Math.min(a, b) : (a || b),
+
+  makeTimeoutResponse = (isGRPC) => isGRPC ? (
+    new Message({
+      status: 200,
+      headers: {
+        'content-type': 'application/grpc',
+        'grpc-status': '4',
+        'grpc-message': 'upstream request timeout',
+      }
+    })
+  ) : (
+    new Message({ status: 504 }, 'upstream request timeout')
+  ),
+
+  isRetriable = (head) => (
+    _clusterConfig.needRetry && (
+      _clusterConfig.retryStatusCodes[head.status] ||
+      Boolean(_clusterConfig.retriableHeaders?.some?.(name => head.headers?.[name] !== undefined))
+    )
+  ),
+
+  // retries are allowed within the budget, or below the minimum number of retries per second
+  withinRetryBudget = (budget) => (
+    (
+      now = Date.now(),
+    ) => (
+      (now - budget.windowStart >= 1000) && (
+        budget.windowStart = now,
+        budget.windowRetries = 0
+      ),
+      (
+        budget.windowRetries < budget.minRetriesPerSecond ||
+        (_retryState.retried ? budget.activeRetries : budget.activeRetries + 1) <= budget.ratio * budget.activeRequests
+      ) && (
+        budget.windowRetries++,
         true
+      )
+    )
+  )(),
+
+  // a request retried or hedged is counted once in the active retries
+  countRetry = (budget) => (
+    !_retryState.retried && (
+      _retryState.retried = true,
+      budget.activeRetries++
+    )
+  ),
+
+  hedgeMethods = { GET: true, HEAD: true, OPTIONS: true },
+
+  isDirectEndpoint = (target) => (
+    (
+      attrs = _clusterConfig.endpointAttributes?.[target]
+    ) => !attrs?.ViaGateway && !attrs?.Path
+  )(),
+
+  // sends a hedged attempt to another endpoint within the retry budget while no attempt has responded,
+  // unless the endpoints are reached through a gateway as the request was rewritten for its endpoint
+  hedge = () => (
+    (
+      targetObject = !_hedgeState.target && _targetObject && isDirectEndpoint(_targetObject.id) && _clusterConfig.targetBalancer?.borrow?.(),
+    ) => (
+      targetObject && _clusterConfig.outlierDetection && (
+        targetObject = pickEndpoint(_clusterConfig.outlierDetection, _clusterConfig.targetBalancer, targetObject)
+      ),
+      Boolean(targetObject) && isDirectEndpoint(targetObject.id) && (
+        (_clusterConfig.retryBudget && !withinRetryBudget(_clusterConfig.retryBudget)) ? (
+          _clusterConfig.retryOverflowCounter.increase(),
+          false
+        ) : (
+          _clusterConfig.retryBudget && countRetry(_clusterConfig.retryBudget),
+          _clusterConfig.hedgeCounter.increase(),
+          _targetObject = targetObject,
+          __target = targetObject.id,
+          __sni = _clusterConfig.endpointAttributes?.[targetObject.id]?.ServerName,
+          true
+        )
+      )
+    )
+  )(),
+
+  // jittered exponential backoff, in seconds
+  retryBackoff = () => (
+    (
+      interval = _clusterConfig.retryBackoffBaseInterval * Math.pow(2, _retryCount - 1),
+      maxInterval = _clusterConfig.retryBackoffMaxInterval > 0 ? _clusterConfig.retryBackoffMaxInterval : _clusterConfig.retryBackoffBaseInterval * 10,
+    ) => (
+      Math.random() * Math.min(interval, maxInterval)
+    )
+  )(),
+
+  shouldRetry = (head) => (
+    isRetriable(head) ? (
+      (_retryCount < _clusterConfig.numRetries && !(_deadline > 0 && Date.now() >= _deadline)) ? (
+        (_clusterConfig.retryBudget && !withinRetryBudget(_clusterConfig.retryBudget)) ? (
+          _clusterConfig.retryOverflowCounter.increase(),
+          false
+        ) : (
+          _clusterConfig.retryCounter.increase(),
+          _clusterConfig.retryBackoffCounter.increase(),
+          _clusterConfig.retryBudget && countRetry(_clusterConfig.retryBudget),
+          _retryCount++,
+          _retryDelay = retryBackoff(),
+          true
+        )
       ) : (
         _clusterConfig.retryLimitCounter.increase(),
         false
@@ -89,6 +324,19 @@
   _targetObject: null,
   _muxHttpOptions: null,
   _session: null,
+  _timeout: 0,
+  _idleTimeout: 0,
+  _deadline: 0,
+  _isGRPC: false,
+  _responded: false,
+  _retryDelay: 0,
+  _attemptTimeout: 0,
+  _attemptBranch: null,
+  _requestBranch: null,
+  _retryState: null,
+  _hedge: false,
+  _hedgeState: null,
+  _hedgeIndex: 0,
 })
 
 .import({
@@ -101,6 +349,8 @@
   __cluster: 'outbound-http-routing',
   __metricLabel: 'connect-tcp',
   __target: 'connect-tcp',
+  __idleTimeout: 'connect-tcp',
+  __sni: 'connect-tls',
 })
 
 .pipeline()
@@ -115,7 +365,35 @@
     )
   )
 )
-.onEnd(() => void ( _session = null))
+.onEnd(
+  () => void (
+    _session = null
+  )
+)
+.handleMessageStart(
+  msg => (
+    (
+      timeouts = __route?.Timeouts,
+      requestTimeout = timeouts?.Request || 0,
+      grpcTimeout = 0,
+    ) => (
+      _isGRPC = Boolean(msg.head.headers?.['content-type']?.startsWith?.('application/grpc')),
+      _isGRPC && (
+        requestTimeout = timeouts?.StreamDuration || requestTimeout,
+        grpcTimeout = parseGRPCTimeout(msg.head.headers['grpc-timeout']),
+        // let the upstream know about a shorter deadline
+        requestTimeout > 0 && !(grpcTimeout > 0 && grpcTimeout <= requestTimeout) && (
+          msg.head.headers['grpc-timeout'] = `${Math.ceil(requestTimeout * 1000)}m`
+        ),
+        requestTimeout = minTimeout(requestTimeout, grpcTimeout)
+      ),
+      _timeout = minTimeout(timeouts?.BackendRequest || 0, requestTimeout),
+      _idleTimeout = timeouts?.Idle || 0,
+      _deadline = requestTimeout > 0 ? Date.now() + requestTimeout * 1000 : 0,
+      _hedge = Boolean(_clusterConfig?.hedging && hedgeMethods[msg.head.method])
+    )
+  )()
+)
 .handleMessageStart(
   msg => (
     _clusterConfig && (
@@ -132,6 +410,9 @@
           _targetObject = _clusterConfig.targetBalancer?.borrow?.()
         )
       ),
+      _clusterConfig.outlierDetection && (
+        _targetObject = pickEndpoint(_clusterConfig.outlierDetection, _clusterConfig.targetBalancer, _targetObject)
+      ),
       __target = _targetObject?.id
     ) && (
       (
@@ -147,23 +428,70 @@
         attrs?.Path && (
           __isEgress = true,
           msg.head.path = attrs.Path + msg.head.path
-        )
+        ),
+        __sni = attrs?.ServerName
       )
     )()
   )
 )
 
+.branch(
+  () => _deadline > 0, (
+    // the request including its retries is given up when the deadline is reached
+    $=>$.forkRace(['forward', 'timeout']).to(
+      $=>$
+      .onStart(b => void (_requestBranch = b))
+      .branch(
+        () => _requestBranch === 'timeout', (
+          $=>$
+          .wait(() => new Timeout(Math.max(0, _deadline - Date.now()) / 1000).wait())
+          .replaceData()
+          .replaceMessageEnd()
+          .replaceMessageStart(
+            () => (
+              metricsCache.get(__cluster?.name).requestTimeoutCounter.increase(),
+              [makeTimeoutResponse(_isGRPC), new StreamEnd]
+            )
+          )
+        ),
+        (
+          $=>$.link('request')
+        )
+      )
+    )
+  ),
+  (
+    $=>$.link('request')
+  )
+)
+
+.pipeline('request')
+.onStart(
+  () => void (
+    _retryState = { retried: false },
+    _clusterConfig?.retryBudget && _clusterConfig.retryBudget.activeRequests++
+  )
+)
+.onEnd(
+  // the forked contexts are cloned, the retries and the hedged attempts share the state of the request
+  () => void (
+    _clusterConfig?.retryBudget && (
+      _clusterConfig.retryBudget.activeRequests--,
+      _retryState.retried && _clusterConfig.retryBudget.activeRetries--
+    )
+  )
+)
 .branch(
   () => _clusterConfig?.needRetry, (
     $=>$
     .replay({
-        delay: () => _clusterConfig.retryBackoffBaseInterval * Math.min(10, Math.pow(2, _retryCount-1)|0)
+        delay: () => _retryDelay
     }).to(
       $=>$
       .link('upstream')
       .replaceMessageStart(
         msg => (
-          shouldRetry(msg.head.status) ? new StreamEnd('Replay') : msg
+          shouldRetry(msg.head) ? new StreamEnd('Replay') : msg
         )
       )
     )
@@ -206,7 +534,12 @@
         __cert = __cluster.SourceCert
       )
     ),
-    __metricLabel = __cluster?.name
+    __metricLabel = __cluster?.name,
+    // each attempt is given up after the per-try timeout, the 504 response being retried as any other
+    _attemptTimeout = minTimeout(_timeout, _clusterConfig?.perTryTimeout || 0),
+    _hedgeState = _hedge ? { target: null } : null,
+    __idleTimeout = _idleTimeout,
+    _responded = false
   )
 )
 .branch(
@@ -224,8 +557,99 @@
     $=>$.chain()
   ),
   (
-    $=>$.muxHTTP(() => _targetObject, () => _muxHttpOptions).to($=>$.use('connect-upstream.js'))
+    $=>$.branch(
+      () => _attemptTimeout > 0, (
+        // the attempt is given up if the upstream does not respond in time,
+        // the timeout ends the stream the same way as a read timeout would
+        $=>$.forkRace(['attempt', 'timeout']).to(
+          $=>$
+          .onStart(b => void (_attemptBranch = b))
+          .branch(
+            () => _attemptBranch === 'timeout', (
+              $=>$
+              .wait(() => new Timeout(_attemptTimeout).wait())
+              .replaceData()
+              .replaceMessageEnd()
+              .replaceMessageStart(() => new StreamEnd('ReadTimeout'))
+            ),
+            (
+              $=>$.link('attempt')
+            )
+          )
+        )
+      ),
+      (
+        $=>$.link('attempt')
+      )
+    )
+    .handleMessageStart(
+      msg => void (
+        _responded = true,
+        _clusterConfig?.outlierDetection && _targetObject && (
+          recordOutcome(_clusterConfig.outlierDetection, (_hedgeState?.target || _targetObject).id, msg.head.status)
+        )
+      )
+    )
+    .handleStreamEnd(
+      e => void (
+        !_responded && e.error && _clusterConfig?.outlierDetection && _targetObject && (
+          recordOutcome(_clusterConfig.outlierDetection, _targetObject.id, 0)
+        )
+      )
+    )
+    .replaceStreamEnd(
+      e => (
+        _attemptTimeout > 0 && !_responded && e.error === 'ReadTimeout' ? (
+          metricsCache.get(__cluster?.name).requestTimeoutCounter.increase(),
+          [makeTimeoutResponse(_isGRPC), new StreamEnd]
+        ) : e
+      )
+    )
+  )
+)
+
+.pipeline('attempt')
+.branch(
+  () => _hedge, (
+    // the request is also sent to other endpoints after each hedging delay without a response,
+    // the first attempt to respond wins the race, the others are abandoned
+    $=>$.forkRace(() => new Array(_clusterConfig.hedging.maxAttempts).fill(0).map((_, i) => i)).to(
+      $=>$
+      .onStart(i => void (_hedgeIndex = i))
+      .branch(
+        () => _hedgeIndex > 0, (
+          $=>$
+          .wait(() => new Timeout(_clusterConfig.hedging.delay * _hedgeIndex).wait())
+          .branch(
+            () => hedge(), (
+              $=>$.link('connect')
+            ),
+            (
+              // the attempt is not hedged, it stays out of the race
+              $=>$.dummy()
+            )
+          )
+        ),
+        (
+          $=>$.link('connect')
+        )
+      )
+      .handleMessageStart(
+        () => void (
+          _hedgeState.target || (_hedgeState.target = _targetObject)
+        )
+      )
+    )
+  ),
+  (
+    $=>$.link('connect')
   )
 )
 
+.pipeline('connect')
+.muxHTTP(
+  () => _idleTimeout > 0 ? `${_targetObject?.id}@${_idleTimeout}` : _targetObject,
+  () => _muxHttpOptions
+).to($=>$.use('connect-upstream.js'))
+
 )()
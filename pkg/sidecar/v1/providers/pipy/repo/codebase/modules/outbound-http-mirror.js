@@ -0,0 +1,89 @@
+((
+  config = pipy.solve('config.js'),
+  certChain = config?.Certificate?.CertChain,
+  privateKey = config?.Certificate?.PrivateKey,
+  {
+    shuffle,
+  } = pipy.solve('utils.js'),
+
+  mirrorCounter = new stats.Counter('sidecar_cluster_upstream_rq_mirror', ['sidecar_cluster_name']),
+
+  makeMirrorConfig = (mirror) => (
+    (
+      clusterConfig = config?.Outbound?.ClustersConfigs?.[mirror.Cluster],
+    ) => (
+      clusterConfig?.Endpoints && {
+        balancer: new algo.RoundRobinLoadBalancer(
+          shuffle(Object.fromEntries(Object.entries(clusterConfig.Endpoints).map(([k, v]) => [k, v.Weight])))
+        ),
+        name: mirror.Cluster,
+        sourceCert: clusterConfig.SourceCert,
+        ratio: (mirror.Percent ?? 100) / 100,
+        host: mirror.Host,
+        headers: mirror.RequestHeadersToAdd || {},
+        counter: mirrorCounter.withLabels(mirror.Cluster),
+      }
+    )
+  )(),
+
+  mirrorConfigs = new algo.Cache(makeMirrorConfig),
+) => pipy({
+  _mirrorConfig: null,
+  _mirrorTarget: null,
+})
+
+.import({
+  __cert: 'outbound',
+  __isHTTP2: 'outbound',
+  __route: 'outbound-http-routing',
+  __metricLabel: 'connect-tcp',
+  __target: 'connect-tcp',
+})
+
+.pipeline()
+.handleMessageStart(
+  () => (
+    _mirrorTarget = null,
+    __route?.Mirror && (_mirrorConfig = mirrorConfigs.get(__route.Mirror)) && (Math.random() < _mirrorConfig.ratio) && (
+      _mirrorTarget = _mirrorConfig.balancer.borrow()?.id,
+      _mirrorTarget && _mirrorConfig.counter.increase()
+    )
+  )
+)
+.branch(
+  () => _mirrorTarget, (
+    $=>$.fork('mirror')
+  ),
+  (
+    $=>$
+  )
+)
+.chain()
+
+//
+// Mirrored requests are fire-and-forget, their responses are discarded,
+// they are connected to the mirror cluster the same way as to any other upstream
+//
+.pipeline('mirror')
+.handleMessageStart(
+  () => (
+    !__cert && _mirrorConfig.sourceCert && (
+      __cert = _mirrorConfig.sourceCert.FsmIssued ? { CertChain: certChain, PrivateKey: privateKey } : _mirrorConfig.sourceCert
+    ),
+    __metricLabel = _mirrorConfig.name,
+    __target = _mirrorTarget
+  )
+)
+.replaceMessageStart(
+  msg => new MessageStart(
+    Object.assign({}, msg.head, {
+      headers: Object.assign({}, msg.head.headers, _mirrorConfig.host ? { host: _mirrorConfig.host } : {}, _mirrorConfig.headers),
+    })
+  )
+)
+.muxHTTP(() => _mirrorTarget, { version: () => __isHTTP2 ? 2 : 1 }).to(
+  $=>$.use('connect-upstream.js')
+)
+.dummy()
+
+)()
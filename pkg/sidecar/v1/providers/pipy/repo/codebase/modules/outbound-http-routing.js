@@ -118,6 +118,31 @@
   )(),
 
   portHandlers = new algo.Cache(makePortHandler),
+
+  modifyHeaders = (headers, modifier) => (
+    modifier.Set && Object.entries(modifier.Set).forEach(([k, v]) => headers[k] = v),
+    modifier.Add && Object.entries(modifier.Add).forEach(([k, v]) => headers[k] = headers[k] ? headers[k] + ',' + v : v),
+    modifier.Remove && modifier.Remove.forEach(k => delete headers[k])
+  ),
+
+  rewriteURL = (head, route, rewrite) => (
+    (
+      i = head.path.indexOf('?'),
+      path = i < 0 ? head.path : head.path.substring(0, i),
+      query = i < 0 ? '' : head.path.substring(i),
+      prefix = route.Type === 'Prefix' ? route.Path : '',
+    ) => (
+      rewrite.Hostname && (
+        head.headers.host = rewrite.Hostname
+      ),
+      rewrite.ReplaceFullPath && (
+        head.path = rewrite.ReplaceFullPath + query
+      ) || rewrite.ReplacePrefixMatch && (
+        path = rewrite.ReplacePrefixMatch.replace(/\/$/, '') + path.substring(prefix.length),
+        head.path = (path.startsWith('/') ? path : '/' + path) + query
+      )
+    )
+  )(),
 ) => pipy({
   _origPath: null,
   _failoverCluster: null,
@@ -161,7 +186,13 @@
           _failoverCluster = null,
           true
         ) || (
-          portHandlers.get(__port)(msg)
+          portHandlers.get(__port)(msg),
+          __route?.Filters?.RequestHeaderModifier && (
+            modifyHeaders(msg.head.headers, __route.Filters.RequestHeaderModifier)
+          )
+        ),
+        __route?.Filters?.URLRewrite && (
+          rewriteURL(msg.head, __route, __route.Filters.URLRewrite)
         )
       )
     )
@@ -171,7 +202,12 @@
         (
           status = msg?.head?.status
         ) => (
-          _failoverCluster && (!status || status > '499') ? new StreamEnd('Replay') : msg
+          _failoverCluster && (!status || status > '499') ? new StreamEnd('Replay') : (
+            msg?.head?.headers && __route?.Filters?.ResponseHeaderModifier && (
+              modifyHeaders(msg.head.headers, __route.Filters.ResponseHeaderModifier)
+            ),
+            msg
+          )
         )
       )()
     )
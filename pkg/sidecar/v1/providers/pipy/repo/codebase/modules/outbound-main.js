@@ -1,6 +1,6 @@
 ((
   config = pipy.solve('config.js'),
-  specEnableEgress = config?.Spec?.Traffic?.EnableEgress,
+  specEnableEgress = config?.Spec?.Traffic?.EnableEgress || config?.Spec?.Observability?.egressLogging?.auditMode,
   outboundL7Chains = config?.Chains?.["outbound-http"],
   outboundL4Chains = config?.Chains?.["outbound-tcp"],
 
@@ -1,6 +1,6 @@
 ((
   config = pipy.solve('config.js'),
-  specEnableEgress = config?.Spec?.Traffic?.EnableEgress,
+  specEnableEgress = config?.Spec?.Traffic?.EnableEgress || config?.Spec?.Observability?.egressLogging?.auditMode,
   isDebugEnabled = config?.Spec?.SidecarLogLevel === 'debug',
 
   targetBalancers = new algo.Cache(target => new algo.RoundRobinLoadBalancer(
@@ -15,12 +15,14 @@
   __cluster: 'outbound-tcp-routing',
   __metricLabel: 'connect-tcp',
   __target: 'connect-tcp',
+  __sni: 'connect-tls',
 })
 
 .pipeline()
 .handleStreamStart(
   () => (
     __target = __cluster && targetBalancers.get(__cluster)?.borrow?.()?.id,
+    __sni = __target && __cluster?.Endpoints?.[__target]?.ServerName,
     !__target && (specEnableEgress || __port?.TcpServiceRouteRules?.AllowedEgressTraffic) && (
       __target = __inbound.destinationAddress + ':' + __inbound.destinationPort,
       __cluster = {name: __target},
@@ -70,7 +70,7 @@ pipy({
     () => (_statsPath === '/listeners'), $ => $
       .replaceMessage(
         (msg) => (
-          ((config?.Outbound || config?.Spec?.Traffic?.EnableEgress) && (msg = 'outbound-listener::0.0.0.0:15001\n')) || (msg = ''),
+          ((config?.Outbound || config?.Spec?.Traffic?.EnableEgress || config?.Spec?.Observability?.egressLogging?.auditMode) && (msg = 'outbound-listener::0.0.0.0:15001\n')) || (msg = ''),
           (config?.Inbound?.TrafficMatches) && (msg += 'inbound-listener::0.0.0.0:15003\n'),
           msg += 'inbound-prometheus-listener::0.0.0.0:15010\n',
           new Message(msg)
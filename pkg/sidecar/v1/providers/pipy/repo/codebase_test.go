@@ -0,0 +1,189 @@
+package repo
+
+import (
+	"bytes"
+	"encoding/json"
+	"os/exec"
+	"strings"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/flomesh-io/fsm/pkg/trafficpolicy"
+)
+
+// codebaseDefinition returns the top level definition of the given name in a codebase module,
+// the definitions being separated by blank lines
+func codebaseDefinition(t *testing.T, module []byte, name string) string {
+	t.Helper()
+
+	start := bytes.Index(module, []byte("\n  "+name+" = "))
+	if start < 0 {
+		t.Fatalf("definition of %s not found", name)
+	}
+	definition := module[start+1:]
+	if end := bytes.Index(definition, []byte("\n\n")); end >= 0 {
+		definition = definition[:end]
+	}
+	return "let " + strings.TrimSuffix(strings.TrimSpace(string(definition)), ",") + ";\n"
+}
+
+// runScript runs the given script with node and returns its output, the test is skipped
+// if node is not installed
+func runScript(t *testing.T, script string) string {
+	t.Helper()
+
+	node, err := exec.LookPath("node")
+	if err != nil {
+		t.Skip("node is not installed")
+	}
+	out, err := exec.Command(node, "-e", script).CombinedOutput() // #nosec G204
+	if err != nil {
+		t.Fatalf("error running script: %s\n%s", err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func TestRecordOutcome(t *testing.T) {
+	recordOutcome := codebaseDefinition(t, codebaseModulesOutboundHTTPLoadBalancingJs, "recordOutcome")
+
+	testCases := []struct {
+		name          string
+		statuses      string
+		expectEjected string
+	}{
+		{
+			name:          "consecutive gateway errors",
+			statuses:      "[502, 503]",
+			expectEjected: "true",
+		},
+		{
+			name:          "connection failures are gateway errors",
+			statuses:      "[0, 504]",
+			expectEjected: "true",
+		},
+		{
+			name:          "gateway errors reset by a 500",
+			statuses:      "[502, 500, 503]",
+			expectEjected: "false",
+		},
+		{
+			name:          "gateway errors reset by a success",
+			statuses:      "[502, 200, 503]",
+			expectEjected: "false",
+		},
+		{
+			name:          "consecutive 5xx errors",
+			statuses:      "[500, 502, 500, 500]",
+			expectEjected: "true",
+		},
+		{
+			name:          "5xx errors reset by a success",
+			statuses:      "[500, 500, 200, 500]",
+			expectEjected: "false",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			script := "let isDebugEnabled = false;\n" + recordOutcome + `
+const metric = { increase: () => {}, decrease: () => {} };
+const outlierDetection = {
+  size: 2, consecutive5xxErrors: 4, consecutiveGatewayErrors: 2, baseEjectionTime: 30000, maxEjectionTime: 300000,
+  maxEjections: 1, ejections: 0, endpoints: {},
+  ejectionsEnforcedCounter: metric, ejectionsOverflowCounter: metric, ejectionsActiveGauge: metric,
+};
+` + tc.statuses + `.forEach(status => recordOutcome(outlierDetection, 'ep', status));
+console.log(outlierDetection.endpoints.ep.ejectedUntil > 0);
+`
+			tassert.Equal(t, tc.expectEjected, runScript(t, script))
+		})
+	}
+}
+
+func TestWithinRetryBudget(t *testing.T) {
+	assert := tassert.New(t)
+
+	script := "let _retryState = { retried: false };\n" + codebaseDefinition(t, codebaseModulesOutboundHTTPLoadBalancingJs, "withinRetryBudget") + `
+const budget = { ratio: 0.2, minRetriesPerSecond: 1, activeRequests: 10, activeRetries: 0, windowStart: 0, windowRetries: 0 };
+const allowed = [];
+// below the minimum number of retries per second
+allowed.push(withinRetryBudget(budget));
+// within the ratio of the active requests
+budget.activeRetries = 1;
+allowed.push(withinRetryBudget(budget));
+// beyond the ratio of the active requests
+budget.activeRetries = 2;
+allowed.push(withinRetryBudget(budget));
+// the request retried or hedged already is counted in the active retries
+_retryState.retried = true;
+allowed.push(withinRetryBudget(budget));
+console.log(JSON.stringify(allowed));
+`
+	assert.Equal("[true,true,false,true]", runScript(t, script))
+}
+
+func TestHedge(t *testing.T) {
+	assert := tassert.New(t)
+
+	script := "let _retryState = { retried: false }, _hedgeState = { target: null }, _targetObject = { id: 'ep1' }, __target = 'ep1', __sni;\n"
+	for _, name := range []string{"withinRetryBudget", "countRetry", "isDirectEndpoint", "hedge"} {
+		script += codebaseDefinition(t, codebaseModulesOutboundHTTPLoadBalancingJs, name)
+	}
+	script += `
+const counter = () => ({ n: 0, increase() { this.n++; } });
+const targets = ['ep2', 'ep3', 'ep4'];
+let _clusterConfig = {
+  endpointAttributes: { ep1: {}, ep2: { ServerName: 'ep2.local' }, ep3: {}, ep4: { ViaGateway: 'gw' } },
+  targetBalancer: { borrow: () => ({ id: targets.shift() }) },
+  retryBudget: { ratio: 0, minRetriesPerSecond: 1, activeRequests: 1, activeRetries: 0, windowStart: 0, windowRetries: 0 },
+  hedgeCounter: counter(),
+  retryOverflowCounter: counter(),
+};
+const hedged = [];
+// within the minimum number of retries per second
+hedged.push(hedge(), __target, __sni);
+// beyond the budget
+hedged.push(hedge(), __target);
+// an endpoint reached through a gateway is not hedged to
+_clusterConfig.retryBudget.windowStart = 0;
+hedged.push(hedge(), __target);
+// no more hedging once an attempt has responded
+_hedgeState.target = _targetObject;
+hedged.push(hedge());
+console.log(JSON.stringify([hedged, _clusterConfig.hedgeCounter.n, _clusterConfig.retryOverflowCounter.n, _clusterConfig.retryBudget.activeRetries]));
+`
+	assert.Equal(`[[true,"ep2","ep2.local",false,"ep2",false,"ep2",false],1,1,1]`, runScript(t, script))
+}
+
+func TestParseGRPCTimeout(t *testing.T) {
+	assert := tassert.New(t)
+
+	script := codebaseDefinition(t, codebaseModulesOutboundHTTPLoadBalancingJs, "grpcTimeoutUnits") +
+		codebaseDefinition(t, codebaseModulesOutboundHTTPLoadBalancingJs, "parseGRPCTimeout") + `
+console.log(JSON.stringify(['1H', '2M', '3S', '100m', '1500m', '250u', '0m', '10n', '', '1.5S', '123456789S', 'x'].map(parseGRPCTimeout)));
+`
+	assert.Equal("[3600,120,3,0.1,1.5,0.001,0.001,0.001,0,0,0,0]", runScript(t, script))
+}
+
+func TestNormalizePath(t *testing.T) {
+	assert := tassert.New(t)
+
+	// the paths are normalized by the sidecar the same way as by the policy check-request command
+	paths := []string{
+		"", "/", "/admin", "/admin/", "/admin?x=/y", "//admin", "/api//admin///users", "/./admin", "/x/../admin",
+		"/../../admin", "/admin/.", "/admin/x/..", "/%61dmin", "/%2E%2e/admin", "/%7Eu%5Fx", "/admin%2Fusers", "/a%zz%4",
+	}
+	expected := make([]string, 0, len(paths))
+	for _, path := range paths {
+		expected = append(expected, trafficpolicy.NormalizePath(path))
+	}
+	input, err := json.Marshal(paths)
+	assert.NoError(err)
+
+	script := codebaseDefinition(t, codebaseModulesInboundHTTPAuthzJs, "normalizePath") +
+		"console.log(JSON.stringify(" + string(input) + ".map(normalizePath)));\n"
+	output, err := json.Marshal(expected)
+	assert.NoError(err)
+	assert.Equal(string(output), runScript(t, script))
+}
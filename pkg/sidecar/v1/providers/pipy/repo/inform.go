@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strings"
 	"sync"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -56,14 +57,6 @@ func (s *Server) informTrafficPolicies(proxyPtr **pipy.Proxy, wg *sync.WaitGroup
 	certRotateChan := certPubSub.Sub(announcements.CertificateRotated.String())
 	defer s.msgBroker.Unsub(certPubSub, certRotateChan)
 
-	newJob := func() *PipyConfGeneratorJob {
-		return &PipyConfGeneratorJob{
-			proxy:      proxy,
-			repoServer: s,
-			done:       make(chan struct{}),
-		}
-	}
-
 	wg.Done()
 
 	for {
@@ -72,11 +65,20 @@ func (s *Server) informTrafficPolicies(proxyPtr **pipy.Proxy, wg *sync.WaitGroup
 			log.Info().Str("proxy", proxy.String()).Msgf("Pipy Restful session closed")
 			return nil
 
-		case <-proxyUpdateChan:
+		case proxyUpdateMsg := <-proxyUpdateChan:
 			log.Info().Str("proxy", proxy.String()).Msg("Broadcast update received")
-			// Queue a full configuration update
+			// Queue a full configuration update, coalesced with the update pending for the proxy if any
 			// Do not send SDS, let sidecar figure out what certs does it want.
-			<-s.workQueues.AddJob(newJob())
+			priority := changePriority
+			var since time.Time
+			if msg, ok := proxyUpdateMsg.(events.PubSubMessage); ok {
+				if msg.Kind == announcements.ProxyUpdate {
+					// Proxy update events are only queued by the resync ticker
+					priority = resyncPriority
+				}
+				since = msg.Timestamp
+			}
+			s.queueConfigUpdate(proxy, priority, since)
 
 		case certRotateMsg := <-certRotateChan:
 			cert := certRotateMsg.(events.PubSubMessage).NewObj.(*certificate.Certificate)
@@ -87,7 +89,7 @@ func (s *Server) informTrafficPolicies(proxyPtr **pipy.Proxy, wg *sync.WaitGroup
 
 				// Empty DiscoveryRequest should create the SDS specific request
 				// Prepare to queue the SDS proxy response job on the worker pool
-				<-s.workQueues.AddJob(newJob())
+				s.queueConfigUpdate(proxy, changePriority, time.Now())
 			}
 		}
 	}
@@ -102,6 +102,21 @@ func (job *PipyConfGeneratorJob) Run() {
 		})
 	}
 
+	// The SidecarScope policies are selected by the labels of the pod, keep the last config of the proxy
+	// rather than publishing one ignoring the workload scoped policies if the pod can not be found
+	var podLabels map[string]string
+	if !proxy.VM {
+		pod, err := s.kubeController.GetPodForProxy(proxy)
+		if err != nil {
+			log.Error().Err(err).Str("proxy", proxy.String()).Msg("Could not find pod for proxy, requeue the proxy config generation")
+			if s.retryProxiesJob != nil {
+				s.retryProxiesJob()
+			}
+			return
+		}
+		podLabels = pod.Labels
+	}
+
 	pipyConf := new(PipyConf)
 
 	desiredSuffix := ""
@@ -117,7 +132,7 @@ func (job *PipyConfGeneratorJob) Run() {
 	certs(s, proxy, pipyConf, proxyServices)
 	plugin(cataloger, s, pipyConf, proxy)
 	inbound(cataloger, s, pipyConf, proxyServices, proxy)
-	outbound(cataloger, s, pipyConf, proxy, s.cfg, desiredSuffix)
+	outbound(cataloger, s, pipyConf, proxy, podLabels, s.cfg, desiredSuffix)
 	egress(cataloger, s, pipyConf, proxy, desiredSuffix)
 	forward(cataloger, s, pipyConf, proxy)
 	cloudConnector(cataloger, pipyConf, s.cfg, proxy)
@@ -125,7 +140,10 @@ func (job *PipyConfGeneratorJob) Run() {
 	reorder(pipyConf)
 	allowedEndpoints(pipyConf, s, warmUpping)
 	dnsResolveDB(pipyConf, s.cfg)
-	job.publishSidecarConf(s.repoClient, proxy, pipyConf)
+	if job.publishSidecarConf(s.repoClient, proxy, pipyConf) && s.propagationTracker != nil &&
+		!proxy.VM && proxy.Metadata != nil {
+		s.propagationTracker.RecordConfig(proxy.Metadata.Namespace, proxy.Metadata.Name, start, proxy.ETag)
+	}
 	end := time.Now()
 
 	log.Debug().Str("proxy", proxy.GetCNPrefix()).
@@ -212,8 +230,8 @@ func forward(cataloger catalog.MeshCataloger, s *Server, pipyConf *PipyConf, _ *
 	return true
 }
 
-func outbound(cataloger catalog.MeshCataloger, s *Server, pipyConf *PipyConf, proxy *pipy.Proxy, cfg configurator.Configurator, desiredSuffix string) bool {
-	outboundTrafficPolicy := cataloger.GetOutboundMeshTrafficPolicy(proxy.Identity)
+func outbound(cataloger catalog.MeshCataloger, s *Server, pipyConf *PipyConf, proxy *pipy.Proxy, podLabels map[string]string, cfg configurator.Configurator, desiredSuffix string) bool {
+	outboundTrafficPolicy := cataloger.GetOutboundMeshTrafficPolicy(proxy.Identity, podLabels)
 	if cfg.IsLocalDNSProxyEnabled() {
 		if len(outboundTrafficPolicy.ServicesResolvableSet) > 0 {
 			if pipyConf.dnsResolveDB == nil {
@@ -269,6 +287,14 @@ func inbound(cataloger catalog.MeshCataloger, s *Server, pipyConf *PipyConf, pro
 				log.Error().Err(aclErr).Msg(aclErr.Error())
 				retry = true
 			}
+			if authnTrafficPolicy, authnErr := cataloger.GetRequestAuthenticationTrafficPolicy(svc); authnErr == nil {
+				if authnTrafficPolicy != nil {
+					generatePipyRequestAuthenticationTrafficPolicy(cataloger, pipyConf, authnTrafficPolicy)
+				}
+			} else {
+				log.Error().Err(authnErr).Msg(authnErr.Error())
+				retry = true
+			}
 			if expTrafficPolicy, expErr := cataloger.GetExportTrafficPolicy(svc); expErr == nil {
 				if expTrafficPolicy != nil {
 					generatePipyServiceExportTrafficRoutePolicy(cataloger, pipyConf, expTrafficPolicy)
@@ -407,6 +433,7 @@ func features(s *Server, proxy *pipy.Proxy, pipyConf *PipyConf) {
 		pipyConf.setEnablePermissiveTrafficPolicyMode((*meshConf).IsPermissiveTrafficPolicyMode())
 		pipyConf.setObservabilityTracing((*meshConf).IsTracingEnabled(), meshConf)
 		pipyConf.setObservabilityRemoteLogging((*meshConf).IsRemoteLoggingEnabled(), meshConf)
+		pipyConf.setObservabilityEgressLogging(meshConf)
 		clusterProps := (*meshConf).GetMeshConfig().Spec.ClusterSet.Properties
 		if len(clusterProps) > 0 {
 			pipyConf.Spec.ClusterSet = make(map[string]string)
@@ -557,7 +584,9 @@ func marshal(pipyConf *PipyConf) ([]client2.BatchItem, error) {
 	}, nil
 }
 
-func (job *PipyConfGeneratorJob) publishSidecarConf(repoClient *client2.PipyRepoClient, proxy *pipy.Proxy, pipyConf *PipyConf) {
+// publishSidecarConf uploads the config of the proxy to the repo if it changed,
+// it returns true if the repo holds the config of the proxy
+func (job *PipyConfGeneratorJob) publishSidecarConf(repoClient *client2.PipyRepoClient, proxy *pipy.Proxy, pipyConf *PipyConf) bool {
 	pipyConf.Ts = nil
 	pipyConf.Version = nil
 	pipyConf.Certificate = nil
@@ -605,16 +634,18 @@ func (job *PipyConfGeneratorJob) publishSidecarConf(repoClient *client2.PipyRepo
 			}
 			if err != nil || !success {
 				_, _ = repoClient.Delete(codebase)
-			} else {
-				proxy.ETag = codebaseCurV
-				log.Debug().Str("proxy", proxy.GetCNPrefix()).
-					Str("id", fmt.Sprintf("%05d", proxy.ID)).
-					Str("prev", fmt.Sprintf("%020d", codebasePreV)).
-					Str("curv", fmt.Sprintf("%020d", codebaseCurV)).
-					Msg("Codebase Regenerated.")
+				return false
 			}
+			proxy.ETag = codebaseCurV
+			log.Debug().Str("proxy", proxy.GetCNPrefix()).
+				Str("id", fmt.Sprintf("%05d", proxy.ID)).
+				Str("prev", fmt.Sprintf("%020d", codebasePreV)).
+				Str("curv", fmt.Sprintf("%020d", codebaseCurV)).
+				Msg("Codebase Regenerated.")
 		}
+		return true
 	}
+	return false
 }
 
 // JobName implementation for this job, for logging purposes
@@ -22,6 +22,7 @@ import (
 	"github.com/flomesh-io/fsm/pkg/k8s"
 	"github.com/flomesh-io/fsm/pkg/service"
 	"github.com/flomesh-io/fsm/pkg/sidecar/v1/providers/pipy/registry"
+	"github.com/flomesh-io/fsm/pkg/trafficpolicy"
 	"github.com/flomesh-io/fsm/pkg/utils/cidr"
 )
 
@@ -88,6 +89,16 @@ func (p *PipyConf) setObservabilityRemoteLogging(enable bool, conf *configurator
 	}
 }
 
+func (p *PipyConf) setObservabilityEgressLogging(conf *configurator.Configurator) {
+	if egressLogging := (*conf).GetMeshConfig().Spec.Observability.EgressLogging; egressLogging.Enable || egressLogging.AuditMode {
+		p.Spec.Observability.EgressLogging = &EgressLoggingSpec{
+			AuditMode: egressLogging.AuditMode,
+		}
+	} else {
+		p.Spec.Observability.EgressLogging = nil
+	}
+}
+
 func (p *PipyConf) setEnableSidecarActiveHealthChecks(enableSidecarActiveHealthChecks bool) (update bool) {
 	if update = p.Spec.FeatureFlags.EnableSidecarActiveHealthChecks != enableSidecarActiveHealthChecks; update {
 		p.Spec.FeatureFlags.EnableSidecarActiveHealthChecks = enableSidecarActiveHealthChecks
@@ -211,11 +222,16 @@ func (p *PipyConf) rebalancedOutboundClusters(catalog catalog.MeshCataloger) (wa
 			continue
 		}
 		hasLocalEndpoints := false
+		hasActiveFailOverEndpoints := false
 		for _, wze := range *weightedEndpoints {
 			if len(wze.Cluster) == 0 {
 				hasLocalEndpoints = true
 				break
 			}
+			// The endpoints of the cluster observed active by the GlobalTrafficPolicy accept the traffic
+			if multiclusterv1alpha1.FailOverLbType == multiclusterv1alpha1.LoadBalancerType(wze.LBType) && wze.Weight > 0 {
+				hasActiveFailOverEndpoints = true
+			}
 		}
 
 		var warmupPolicy *configv1alpha3.TrafficWarmupSpec
@@ -238,6 +254,8 @@ func (p *PipyConf) rebalancedOutboundClusters(catalog catalog.MeshCataloger) (wa
 				if multiclusterv1alpha1.FailOverLbType == multiclusterv1alpha1.LoadBalancerType(wze.LBType) {
 					if hasLocalEndpoints {
 						wze.Weight = constants.ClusterWeightFailOver
+					} else if hasActiveFailOverEndpoints && wze.Weight == 0 {
+						wze.Weight = constants.ClusterWeightFailOver
 					} else {
 						wze.Weight = constants.ClusterWeightAcceptAll
 					}
@@ -612,6 +630,53 @@ func (itm *InboundTrafficMatch) setTCPServiceRateLimit(rateLimit *policyv1alpha1
 	}
 }
 
+func (itm *InboundTrafficMatch) setRequestAuthentication(authnPolicy *trafficpolicy.RequestAuthenticationTrafficPolicy) {
+	if authnPolicy == nil || len(authnPolicy.JWTRules) == 0 {
+		itm.RequestAuthentication = nil
+		return
+	}
+
+	authn := &RequestAuthentication{AllowMissingToken: authnPolicy.AllowMissingToken}
+	for _, rule := range authnPolicy.JWTRules {
+		jwtRule := &JWTRule{
+			Issuer:               rule.Issuer,
+			Audiences:            rule.Audiences,
+			JWKS:                 rule.JWKS,
+			FromParams:           rule.FromParams,
+			ForwardOriginalToken: rule.ForwardOriginalToken,
+		}
+		for _, header := range rule.FromHeaders {
+			jwtRule.FromHeaders = append(jwtRule.FromHeaders, JWTHeader{Name: strings.ToLower(header.Name), Prefix: header.Prefix})
+		}
+		for _, claimToHeader := range rule.OutputClaimToHeaders {
+			jwtRule.OutputClaimToHeaders = append(jwtRule.OutputClaimToHeaders, JWTClaimToHeader{Header: strings.ToLower(claimToHeader.Header), Claim: claimToHeader.Claim})
+		}
+		authn.JWTRules = append(authn.JWTRules, jwtRule)
+	}
+	itm.RequestAuthentication = authn
+}
+
+func (itm *InboundTrafficMatch) setAuthorization(authzPolicy *trafficpolicy.AuthorizationTrafficPolicy) {
+	if authzPolicy == nil {
+		itm.Authorization = nil
+		return
+	}
+
+	authz := &Authorization{DefaultAllow: authzPolicy.DefaultAllow}
+	for _, rule := range authzPolicy.Rules {
+		authz.Rules = append(authz.Rules, &AuthorizationRule{
+			Policy:     rule.Policy,
+			Action:     string(rule.Action),
+			Principals: rule.Principals,
+			Methods:    rule.Methods,
+			Paths:      rule.Paths,
+			Headers:    rule.Headers,
+			Claims:     rule.Claims,
+		})
+	}
+	itm.Authorization = authz
+}
+
 func (itm *InboundTrafficMatch) newTCPServiceRouteRules() *InboundTCPServiceRouteRules {
 	if itm.TCPServiceRouteRules == nil {
 		itm.TCPServiceRouteRules = new(InboundTCPServiceRouteRules)
@@ -857,6 +922,92 @@ func (hrr *HTTPRouteRule) addAllowedService(serviceName ServiceName) {
 	}
 }
 
+func (ohrr *OutboundHTTPRouteRule) setFilters(filters *trafficpolicy.HTTPRouteFilters) {
+	if filters == nil {
+		ohrr.Filters = nil
+		return
+	}
+	ohrr.Filters = new(HTTPRouteFilters)
+	ohrr.Filters.RequestHeaderModifier = newHTTPHeaderModifier(filters.RequestHeaderModifier)
+	ohrr.Filters.ResponseHeaderModifier = newHTTPHeaderModifier(filters.ResponseHeaderModifier)
+	if rewrite := filters.URLRewrite; rewrite != nil {
+		ohrr.Filters.URLRewrite = &HTTPURLRewrite{
+			Hostname:           rewrite.Hostname,
+			ReplaceFullPath:    rewrite.ReplaceFullPath,
+			ReplacePrefixMatch: rewrite.ReplacePrefixMatch,
+		}
+	}
+}
+
+func newHTTPHeaderModifier(modifier *trafficpolicy.HTTPHeaderModifier) *HTTPHeaderModifier {
+	if modifier == nil {
+		return nil
+	}
+	return &HTTPHeaderModifier{
+		Set:    modifier.Set,
+		Add:    modifier.Add,
+		Remove: modifier.Remove,
+	}
+}
+
+func (ohrr *OutboundHTTPRouteRule) setTimeouts(timeouts *trafficpolicy.HTTPRouteTimeouts) {
+	if timeouts == nil {
+		ohrr.Timeouts = nil
+		return
+	}
+	ohrr.Timeouts = new(HTTPRouteTimeouts)
+	if timeouts.Request != nil {
+		request := timeouts.Request.Seconds()
+		ohrr.Timeouts.Request = &request
+	}
+	if timeouts.BackendRequest != nil {
+		backendRequest := timeouts.BackendRequest.Seconds()
+		ohrr.Timeouts.BackendRequest = &backendRequest
+	}
+	if timeouts.Idle != nil {
+		idle := timeouts.Idle.Seconds()
+		ohrr.Timeouts.Idle = &idle
+	}
+	if timeouts.StreamDuration != nil {
+		streamDuration := timeouts.StreamDuration.Seconds()
+		ohrr.Timeouts.StreamDuration = &streamDuration
+	}
+}
+
+func (ohrr *OutboundHTTPRouteRule) setMirror(mirror *trafficpolicy.HTTPRouteMirror) {
+	if mirror == nil {
+		ohrr.Mirror = nil
+		return
+	}
+	ohrr.Mirror = &HTTPRouteMirror{
+		Cluster:             ClusterName(mirror.ClusterName),
+		Host:                mirror.Host,
+		Percent:             mirror.Percent,
+		RequestHeadersToAdd: mirror.RequestHeadersToAdd,
+	}
+}
+
+func (ohrr *OutboundHTTPRouteRule) setFault(fault *trafficpolicy.HTTPRouteFault) {
+	if fault == nil {
+		ohrr.Fault = nil
+		return
+	}
+	ohrr.Fault = &HTTPRouteFault{}
+	if fault.Delay != nil {
+		ohrr.Fault.Delay = &HTTPFaultDelay{
+			Percent: fault.Delay.Percent,
+			Delay:   fault.Delay.FixedDelay.Seconds(),
+		}
+	}
+	if fault.Abort != nil {
+		ohrr.Fault.Abort = &HTTPFaultAbort{
+			Percent:    fault.Abort.Percent,
+			Status:     fault.Abort.HTTPStatus,
+			GRPCStatus: fault.Abort.GRPCStatus,
+		}
+	}
+}
+
 func (ihrr *InboundHTTPRouteRule) setRateLimit(rateLimit *policyv1alpha1.HTTPPerRouteRateLimitSpec) {
 	ihrr.RateLimit = newHTTPPerRouteRateLimit(rateLimit)
 }
@@ -887,6 +1038,18 @@ func (otp *OutboundTrafficPolicy) newClusterConfigs(clusterName ClusterName) *Cl
 	return cluster
 }
 
+func (otp *OutboundTrafficPolicy) addEgressPolicies(policiesPerDestination map[string][]string) {
+	if len(policiesPerDestination) == 0 {
+		return
+	}
+	if otp.EgressPolicies == nil {
+		otp.EgressPolicies = make(map[string][]string)
+	}
+	for destination, policies := range policiesPerDestination {
+		otp.EgressPolicies[destination] = append(otp.EgressPolicies[destination], policies...)
+	}
+}
+
 func (otp *ClusterConfig) addWeightedEndpoint(address Address, port Port, weight Weight) {
 	if otp.Endpoints == nil {
 		weightedEndpoints := make(WeightedEndpoints)
@@ -895,12 +1058,12 @@ func (otp *ClusterConfig) addWeightedEndpoint(address Address, port Port, weight
 	otp.Endpoints.addWeightedEndpoint(address, port, weight)
 }
 
-func (otp *ClusterConfig) addWeightedZoneEndpoint(address Address, port Port, weight Weight, cluster, lbType, contextPath, viaGw string) {
+func (otp *ClusterConfig) addWeightedZoneEndpoint(address Address, port Port, weight Weight, cluster, lbType, contextPath, serverName, viaGw string) {
 	if otp.Endpoints == nil {
 		weightedEndpoints := make(WeightedEndpoints)
 		otp.Endpoints = &weightedEndpoints
 	}
-	otp.Endpoints.addWeightedZoneEndpoint(address, port, weight, cluster, lbType, contextPath, viaGw)
+	otp.Endpoints.addWeightedZoneEndpoint(address, port, weight, cluster, lbType, contextPath, serverName, viaGw)
 }
 
 func (wes *WeightedEndpoints) addWeightedEndpoint(address Address, port Port, weight Weight) {
@@ -917,7 +1080,7 @@ func (wes *WeightedEndpoints) addWeightedEndpoint(address Address, port Port, we
 	}
 }
 
-func (wes *WeightedEndpoints) addWeightedZoneEndpoint(address Address, port Port, weight Weight, cluster, lbType, contextPath, viaGw string) {
+func (wes *WeightedEndpoints) addWeightedZoneEndpoint(address Address, port Port, weight Weight, cluster, lbType, contextPath, serverName, viaGw string) {
 	if addrWithPort.MatchString(string(address)) {
 		httpHostPort := HTTPHostPort(address)
 		(*wes)[httpHostPort] = &WeightedZoneEndpoint{
@@ -925,6 +1088,7 @@ func (wes *WeightedEndpoints) addWeightedZoneEndpoint(address Address, port Port
 			Cluster:     cluster,
 			LBType:      lbType,
 			ContextPath: contextPath,
+			ServerName:  serverName,
 			ViaGateway:  viaGw,
 		}
 	} else {
@@ -934,6 +1098,7 @@ func (wes *WeightedEndpoints) addWeightedZoneEndpoint(address Address, port Port
 			Cluster:     cluster,
 			LBType:      lbType,
 			ContextPath: contextPath,
+			ServerName:  serverName,
 			ViaGateway:  viaGw,
 		}
 	}
@@ -1002,10 +1167,69 @@ func (otp *ClusterConfig) setRetryPolicy(retryPolicy *policyv1alpha1.RetryPolicy
 	otp.RetryPolicy = new(RetryPolicy)
 	otp.RetryPolicy.RetryOn = retryPolicy.RetryOn
 	otp.RetryPolicy.NumRetries = retryPolicy.NumRetries
-	perTryTimeout := retryPolicy.PerTryTimeout.Seconds()
-	otp.RetryPolicy.PerTryTimeout = &perTryTimeout
+	if retryPolicy.PerTryTimeout != nil {
+		perTryTimeout := retryPolicy.PerTryTimeout.Seconds()
+		otp.RetryPolicy.PerTryTimeout = &perTryTimeout
+	}
 	retryBackoffBaseInterval := retryPolicy.RetryBackoffBaseInterval.Seconds()
 	otp.RetryPolicy.RetryBackoffBaseInterval = &retryBackoffBaseInterval
+	if retryPolicy.RetryBackoffMaxInterval != nil {
+		retryBackoffMaxInterval := retryPolicy.RetryBackoffMaxInterval.Seconds()
+		otp.RetryPolicy.RetryBackoffMaxInterval = &retryBackoffMaxInterval
+	}
+	otp.RetryPolicy.RetriableStatusCodes = retryPolicy.RetriableStatusCodes
+	otp.RetryPolicy.RetriableHeaders = retryPolicy.RetriableHeaders
+	if retryPolicy.Budget != nil {
+		otp.RetryPolicy.Budget = &RetryBudget{
+			BudgetPercent:       defaultRetryBudgetPercent,
+			MinRetriesPerSecond: defaultMinRetriesPerSecond,
+		}
+		if retryPolicy.Budget.BudgetPercent != nil {
+			otp.RetryPolicy.Budget.BudgetPercent = *retryPolicy.Budget.BudgetPercent
+		}
+		if retryPolicy.Budget.MinRetriesPerSecond != nil {
+			otp.RetryPolicy.Budget.MinRetriesPerSecond = *retryPolicy.Budget.MinRetriesPerSecond
+		}
+	}
+	if retryPolicy.Hedging != nil && retryPolicy.Hedging.Delay.Duration > 0 {
+		otp.RetryPolicy.Hedging = &Hedging{
+			Delay:       retryPolicy.Hedging.Delay.Seconds(),
+			MaxAttempts: defaultHedgingMaxAttempts,
+		}
+		if retryPolicy.Hedging.MaxAttempts != nil && *retryPolicy.Hedging.MaxAttempts > 1 {
+			otp.RetryPolicy.Hedging.MaxAttempts = *retryPolicy.Hedging.MaxAttempts
+		}
+	}
+}
+
+func (otp *ClusterConfig) setOutlierDetection(outlierDetection *policyv1alpha1.OutlierDetectionSpec) {
+	if outlierDetection == nil {
+		otp.OutlierDetection = nil
+		return
+	}
+	otp.OutlierDetection = &OutlierDetection{
+		BaseEjectionTime:   defaultBaseEjectionTime.Seconds(),
+		MaxEjectionTime:    defaultMaxEjectionTime.Seconds(),
+		MaxEjectionPercent: defaultMaxEjectionPercent,
+	}
+	if outlierDetection.Consecutive5xxErrors == nil && outlierDetection.ConsecutiveGatewayErrors == nil {
+		otp.OutlierDetection.Consecutive5xxErrors = defaultConsecutive5xxErrors
+	}
+	if outlierDetection.Consecutive5xxErrors != nil {
+		otp.OutlierDetection.Consecutive5xxErrors = *outlierDetection.Consecutive5xxErrors
+	}
+	if outlierDetection.ConsecutiveGatewayErrors != nil {
+		otp.OutlierDetection.ConsecutiveGatewayErrors = *outlierDetection.ConsecutiveGatewayErrors
+	}
+	if outlierDetection.BaseEjectionTime != nil {
+		otp.OutlierDetection.BaseEjectionTime = outlierDetection.BaseEjectionTime.Seconds()
+	}
+	if outlierDetection.MaxEjectionTime != nil {
+		otp.OutlierDetection.MaxEjectionTime = outlierDetection.MaxEjectionTime.Seconds()
+	}
+	if outlierDetection.MaxEjectionPercent != nil {
+		otp.OutlierDetection.MaxEjectionPercent = *outlierDetection.MaxEjectionPercent
+	}
 }
 
 func (ftp *ForwardTrafficPolicy) newForwardMatch(rule string) WeightedClusters {
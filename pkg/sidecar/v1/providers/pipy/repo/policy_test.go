@@ -0,0 +1,132 @@
+package repo
+
+import (
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+)
+
+func TestSetOutlierDetection(t *testing.T) {
+	testCases := []struct {
+		name             string
+		outlierDetection *policyv1alpha1.OutlierDetectionSpec
+		expected         *OutlierDetection
+	}{
+		{
+			name:             "not specified",
+			outlierDetection: nil,
+			expected:         nil,
+		},
+		{
+			name:             "defaults",
+			outlierDetection: &policyv1alpha1.OutlierDetectionSpec{},
+			expected: &OutlierDetection{
+				Consecutive5xxErrors: 5,
+				BaseEjectionTime:     30,
+				MaxEjectionTime:      300,
+				MaxEjectionPercent:   10,
+			},
+		},
+		{
+			name: "gateway errors only",
+			outlierDetection: &policyv1alpha1.OutlierDetectionSpec{
+				ConsecutiveGatewayErrors: ptr.To(uint32(3)),
+			},
+			expected: &OutlierDetection{
+				ConsecutiveGatewayErrors: 3,
+				BaseEjectionTime:         30,
+				MaxEjectionTime:          300,
+				MaxEjectionPercent:       10,
+			},
+		},
+		{
+			name: "5xx errors disabled",
+			outlierDetection: &policyv1alpha1.OutlierDetectionSpec{
+				Consecutive5xxErrors: ptr.To(uint32(0)),
+			},
+			expected: &OutlierDetection{
+				BaseEjectionTime:   30,
+				MaxEjectionTime:    300,
+				MaxEjectionPercent: 10,
+			},
+		},
+		{
+			name: "all specified",
+			outlierDetection: &policyv1alpha1.OutlierDetectionSpec{
+				Consecutive5xxErrors:     ptr.To(uint32(2)),
+				ConsecutiveGatewayErrors: ptr.To(uint32(1)),
+				BaseEjectionTime:         &metav1.Duration{Duration: 500 * time.Millisecond},
+				MaxEjectionTime:          &metav1.Duration{Duration: time.Minute},
+				MaxEjectionPercent:       ptr.To(uint32(0)),
+			},
+			expected: &OutlierDetection{
+				Consecutive5xxErrors:     2,
+				ConsecutiveGatewayErrors: 1,
+				BaseEjectionTime:         0.5,
+				MaxEjectionTime:          60,
+				MaxEjectionPercent:       0,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			clusterConfig := &ClusterConfig{OutlierDetection: &OutlierDetection{Consecutive5xxErrors: 1}}
+			clusterConfig.setOutlierDetection(tc.outlierDetection)
+			assert.Equal(tc.expected, clusterConfig.OutlierDetection)
+		})
+	}
+}
+
+func TestSetRetryPolicy(t *testing.T) {
+	assert := tassert.New(t)
+
+	clusterConfig := &ClusterConfig{}
+	clusterConfig.setRetryPolicy(&policyv1alpha1.RetryPolicySpec{
+		RetryOn:                  "5xx",
+		NumRetries:               ptr.To(uint32(3)),
+		RetryBackoffBaseInterval: &metav1.Duration{Duration: 100 * time.Millisecond},
+		RetriableStatusCodes:     []uint32{409},
+		Budget:                   &policyv1alpha1.RetryBudgetSpec{MinRetriesPerSecond: ptr.To(uint32(5))},
+	})
+	assert.Equal(&RetryPolicy{
+		RetryOn:                  "5xx",
+		NumRetries:               ptr.To(uint32(3)),
+		RetryBackoffBaseInterval: ptr.To(0.1),
+		RetriableStatusCodes:     []uint32{409},
+		Budget:                   &RetryBudget{BudgetPercent: 20, MinRetriesPerSecond: 5},
+	}, clusterConfig.RetryPolicy)
+
+	clusterConfig.setRetryPolicy(&policyv1alpha1.RetryPolicySpec{
+		RetryOn:                  "5xx",
+		PerTryTimeout:            &metav1.Duration{Duration: 1500 * time.Millisecond},
+		RetryBackoffBaseInterval: &metav1.Duration{Duration: time.Second},
+	})
+	assert.Equal(ptr.To(1.5), clusterConfig.RetryPolicy.PerTryTimeout)
+	assert.Nil(clusterConfig.RetryPolicy.Budget)
+	assert.Nil(clusterConfig.RetryPolicy.Hedging)
+
+	clusterConfig.setRetryPolicy(&policyv1alpha1.RetryPolicySpec{
+		RetryOn:                  "5xx",
+		RetryBackoffBaseInterval: &metav1.Duration{Duration: time.Second},
+		Hedging:                  &policyv1alpha1.HedgingSpec{Delay: metav1.Duration{Duration: 200 * time.Millisecond}},
+	})
+	assert.Equal(&Hedging{Delay: 0.2, MaxAttempts: 2}, clusterConfig.RetryPolicy.Hedging)
+
+	clusterConfig.setRetryPolicy(&policyv1alpha1.RetryPolicySpec{
+		RetryOn:                  "5xx",
+		RetryBackoffBaseInterval: &metav1.Duration{Duration: time.Second},
+		Hedging:                  &policyv1alpha1.HedgingSpec{Delay: metav1.Duration{Duration: time.Second}, MaxAttempts: ptr.To(uint32(3))},
+	})
+	assert.Equal(&Hedging{Delay: 1, MaxAttempts: 3}, clusterConfig.RetryPolicy.Hedging)
+
+	clusterConfig.setRetryPolicy(nil)
+	assert.Nil(clusterConfig.RetryPolicy)
+}
@@ -0,0 +1,169 @@
+package repo
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+
+	"github.com/flomesh-io/fsm/pkg/metricsstore"
+	"github.com/flomesh-io/fsm/pkg/sidecar/v1/providers/pipy"
+)
+
+// updatePriority is the priority of a pending proxy configuration update, higher first
+type updatePriority int
+
+const (
+	// resyncPriority is the priority of the periodic resync of the configurations of connected proxies
+	resyncPriority updatePriority = iota
+
+	// changePriority is the priority of configuration updates triggered by changes in the mesh
+	changePriority
+
+	// newProxyPriority is the priority of the first configuration of new proxies
+	newProxyPriority
+)
+
+// String returns the name of the priority, used as a metric label
+func (p updatePriority) String() string {
+	switch p {
+	case newProxyPriority:
+		return "new"
+	case changePriority:
+		return "update"
+	default:
+		return "resync"
+	}
+}
+
+// pendingUpdate is a configuration update pending for a proxy
+type pendingUpdate struct {
+	proxy    *pipy.Proxy
+	priority updatePriority
+
+	// since is the time of the earliest event coalesced into the update
+	since time.Time
+
+	// index is the index of the update in the heap
+	index int
+}
+
+// updateHeap orders the pending updates by priority, then by age
+type updateHeap []*pendingUpdate
+
+func (h updateHeap) Len() int { return len(h) }
+
+func (h updateHeap) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority > h[j].priority
+	}
+	return h[i].since.Before(h[j].since)
+}
+
+func (h updateHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *updateHeap) Push(x interface{}) {
+	update := x.(*pendingUpdate)
+	update.index = len(*h)
+	*h = append(*h, update)
+}
+
+func (h *updateHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	update := old[n-1]
+	old[n-1] = nil
+	update.index = -1
+	*h = old[:n-1]
+	return update
+}
+
+// configUpdateQueue holds at most one pending configuration update per proxy, events for a proxy
+// with a pending update are coalesced into it. Updates are handed out by priority so that new
+// proxies are configured before the configurations of connected proxies are resynced.
+type configUpdateQueue struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	updates updateHeap
+	pending map[string]*pendingUpdate // proxy UUID -> pending update
+}
+
+func newConfigUpdateQueue() *configUpdateQueue {
+	q := &configUpdateQueue{
+		pending: make(map[string]*pendingUpdate),
+	}
+	q.cond = sync.NewCond(&q.mu)
+	return q
+}
+
+// add queues a configuration update for the given proxy, the time of the event triggering
+// the update is used to measure its propagation latency
+func (q *configUpdateQueue) add(proxy *pipy.Proxy, priority updatePriority, since time.Time) {
+	if proxy == nil {
+		return
+	}
+	if since.IsZero() {
+		since = time.Now()
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	key := proxy.UUID.String()
+	if update, ok := q.pending[key]; ok {
+		update.proxy = proxy
+		if priority > update.priority {
+			update.priority = priority
+		}
+		if since.Before(update.since) {
+			update.since = since
+		}
+		heap.Fix(&q.updates, update.index)
+		metricsstore.DefaultMetricsStore.ProxyConfigUpdateCoalescedCount.WithLabelValues("proxy").Inc()
+		return
+	}
+
+	update := &pendingUpdate{proxy: proxy, priority: priority, since: since}
+	q.pending[key] = update
+	heap.Push(&q.updates, update)
+	metricsstore.DefaultMetricsStore.ProxyConfigUpdateQueueDepth.Set(float64(len(q.pending)))
+	q.cond.Signal()
+}
+
+// get blocks until an update is pending and returns the update with the highest priority
+func (q *configUpdateQueue) get() *pendingUpdate {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for len(q.updates) == 0 {
+		q.cond.Wait()
+	}
+
+	update := heap.Pop(&q.updates).(*pendingUpdate)
+	delete(q.pending, update.proxy.UUID.String())
+	metricsstore.DefaultMetricsStore.ProxyConfigUpdateQueueDepth.Set(float64(len(q.pending)))
+	return update
+}
+
+// runConfigUpdates hands out the pending configuration updates to the worker pool, one update
+// per worker at a time so that the priority of pending updates is honored
+func (s *Server) runConfigUpdates() {
+	for {
+		update := s.updateQueue.get()
+		<-s.workQueues.AddJob(&PipyConfGeneratorJob{
+			proxy:      update.proxy,
+			repoServer: s,
+			done:       make(chan struct{}),
+		})
+		metricsstore.DefaultMetricsStore.ProxyConfigPropagationLatency.
+			WithLabelValues(update.priority.String()).Observe(time.Since(update.since).Seconds())
+	}
+}
+
+// queueConfigUpdate queues a configuration update for the given proxy
+func (s *Server) queueConfigUpdate(proxy *pipy.Proxy, priority updatePriority, since time.Time) {
+	s.updateQueue.add(proxy, priority, since)
+}
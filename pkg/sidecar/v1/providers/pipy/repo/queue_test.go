@@ -0,0 +1,52 @@
+package repo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/flomesh-io/fsm/pkg/identity"
+	"github.com/flomesh-io/fsm/pkg/models"
+	"github.com/flomesh-io/fsm/pkg/sidecar/v1/providers/pipy"
+)
+
+func TestConfigUpdateQueue(t *testing.T) {
+	assert := tassert.New(t)
+
+	newProxy := func(name string) *pipy.Proxy {
+		return pipy.NewProxy(models.KindSidecar, uuid.New(), name, "ns", identity.New("sa", "ns"), false, nil)
+	}
+	resynced, changed, created := newProxy("resynced"), newProxy("changed"), newProxy("created")
+
+	q := newConfigUpdateQueue()
+	now := time.Now()
+	q.add(resynced, resyncPriority, now)
+	q.add(changed, resyncPriority, now.Add(time.Second))
+	q.add(created, newProxyPriority, now.Add(2*time.Second))
+
+	// Coalesced into the pending update of the proxy, raising its priority and keeping the earliest event
+	q.add(changed, changePriority, now.Add(3*time.Second))
+	q.add(changed, resyncPriority, now.Add(-time.Second))
+	assert.Len(q.pending, 3)
+
+	update := q.get()
+	assert.Equal(created, update.proxy)
+	assert.Equal(newProxyPriority, update.priority)
+
+	update = q.get()
+	assert.Equal(changed, update.proxy)
+	assert.Equal(changePriority, update.priority)
+	assert.Equal(now.Add(-time.Second), update.since)
+
+	update = q.get()
+	assert.Equal(resynced, update.proxy)
+	assert.Empty(q.pending)
+
+	// A proxy can be queued again once its update was handed out
+	q.add(resynced, resyncPriority, time.Time{})
+	update = q.get()
+	assert.Equal(resynced, update.proxy)
+	assert.False(update.since.IsZero())
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/flomesh-io/fsm/pkg/configurator"
 	"github.com/flomesh-io/fsm/pkg/k8s"
 	"github.com/flomesh-io/fsm/pkg/messaging"
+	"github.com/flomesh-io/fsm/pkg/propagation"
 	client2 "github.com/flomesh-io/fsm/pkg/sidecar/v1/providers/pipy/client"
 	"github.com/flomesh-io/fsm/pkg/sidecar/v1/providers/pipy/registry"
 	"github.com/flomesh-io/fsm/pkg/workerpool"
@@ -36,7 +37,7 @@ var (
 )
 
 // NewRepoServer creates a new Aggregated Discovery Service server
-func NewRepoServer(meshCatalog catalog.MeshCataloger, proxyRegistry *registry.ProxyRegistry, fsmNamespace string, cfg configurator.Configurator, certManager *certificate.Manager, kubecontroller k8s.Controller, msgBroker *messaging.Broker) *Server {
+func NewRepoServer(meshCatalog catalog.MeshCataloger, proxyRegistry *registry.ProxyRegistry, fsmNamespace string, cfg configurator.Configurator, certManager *certificate.Manager, kubecontroller k8s.Controller, msgBroker *messaging.Broker, propagationTracker *propagation.Tracker) *Server {
 	if len(cfg.GetRepoServerCodebase()) > 0 {
 		fsmCodebase = fmt.Sprintf("%s/%s", cfg.GetRepoServerCodebase(), fsmCodebase)
 		fsmSidecarCodebase = fmt.Sprintf("%s/%s", cfg.GetRepoServerCodebase(), fsmSidecarCodebase)
@@ -44,18 +45,20 @@ func NewRepoServer(meshCatalog catalog.MeshCataloger, proxyRegistry *registry.Pr
 	}
 
 	server := Server{
-		catalog:        meshCatalog,
-		proxyRegistry:  proxyRegistry,
-		fsmNamespace:   fsmNamespace,
-		cfg:            cfg,
-		certManager:    certManager,
-		workQueues:     workerpool.NewWorkerPool(workerPoolSize),
-		kubeController: kubecontroller,
-		configVerMutex: sync.Mutex{},
-		configVersion:  make(map[string]uint64),
-		pluginSet:      mapset.NewSet(),
-		msgBroker:      msgBroker,
-		repoClient:     client2.NewRepoClient(cfg.GetRepoServerIPAddr(), uint16(cfg.GetProxyServerPort())),
+		catalog:            meshCatalog,
+		proxyRegistry:      proxyRegistry,
+		fsmNamespace:       fsmNamespace,
+		cfg:                cfg,
+		certManager:        certManager,
+		workQueues:         workerpool.NewWorkerPool(workerPoolSize),
+		updateQueue:        newConfigUpdateQueue(),
+		kubeController:     kubecontroller,
+		configVerMutex:     sync.Mutex{},
+		configVersion:      make(map[string]uint64),
+		pluginSet:          mapset.NewSet(),
+		msgBroker:          msgBroker,
+		propagationTracker: propagationTracker,
+		repoClient:         client2.NewRepoClient(cfg.GetRepoServerIPAddr(), uint16(cfg.GetProxyServerPort())),
 	}
 
 	prettyConfig = func() bool {
@@ -113,6 +116,11 @@ func (s *Server) Start(_ uint32, _ *certificate.Certificate) error {
 		return err
 	}
 
+	// Start the routines handing out the pending configuration updates to the worker pool
+	for i := 0; i < s.workQueues.GetWorkerNumber(); i++ {
+		go s.runConfigUpdates()
+	}
+
 	// Start broadcast listener thread
 	go s.broadcastListener()
 
@@ -16,6 +16,7 @@ import (
 	"github.com/flomesh-io/fsm/pkg/k8s"
 	"github.com/flomesh-io/fsm/pkg/logger"
 	"github.com/flomesh-io/fsm/pkg/messaging"
+	"github.com/flomesh-io/fsm/pkg/propagation"
 	"github.com/flomesh-io/fsm/pkg/service"
 	"github.com/flomesh-io/fsm/pkg/sidecar/v1/providers/pipy/client"
 	"github.com/flomesh-io/fsm/pkg/sidecar/v1/providers/pipy/registry"
@@ -27,6 +28,19 @@ var (
 	log = logger.New("flomesh-pipy")
 )
 
+const (
+	// defaults of the outlier detection of the clusters, as in Envoy
+	defaultConsecutive5xxErrors = 5
+	defaultBaseEjectionTime     = 30 * time.Second
+	defaultMaxEjectionTime      = 300 * time.Second
+	defaultMaxEjectionPercent   = 10
+
+	// defaults of the retry budgets and the hedging of the clusters
+	defaultRetryBudgetPercent  = 20
+	defaultMinRetriesPerSecond = 10
+	defaultHedgingMaxAttempts  = 2
+)
+
 // Server implements the Aggregate Discovery Services
 type Server struct {
 	catalog        catalog.MeshCataloger
@@ -36,6 +50,7 @@ type Server struct {
 	certManager    *certificate.Manager
 	ready          bool
 	workQueues     *workerpool.WorkerPool
+	updateQueue    *configUpdateQueue
 	kubeController k8s.Controller
 
 	// When snapshot cache is enabled, we (currently) don't keep track of proxy information, however different
@@ -51,6 +66,9 @@ type Server struct {
 
 	msgBroker *messaging.Broker
 
+	// propagationTracker records the configs generated for proxies, nil when propagation is not tracked
+	propagationTracker *propagation.Tracker
+
 	repoClient *client.PipyRepoClient
 
 	retryProxiesJob func()
@@ -235,6 +253,15 @@ type ObservabilitySpec struct {
 
 	// RemoteLogging defines OSM's remote logging configuration.
 	RemoteLogging *RemoteLoggingSpec `json:"remoteLogging,omitempty"`
+
+	// EgressLogging defines the egress access logging and metrics configuration.
+	EgressLogging *EgressLoggingSpec `json:"egressLogging,omitempty"`
+}
+
+// EgressLoggingSpec is the type to represent egress access logging and metrics configuration.
+type EgressLoggingSpec struct {
+	// AuditMode defines if egress traffic allowed by no Egress policy is let through and logged as audited.
+	AuditMode bool `json:"auditMode,omitempty"`
 }
 
 // MeshConfigSpec represents the spec of mesh config
@@ -282,7 +309,7 @@ type RetryPolicy struct {
 	// RetryOn defines the policies to retry on, delimited by comma.
 	RetryOn string `json:"RetryOn"`
 
-	// PerTryTimeout defines the time allowed for a retry before it's considered a failed attempt.
+	// PerTryTimeout defines the time allowed for each attempt before it's considered a failed attempt.
 	// +optional
 	PerTryTimeout *float64 `json:"PerTryTimeout"`
 
@@ -293,12 +320,46 @@ type RetryPolicy struct {
 	// RetryBackoffBaseInterval defines the base interval for exponential retry backoff.
 	// +optional
 	RetryBackoffBaseInterval *float64 `json:"RetryBackoffBaseInterval"`
+
+	// RetryBackoffMaxInterval defines the maximum interval between retries.
+	// +optional
+	RetryBackoffMaxInterval *float64 `json:"RetryBackoffMaxInterval,omitempty"`
+
+	// RetriableStatusCodes defines the status codes retried in addition to RetryOn.
+	// +optional
+	RetriableStatusCodes []uint32 `json:"RetriableStatusCodes,omitempty"`
+
+	// RetriableHeaders defines the response headers causing a retry.
+	// +optional
+	RetriableHeaders []string `json:"RetriableHeaders,omitempty"`
+
+	// Budget defines the limit on the retries.
+	// +optional
+	Budget *RetryBudget `json:"Budget,omitempty"`
+
+	// Hedging defines the hedging of the idempotent requests.
+	// +optional
+	Hedging *Hedging `json:"Hedging,omitempty"`
+}
+
+// RetryBudget defines the limit on the retries of the requests to a cluster
+type RetryBudget struct {
+	BudgetPercent       uint32 `json:"BudgetPercent"`
+	MinRetriesPerSecond uint32 `json:"MinRetriesPerSecond"`
+}
+
+// Hedging defines the hedging of the idempotent requests to a cluster, the delay in seconds
+type Hedging struct {
+	Delay       float64 `json:"Delay"`
+	MaxAttempts uint32  `json:"MaxAttempts"`
 }
 
 // WeightedCluster is a struct of a cluster and is weight that is backing a service
 type WeightedCluster struct {
 	service.WeightedCluster
 	RetryPolicy *v1alpha1.RetryPolicySpec
+	// Mirror is true if the cluster only receives mirrored requests
+	Mirror bool
 }
 
 // InboundHTTPRouteRule http route rule
@@ -336,14 +397,122 @@ type InboundTrafficMatch struct {
 	HTTPServiceRouteRules InboundHTTPServiceRouteRules `json:"HttpServiceRouteRules,omitempty"`
 	TCPServiceRouteRules  *InboundTCPServiceRouteRules `json:"TcpServiceRouteRules,omitempty"`
 	TCPRateLimit          *TCPRateLimit                `json:"RateLimit,omitempty"`
+	RequestAuthentication *RequestAuthentication       `json:"RequestAuthentication,omitempty"`
+	Authorization         *Authorization               `json:"Authorization,omitempty"`
+}
+
+// Authorization represents the ordered authorization rules of inbound requests
+type Authorization struct {
+	Rules        []*AuthorizationRule `json:"Rules,omitempty"`
+	DefaultAllow bool                 `json:"DefaultAllow"`
+}
+
+// AuthorizationRule represents the conditions of a request and the action taken when all of them match
+type AuthorizationRule struct {
+	Policy     string              `json:"Policy"`
+	Action     string              `json:"Action"`
+	Principals []string            `json:"Principals,omitempty"`
+	Methods    []string            `json:"Methods,omitempty"`
+	Paths      []string            `json:"Paths,omitempty"`
+	Headers    map[string][]string `json:"Headers,omitempty"`
+	Claims     map[string][]string `json:"Claims,omitempty"`
+}
+
+// RequestAuthentication represents the JWT validation of inbound requests
+type RequestAuthentication struct {
+	JWTRules          []*JWTRule `json:"JWTRules"`
+	AllowMissingToken bool       `json:"AllowMissingToken,omitempty"`
+}
+
+// JWTRule represents how to validate the JWT issued by an issuer
+type JWTRule struct {
+	Issuer               string             `json:"Issuer"`
+	Audiences            []string           `json:"Audiences,omitempty"`
+	JWKS                 string             `json:"JWKS"`
+	FromHeaders          []JWTHeader        `json:"FromHeaders,omitempty"`
+	FromParams           []string           `json:"FromParams,omitempty"`
+	OutputClaimToHeaders []JWTClaimToHeader `json:"OutputClaimToHeaders,omitempty"`
+	ForwardOriginalToken bool               `json:"ForwardOriginalToken,omitempty"`
+}
+
+// JWTHeader represents a header the JWT is extracted from
+type JWTHeader struct {
+	Name   string `json:"Name"`
+	Prefix string `json:"Prefix,omitempty"`
+}
+
+// JWTClaimToHeader represents a claim copied to a request header
+type JWTClaimToHeader struct {
+	Header string `json:"Header"`
+	Claim  string `json:"Claim"`
 }
 
 // InboundTrafficMatches is a wrapper type of map[Port]*InboundTrafficMatch
 type InboundTrafficMatches map[Port]*InboundTrafficMatch
 
+// HTTPHeaderModifier represents the modifications of http headers
+type HTTPHeaderModifier struct {
+	Set    map[string]string `json:"Set,omitempty"`
+	Add    map[string]string `json:"Add,omitempty"`
+	Remove []string          `json:"Remove,omitempty"`
+}
+
+// HTTPURLRewrite represents the rewrite of http host and path
+type HTTPURLRewrite struct {
+	Hostname           *string `json:"Hostname,omitempty"`
+	ReplaceFullPath    *string `json:"ReplaceFullPath,omitempty"`
+	ReplacePrefixMatch *string `json:"ReplacePrefixMatch,omitempty"`
+}
+
+// HTTPRouteFilters represents the filters of http route rule
+type HTTPRouteFilters struct {
+	RequestHeaderModifier  *HTTPHeaderModifier `json:"RequestHeaderModifier,omitempty"`
+	ResponseHeaderModifier *HTTPHeaderModifier `json:"ResponseHeaderModifier,omitempty"`
+	URLRewrite             *HTTPURLRewrite     `json:"URLRewrite,omitempty"`
+}
+
+// HTTPRouteTimeouts represents the timeouts of http route rule, in seconds
+type HTTPRouteTimeouts struct {
+	Request        *float64 `json:"Request,omitempty"`
+	BackendRequest *float64 `json:"BackendRequest,omitempty"`
+	Idle           *float64 `json:"Idle,omitempty"`
+	StreamDuration *float64 `json:"StreamDuration,omitempty"`
+}
+
+// HTTPRouteMirror represents the mirroring of the requests matching an http route rule to a cluster
+type HTTPRouteMirror struct {
+	Cluster             ClusterName       `json:"Cluster"`
+	Host                string            `json:"Host,omitempty"`
+	Percent             uint32            `json:"Percent"`
+	RequestHeadersToAdd map[string]string `json:"RequestHeadersToAdd,omitempty"`
+}
+
+// HTTPRouteFault represents the faults injected into the requests matching an http route rule
+type HTTPRouteFault struct {
+	Delay *HTTPFaultDelay `json:"Delay,omitempty"`
+	Abort *HTTPFaultAbort `json:"Abort,omitempty"`
+}
+
+// HTTPFaultDelay represents the delay injected into a percentage of the requests, in seconds
+type HTTPFaultDelay struct {
+	Percent uint32  `json:"Percent"`
+	Delay   float64 `json:"Delay"`
+}
+
+// HTTPFaultAbort represents the abort injected into a percentage of the requests
+type HTTPFaultAbort struct {
+	Percent    uint32  `json:"Percent"`
+	Status     int32   `json:"Status"`
+	GRPCStatus *uint32 `json:"GRPCStatus,omitempty"`
+}
+
 // OutboundHTTPRouteRule http route rule
 type OutboundHTTPRouteRule struct {
 	HTTPRouteRule
+	Filters  *HTTPRouteFilters  `json:"Filters,omitempty"`
+	Timeouts *HTTPRouteTimeouts `json:"Timeouts,omitempty"`
+	Mirror   *HTTPRouteMirror   `json:"Mirror,omitempty"`
+	Fault    *HTTPRouteFault    `json:"Fault,omitempty"`
 }
 
 // OutboundHTTPRouteRuleSlice http route rule array
@@ -398,6 +567,7 @@ type WeightedZoneEndpoint struct {
 	Cluster     string `json:"Key,omitempty"`
 	LBType      string `json:"-"`
 	ContextPath string `json:"Path,omitempty"`
+	ServerName  string `json:"ServerName,omitempty"`
 	ViaGateway  string `json:"ViaGateway,omitempty"`
 }
 
@@ -409,10 +579,20 @@ type ClusterConfig struct {
 	Endpoints          *WeightedEndpoints  `json:"Endpoints"`
 	ConnectionSettings *ConnectionSettings `json:"ConnectionSettings,omitempty"`
 	RetryPolicy        *RetryPolicy        `json:"RetryPolicy,omitempty"`
+	OutlierDetection   *OutlierDetection   `json:"OutlierDetection,omitempty"`
 	SourceCert         *Certificate        `json:"SourceCert,omitempty"`
 	Hash               uint64              `json:"Hash,omitempty"`
 }
 
+// OutlierDetection defines the ejection of the failing endpoints of a cluster, durations in seconds
+type OutlierDetection struct {
+	Consecutive5xxErrors     uint32  `json:"Consecutive5xxErrors"`
+	ConsecutiveGatewayErrors uint32  `json:"ConsecutiveGatewayErrors"`
+	BaseEjectionTime         float64 `json:"BaseEjectionTime"`
+	MaxEjectionTime          float64 `json:"MaxEjectionTime"`
+	MaxEjectionPercent       uint32  `json:"MaxEjectionPercent"`
+}
+
 // EgressGatewayClusterConfigs represents the configs of Egress Gateway Cluster
 type EgressGatewayClusterConfigs struct {
 	ClusterConfig
@@ -424,6 +604,7 @@ type OutboundTrafficPolicy struct {
 	namedTrafficMatches namedOutboundTrafficMatches
 	TrafficMatches      OutboundTrafficMatches         `json:"TrafficMatches"`
 	ClustersConfigs     map[ClusterName]*ClusterConfig `json:"ClustersConfigs"`
+	EgressPolicies      map[string][]string            `json:"EgressPolicies,omitempty"`
 }
 
 // ForwardTrafficMatches is a wrapper type of map[Port]WeightedClusters
@@ -38,6 +38,7 @@ func generatePipyInboundTrafficPolicy(meshCatalog catalog.MeshCataloger, pipyCon
 			tm.setProtocol(Protocol(destinationProtocol))
 			tm.setPort(Port(trafficMatch.DestinationPort))
 			tm.setTCPServiceRateLimit(trafficMatch.RateLimit)
+			tm.setAuthorization(trafficMatch.Authorization)
 
 			if destinationProtocol == constants.ProtocolHTTP ||
 				trafficMatch.DestinationProtocol == constants.ProtocolGRPC {
@@ -198,17 +199,29 @@ func generatePipyOutboundTrafficRoutePolicy(_ catalog.MeshCataloger, pipyConf *P
 					}
 
 					hsrr, _ := hsrrs.newHTTPServiceRouteRule(httpMatch)
+					hsrr.setFilters(route.Filters)
+					hsrr.setTimeouts(route.Timeouts)
+					hsrr.setMirror(route.Mirror)
+					hsrr.setFault(route.Fault)
 					for cluster := range route.WeightedClusters.Iter() {
 						serviceCluster := cluster.(service.WeightedCluster)
 						weightedCluster := &WeightedCluster{
 							WeightedCluster: serviceCluster,
 							RetryPolicy:     route.RetryPolicy,
 						}
-						if _, exists := dependClusters[weightedCluster.ClusterName]; !exists {
+						if existing, exists := dependClusters[weightedCluster.ClusterName]; !exists || existing.Mirror {
 							dependClusters[weightedCluster.ClusterName] = weightedCluster
 						}
 						hsrr.addWeightedCluster(ClusterName(weightedCluster.ClusterName), Weight(weightedCluster.Weight))
 					}
+					if route.Mirror != nil {
+						if _, exists := dependClusters[route.Mirror.ClusterName]; !exists {
+							dependClusters[route.Mirror.ClusterName] = &WeightedCluster{
+								WeightedCluster: service.WeightedCluster{ClusterName: route.Mirror.ClusterName},
+								Mirror:          true,
+							}
+						}
+					}
 				}
 			}
 		} else if destinationProtocol == constants.ProtocolTCP ||
@@ -219,7 +232,7 @@ func generatePipyOutboundTrafficRoutePolicy(_ catalog.MeshCataloger, pipyConf *P
 				weightedCluster := &WeightedCluster{
 					WeightedCluster: serviceCluster,
 				}
-				if _, exists := dependClusters[weightedCluster.ClusterName]; !exists {
+				if existing, exists := dependClusters[weightedCluster.ClusterName]; !exists || existing.Mirror {
 					dependClusters[weightedCluster.ClusterName] = weightedCluster
 				}
 				tsrr.addWeightedCluster(ClusterName(weightedCluster.ClusterName), Weight(weightedCluster.Weight))
@@ -290,6 +303,7 @@ func generatePipyEgressTrafficRoutePolicy(meshCatalog catalog.MeshCataloger, pip
 	}
 
 	otp := pipyConf.newOutboundTrafficPolicy()
+	otp.addEgressPolicies(egressPolicy.PoliciesPerDestination)
 	dependClusters := make(map[service.ClusterName]*WeightedCluster)
 	for _, trafficMatch := range egressPolicy.TrafficMatches {
 		destinationProtocol := strings.ToLower(trafficMatch.DestinationProtocol)
@@ -427,6 +441,7 @@ func generatePipyOutboundTrafficBalancePolicy(meshCatalog catalog.MeshCataloger,
 		cfg.GetMeshConfig().Spec.ClusterSet.Zone,
 		cfg.GetMeshConfig().Spec.ClusterSet.Region)
 	otp := pipyConf.newOutboundTrafficPolicy()
+	localityZone := meshCatalog.GetLocalityZone(proxy)
 	clustersConfigsMap := make(map[string][]*trafficpolicy.MeshClusterConfig)
 	if len(outboundPolicy.ClustersConfigs) > 0 {
 		for _, clustersConfig := range outboundPolicy.ClustersConfigs {
@@ -442,16 +457,18 @@ func generatePipyOutboundTrafficBalancePolicy(meshCatalog catalog.MeshCataloger,
 	for _, cluster := range dependClusters {
 		meshClusterConfigs := clustersConfigsMap[string(cluster.ClusterName)]
 		if len(meshClusterConfigs) == 0 {
-			ready = false
+			// mirrored requests are dropped rather than holding the config back
+			ready = ready && cluster.Mirror
 			continue
 		}
 		for _, clusterConfig := range meshClusterConfigs {
 			clusterConfigs := otp.newClusterConfigs(ClusterName(cluster.ClusterName.String()))
 			upstreamEndpoints := getUpstreamEndpoints(meshCatalog, proxy.Identity, service.ClusterName(clusterConfig.Service.ClusterName()))
 			if len(upstreamEndpoints) == 0 {
-				ready = false
+				ready = ready && cluster.Mirror
 				continue
 			}
+			upstreamEndpoints = meshCatalog.ApplyLocalityLoadBalancing(localityZone, upstreamEndpoints)
 			for _, upstreamEndpoint := range upstreamEndpoints {
 				address := Address(upstreamEndpoint.IP.String())
 				port := Port(clusterConfig.Service.Port)
@@ -482,12 +499,13 @@ func generatePipyOutboundTrafficBalancePolicy(meshCatalog catalog.MeshCataloger,
 						port = Port(upstreamEndpoint.Port)
 					}
 				}
-				clusterConfigs.addWeightedZoneEndpoint(address, port, weight, upstreamEndpoint.ClusterKey, upstreamEndpoint.LBType, upstreamEndpoint.Path, viaGw)
+				clusterConfigs.addWeightedZoneEndpoint(address, port, weight, upstreamEndpoint.ClusterKey, upstreamEndpoint.LBType, upstreamEndpoint.Path, upstreamEndpoint.ServerName, viaGw)
 			}
 			if clusterConfig.UpstreamTrafficSetting != nil {
 				if clusterConfig.UpstreamTrafficSetting.Spec.ConnectionSettings != nil {
 					clusterConfigs.setConnectionSettings(clusterConfig.UpstreamTrafficSetting.Spec.ConnectionSettings)
 				}
+				clusterConfigs.setOutlierDetection(clusterConfig.UpstreamTrafficSetting.Spec.OutlierDetection)
 			}
 			if cluster.RetryPolicy != nil {
 				clusterConfigs.setRetryPolicy(cluster.RetryPolicy)
@@ -692,6 +710,17 @@ func generatePipyEgressTrafficForwardPolicy(_ catalog.MeshCataloger, pipyConf *P
 	return success
 }
 
+func generatePipyRequestAuthenticationTrafficPolicy(_ catalog.MeshCataloger, pipyConf *PipyConf, authnPolicy *trafficpolicy.RequestAuthenticationTrafficPolicy) {
+	if pipyConf.Inbound == nil {
+		return
+	}
+
+	itp := pipyConf.newInboundTrafficPolicy()
+	if tm := itp.getTrafficMatch(Port(authnPolicy.Port)); tm != nil {
+		tm.setRequestAuthentication(authnPolicy)
+	}
+}
+
 func generatePipyAccessControlTrafficRoutePolicy(_ catalog.MeshCataloger, pipyConf *PipyConf, aclPolicy *trafficpolicy.AccessControlTrafficPolicy) {
 	if len(aclPolicy.TrafficMatches) == 0 {
 		return
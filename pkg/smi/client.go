@@ -75,6 +75,27 @@ func NewSMIClient(informerCollection *informers.InformerCollection, fsmNamespace
 	}
 	informerCollection.AddEventHandler(informers.InformerKeyTrafficTarget, k8s.GetEventHandlerFuncs(shouldObserve, trafficTargetEventTypes, msgBroker))
 
+	httpRouteEventTypes := k8s.EventTypes{
+		Add:    a.GatewayAPIHTTPRouteAdded,
+		Update: a.GatewayAPIHTTPRouteUpdated,
+		Delete: a.GatewayAPIHTTPRouteDeleted,
+	}
+	informerCollection.AddEventHandler(informers.InformerKeyGatewayAPIHTTPRoute, k8s.GetEventHandlerFuncs(shouldObserve, httpRouteEventTypes, msgBroker))
+
+	grpcRouteEventTypes := k8s.EventTypes{
+		Add:    a.GatewayAPIGRPCRouteAdded,
+		Update: a.GatewayAPIGRPCRouteUpdated,
+		Delete: a.GatewayAPIGRPCRouteDeleted,
+	}
+	informerCollection.AddEventHandler(informers.InformerKeyGatewayAPIGRPCRoute, k8s.GetEventHandlerFuncs(shouldObserve, grpcRouteEventTypes, msgBroker))
+
+	referenceGrantEventTypes := k8s.EventTypes{
+		Add:    a.GatewayAPIReferenceGrantAdded,
+		Update: a.GatewayAPIReferenceGrantUpdated,
+		Delete: a.GatewayAPIReferenceGrantDeleted,
+	}
+	informerCollection.AddEventHandler(informers.InformerKeyGatewayAPIReferenceGrant, k8s.GetEventHandlerFuncs(shouldObserve, referenceGrantEventTypes, msgBroker))
+
 	return &client
 }
 
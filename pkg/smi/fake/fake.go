@@ -5,8 +5,11 @@ import (
 	access "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
 	smiSpecs "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
 	split "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha4"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	"github.com/flomesh-io/fsm/pkg/identity"
+	"github.com/flomesh-io/fsm/pkg/service"
 	"github.com/flomesh-io/fsm/pkg/smi"
 	"github.com/flomesh-io/fsm/pkg/tests"
 )
@@ -81,3 +84,18 @@ func (f fakeMeshSpec) ListTrafficTargets(opts ...smi.TrafficTargetListOption) []
 	}
 	return trafficTargets
 }
+
+// ListHTTPRoutes lists Gateway API HTTPRoute resources attached to the given mesh service for the fake Mesh Spec
+func (f fakeMeshSpec) ListHTTPRoutes(_ service.MeshService) []*gwv1.HTTPRoute {
+	return nil
+}
+
+// ListGRPCRoutes lists Gateway API GRPCRoute resources attached to the given mesh service for the fake Mesh Spec
+func (f fakeMeshSpec) ListGRPCRoutes(_ service.MeshService) []*gwv1.GRPCRoute {
+	return nil
+}
+
+// ListReferenceGrants lists Gateway API ReferenceGrant resources for the fake Mesh Spec
+func (f fakeMeshSpec) ListReferenceGrants() []*gwv1beta1.ReferenceGrant {
+	return nil
+}
@@ -0,0 +1,113 @@
+package smi
+
+import (
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/flomesh-io/fsm/pkg/constants"
+	"github.com/flomesh-io/fsm/pkg/k8s/informers"
+	"github.com/flomesh-io/fsm/pkg/service"
+)
+
+// ListHTTPRoutes lists the Gateway API HTTPRoute resources attached to the given mesh service by a Service parentRef (GAMMA)
+func (c *Client) ListHTTPRoutes(svc service.MeshService) []*gwv1.HTTPRoute {
+	var httpRoutes []*gwv1.HTTPRoute
+	for _, routeIface := range c.informers.List(informers.InformerKeyGatewayAPIHTTPRoute) {
+		route := routeIface.(*gwv1.HTTPRoute)
+
+		if !c.kubeController.IsMonitoredNamespace(route.Namespace) {
+			continue
+		}
+
+		if HasServiceParentRef(route.Namespace, route.Spec.ParentRefs, svc) {
+			httpRoutes = append(httpRoutes, route)
+		}
+	}
+
+	sort.Slice(httpRoutes, func(i, j int) bool {
+		return isOlderRoute(&httpRoutes[i].ObjectMeta, &httpRoutes[j].ObjectMeta)
+	})
+
+	return httpRoutes
+}
+
+// ListGRPCRoutes lists the Gateway API GRPCRoute resources attached to the given mesh service by a Service parentRef (GAMMA)
+func (c *Client) ListGRPCRoutes(svc service.MeshService) []*gwv1.GRPCRoute {
+	var grpcRoutes []*gwv1.GRPCRoute
+	for _, routeIface := range c.informers.List(informers.InformerKeyGatewayAPIGRPCRoute) {
+		route := routeIface.(*gwv1.GRPCRoute)
+
+		if !c.kubeController.IsMonitoredNamespace(route.Namespace) {
+			continue
+		}
+
+		if HasServiceParentRef(route.Namespace, route.Spec.ParentRefs, svc) {
+			grpcRoutes = append(grpcRoutes, route)
+		}
+	}
+
+	sort.Slice(grpcRoutes, func(i, j int) bool {
+		return isOlderRoute(&grpcRoutes[i].ObjectMeta, &grpcRoutes[j].ObjectMeta)
+	})
+
+	return grpcRoutes
+}
+
+// ListReferenceGrants lists the Gateway API ReferenceGrant resources, which allow routes to refer to
+// backends in other namespaces
+func (c *Client) ListReferenceGrants() []*gwv1beta1.ReferenceGrant {
+	var referenceGrants []*gwv1beta1.ReferenceGrant
+	for _, refGrantIface := range c.informers.List(informers.InformerKeyGatewayAPIReferenceGrant) {
+		refGrant := refGrantIface.(*gwv1beta1.ReferenceGrant)
+
+		if !c.kubeController.IsMonitoredNamespace(refGrant.Namespace) {
+			continue
+		}
+
+		referenceGrants = append(referenceGrants, refGrant)
+	}
+
+	return referenceGrants
+}
+
+// HasServiceParentRef checks if any of the parentRefs of a route in the given namespace refers to the mesh service,
+// a parentRef without port attaches the route to all the ports of the service
+func HasServiceParentRef(routeNamespace string, parentRefs []gwv1.ParentReference, svc service.MeshService) bool {
+	for _, ref := range parentRefs {
+		if ref.Group == nil || string(*ref.Group) != constants.KubernetesCoreGroup {
+			continue
+		}
+		if ref.Kind == nil || string(*ref.Kind) != constants.KubernetesServiceKind {
+			continue
+		}
+
+		namespace := routeNamespace
+		if ref.Namespace != nil {
+			namespace = string(*ref.Namespace)
+		}
+		if namespace != svc.Namespace || string(ref.Name) != svc.Name {
+			continue
+		}
+
+		if ref.Port == nil || uint16(*ref.Port) == svc.Port {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isOlderRoute returns true if route a takes precedence over route b,
+// conflicts are resolved by creation timestamp first and then by namespace/name
+func isOlderRoute(a, b *metav1.ObjectMeta) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+	return a.Name < b.Name
+}
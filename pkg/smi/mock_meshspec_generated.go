@@ -8,10 +8,13 @@ import (
 	reflect "reflect"
 
 	identity "github.com/flomesh-io/fsm/pkg/identity"
+	service "github.com/flomesh-io/fsm/pkg/service"
 	gomock "github.com/golang/mock/gomock"
 	v1alpha3 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
 	v1alpha4 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
 	v1alpha40 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha4"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	v1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 // MockMeshSpec is a mock of MeshSpec interface.
@@ -65,6 +68,34 @@ func (mr *MockMeshSpecMockRecorder) GetTCPRoute(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTCPRoute", reflect.TypeOf((*MockMeshSpec)(nil).GetTCPRoute), arg0)
 }
 
+// ListGRPCRoutes mocks base method.
+func (m *MockMeshSpec) ListGRPCRoutes(arg0 service.MeshService) []*v1.GRPCRoute {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGRPCRoutes", arg0)
+	ret0, _ := ret[0].([]*v1.GRPCRoute)
+	return ret0
+}
+
+// ListGRPCRoutes indicates an expected call of ListGRPCRoutes.
+func (mr *MockMeshSpecMockRecorder) ListGRPCRoutes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGRPCRoutes", reflect.TypeOf((*MockMeshSpec)(nil).ListGRPCRoutes), arg0)
+}
+
+// ListHTTPRoutes mocks base method.
+func (m *MockMeshSpec) ListHTTPRoutes(arg0 service.MeshService) []*v1.HTTPRoute {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHTTPRoutes", arg0)
+	ret0, _ := ret[0].([]*v1.HTTPRoute)
+	return ret0
+}
+
+// ListHTTPRoutes indicates an expected call of ListHTTPRoutes.
+func (mr *MockMeshSpecMockRecorder) ListHTTPRoutes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHTTPRoutes", reflect.TypeOf((*MockMeshSpec)(nil).ListHTTPRoutes), arg0)
+}
+
 // ListHTTPTrafficSpecs mocks base method.
 func (m *MockMeshSpec) ListHTTPTrafficSpecs() []*v1alpha4.HTTPRouteGroup {
 	m.ctrl.T.Helper()
@@ -93,6 +124,20 @@ func (mr *MockMeshSpecMockRecorder) ListServiceAccounts() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceAccounts", reflect.TypeOf((*MockMeshSpec)(nil).ListServiceAccounts))
 }
 
+// ListReferenceGrants mocks base method.
+func (m *MockMeshSpec) ListReferenceGrants() []*v1beta1.ReferenceGrant {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReferenceGrants")
+	ret0, _ := ret[0].([]*v1beta1.ReferenceGrant)
+	return ret0
+}
+
+// ListReferenceGrants indicates an expected call of ListReferenceGrants.
+func (mr *MockMeshSpecMockRecorder) ListReferenceGrants() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReferenceGrants", reflect.TypeOf((*MockMeshSpec)(nil).ListReferenceGrants))
+}
+
 // ListTCPTrafficSpecs mocks base method.
 func (m *MockMeshSpec) ListTCPTrafficSpecs() []*v1alpha4.TCPRoute {
 	m.ctrl.T.Helper()
@@ -6,6 +6,8 @@ import (
 	access "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
 	spec "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
 	split "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha4"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	"github.com/flomesh-io/fsm/pkg/identity"
 	"github.com/flomesh-io/fsm/pkg/k8s"
@@ -49,6 +51,15 @@ type MeshSpec interface {
 	// ListTrafficTargets lists SMI TrafficTarget resources. An optional filter can be applied to filter the
 	// returned list
 	ListTrafficTargets(...TrafficTargetListOption) []*access.TrafficTarget
+
+	// ListHTTPRoutes lists Gateway API HTTPRoute resources attached to the given mesh service (GAMMA)
+	ListHTTPRoutes(service.MeshService) []*gwv1.HTTPRoute
+
+	// ListGRPCRoutes lists Gateway API GRPCRoute resources attached to the given mesh service (GAMMA)
+	ListGRPCRoutes(service.MeshService) []*gwv1.GRPCRoute
+
+	// ListReferenceGrants lists Gateway API ReferenceGrant resources
+	ListReferenceGrants() []*gwv1beta1.ReferenceGrant
 }
 
 // TrafficTargetListOpt specifies the options used to filter TrafficTarget objects as a part of its lister
@@ -0,0 +1,68 @@
+package telemetry
+
+import (
+	"time"
+)
+
+// Counters accumulates the counters scraped from a sidecar across its restarts, a counter
+// lower than its last scraped value means the sidecar restarted and is accumulated as is
+type Counters[K comparable] struct {
+	totals   map[K]uint64
+	scraped  map[K]uint64
+	lastSeen map[K]time.Time
+}
+
+// NewCounters returns an empty set of accumulated counters
+func NewCounters[K comparable]() *Counters[K] {
+	return &Counters[K]{
+		totals:   make(map[K]uint64),
+		scraped:  make(map[K]uint64),
+		lastSeen: make(map[K]time.Time),
+	}
+}
+
+// Update accumulates the given scraped counter values, a counter is seen at the given
+// time if its value changed since the last scrape
+func (c *Counters[K]) Update(values map[K]uint64, now time.Time) {
+	for key, value := range values {
+		previous, ok := c.scraped[key]
+		if !ok && value == 0 {
+			continue
+		}
+		switch {
+		case value > previous:
+			c.totals[key] += value - previous
+			c.lastSeen[key] = now
+		case value < previous && value > 0:
+			c.totals[key] += value
+			c.lastSeen[key] = now
+		}
+		c.scraped[key] = value
+	}
+	for key := range c.scraped {
+		if _, ok := values[key]; !ok {
+			c.scraped[key] = 0
+		}
+	}
+}
+
+// Prune drops the counters last seen before the given time and returns true if no counter is left
+func (c *Counters[K]) Prune(before time.Time) bool {
+	for key, lastSeen := range c.lastSeen {
+		if lastSeen.Before(before) {
+			delete(c.totals, key)
+			delete(c.scraped, key)
+			delete(c.lastSeen, key)
+		}
+	}
+	return len(c.lastSeen) == 0
+}
+
+// Range calls fn for every counter with a non zero total
+func (c *Counters[K]) Range(fn func(key K, total uint64, lastSeen time.Time)) {
+	for key, total := range c.totals {
+		if total > 0 {
+			fn(key, total, c.lastSeen[key])
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package telemetry
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// DefaultScrapeTimeout is the default timeout for scraping the metrics of a single sidecar
+	DefaultScrapeTimeout = 5 * time.Second
+
+	// DefaultScrapeConcurrency is the default maximum number of sidecars scraped concurrently
+	DefaultScrapeConcurrency = 16
+)
+
+// Scraper scrapes the given metrics of the sidecars of many pods concurrently,
+// each sidecar is scraped within its own timeout so a slow sidecar does not delay the others
+type Scraper struct {
+	names       []string
+	timeout     time.Duration
+	concurrency int
+
+	// scrape is the function scraping a single sidecar, replaced in tests
+	scrape func(ctx context.Context, pod *corev1.Pod, names ...string) ([]Sample, error)
+}
+
+// NewScraper returns a scraper of the given metrics with the default timeout and concurrency
+func NewScraper(names ...string) *Scraper {
+	client := &http.Client{Timeout: DefaultScrapeTimeout}
+	return &Scraper{
+		names:       names,
+		timeout:     DefaultScrapeTimeout,
+		concurrency: DefaultScrapeConcurrency,
+		scrape: func(ctx context.Context, pod *corev1.Pod, names ...string) ([]Sample, error) {
+			return Scrape(ctx, client, pod, names...)
+		},
+	}
+}
+
+// ScrapeAll scrapes the sidecars of the given pods that can be scraped and calls fn with the samples
+// of every sidecar scraped successfully, fn may be called concurrently. Pods failing to be scraped are
+// logged and skipped.
+func (s *Scraper) ScrapeAll(pods []*corev1.Pod, fn func(pod *corev1.Pod, samples []Sample)) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, s.concurrency)
+	for _, pod := range pods {
+		if !IsScrapable(pod) {
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(pod *corev1.Pod) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+			defer cancel()
+
+			samples, err := s.scrape(ctx, pod, s.names...)
+			if err != nil {
+				log.Debug().Err(err).Msgf("Failed to scrape the metrics of pod %s/%s", pod.Namespace, pod.Name)
+				return
+			}
+			fn(pod, samples)
+		}(pod)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,129 @@
+// Package telemetry scrapes the metrics exposed by the sidecars of the meshed pods.
+package telemetry
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/flomesh-io/fsm/pkg/constants"
+	"github.com/flomesh-io/fsm/pkg/logger"
+)
+
+var log = logger.New("telemetry")
+
+// Sample is a sample of a metric exposed by a sidecar
+type Sample struct {
+	Name   string
+	Labels map[string]string
+	Value  float64
+}
+
+// IsScrapable returns true if the given pod runs a sidecar whose metrics can be scraped
+func IsScrapable(pod *corev1.Pod) bool {
+	if _, ok := pod.Labels[constants.SidecarUniqueIDLabelName]; !ok {
+		return false
+	}
+	return pod.Status.Phase == corev1.PodRunning && len(pod.Status.PodIP) > 0
+}
+
+// Scrape returns the samples of the given metrics exposed by the sidecar of the given pod
+func Scrape(ctx context.Context, client *http.Client, pod *corev1.Pod, names ...string) ([]Sample, error) {
+	url := fmt.Sprintf("http://%s/stats/prometheus", net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(constants.SidecarPrometheusInboundListenerPort)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() //nolint: errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return Parse(resp.Body, names...)
+}
+
+// Parse returns the samples of the given metrics in the Prometheus text exposition format,
+// only the lines of the given metrics are parsed so malformed lines of other metrics are ignored
+func Parse(r io.Reader, names ...string) ([]Sample, error) {
+	var samples []Sample
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+
+		for _, name := range names {
+			if !strings.HasPrefix(line, name) {
+				continue
+			}
+
+			rest := line[len(name):]
+			labels := map[string]string{}
+			switch {
+			case strings.HasPrefix(rest, "{"):
+				end := strings.LastIndex(rest, "}")
+				if end < 0 {
+					continue
+				}
+				labels = parseLabels(rest[1:end])
+				rest = rest[end+1:]
+			case strings.HasPrefix(rest, " "):
+			default:
+				continue
+			}
+
+			value, err := strconv.ParseFloat(strings.TrimSpace(rest), 64)
+			if err != nil {
+				continue
+			}
+			samples = append(samples, Sample{Name: name, Labels: labels, Value: value})
+			break
+		}
+	}
+
+	return samples, scanner.Err()
+}
+
+// parseLabels parses the label pairs of a metric sample such as a="x",b="y"
+func parseLabels(s string) map[string]string {
+	labels := make(map[string]string)
+	for len(s) > 0 {
+		eq := strings.Index(s, "=\"")
+		if eq < 0 {
+			break
+		}
+		name := strings.TrimSpace(strings.TrimPrefix(s[:eq], ","))
+		s = s[eq+2:]
+
+		var value strings.Builder
+		i := 0
+		for ; i < len(s) && s[i] != '"'; i++ {
+			if s[i] == '\\' && i+1 < len(s) {
+				i++
+			}
+			value.WriteByte(s[i])
+		}
+		labels[name] = value.String()
+		if i >= len(s) {
+			break
+		}
+		s = s[i+1:]
+	}
+	return labels
+}
@@ -0,0 +1,138 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/flomesh-io/fsm/pkg/constants"
+)
+
+func TestParse(t *testing.T) {
+	assert := tassert.New(t)
+
+	metrics := `# HELP sidecar_cluster_upstream_rq_total upstream requests
+# TYPE sidecar_cluster_upstream_rq_total counter
+sidecar_cluster_upstream_rq_total{source_namespace="bookbuyer",sidecar_cluster_name="bookstore/bookstore|14001"} 12
+sidecar_cluster_upstream_rq_total_bucket{le="5"} 1
+sidecar_cluster_upstream_cx_tx_bytes_total{sidecar_cluster_name="a \"quoted\" name"} 1024
+sidecar_server_live 1
+sidecar_cluster_upstream_cx_active{sidecar_cluster_name="bookstore/bookstore|14001"} 2
+`
+
+	samples, err := Parse(strings.NewReader(metrics), "sidecar_cluster_upstream_rq_total", "sidecar_cluster_upstream_cx_tx_bytes_total", "sidecar_server_live")
+	assert.Nil(err)
+	assert.Equal([]Sample{
+		{
+			Name:   "sidecar_cluster_upstream_rq_total",
+			Labels: map[string]string{"source_namespace": "bookbuyer", "sidecar_cluster_name": "bookstore/bookstore|14001"},
+			Value:  12,
+		},
+		{
+			Name:   "sidecar_cluster_upstream_cx_tx_bytes_total",
+			Labels: map[string]string{"sidecar_cluster_name": `a "quoted" name`},
+			Value:  1024,
+		},
+		{
+			Name:   "sidecar_server_live",
+			Labels: map[string]string{},
+			Value:  1,
+		},
+	}, samples)
+}
+
+func TestCounters(t *testing.T) {
+	assert := tassert.New(t)
+
+	counters := NewCounters[string]()
+	now := time.Now()
+	collect := func() map[string]uint64 {
+		totals := map[string]uint64{}
+		counters.Range(func(key string, total uint64, _ time.Time) {
+			totals[key] = total
+		})
+		return totals
+	}
+
+	counters.Update(map[string]uint64{"a": 3, "b": 0}, now)
+	assert.Equal(map[string]uint64{"a": 3}, collect())
+
+	counters.Update(map[string]uint64{"a": 5, "b": 2}, now.Add(time.Minute))
+	assert.Equal(map[string]uint64{"a": 5, "b": 2}, collect())
+
+	// The sidecar restarted
+	counters.Update(map[string]uint64{"a": 1}, now.Add(2*time.Minute))
+	assert.Equal(map[string]uint64{"a": 6, "b": 2}, collect())
+
+	assert.False(counters.Prune(now.Add(90 * time.Second)))
+	assert.Equal(map[string]uint64{"a": 6}, collect())
+
+	assert.True(counters.Prune(now.Add(time.Hour)))
+	assert.Empty(collect())
+}
+
+func TestScrapeAll(t *testing.T) {
+	assert := tassert.New(t)
+
+	var pods []*corev1.Pod
+	for i := 0; i < 8; i++ {
+		pods = append(pods, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      fmt.Sprintf("pod-%d", i),
+				Labels:    map[string]string{constants.SidecarUniqueIDLabelName: "uuid"},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning, PodIP: fmt.Sprintf("10.0.0.%d", i)},
+		})
+	}
+	// Pods without a sidecar are not scraped
+	pods = append(pods, &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "no-sidecar"},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIP: "10.0.1.1"},
+	})
+
+	var active, maxActive int32
+	s := &Scraper{
+		names:       []string{"metric"},
+		timeout:     50 * time.Millisecond,
+		concurrency: 2,
+		scrape: func(ctx context.Context, pod *corev1.Pod, _ ...string) ([]Sample, error) {
+			n := atomic.AddInt32(&active, 1)
+			defer atomic.AddInt32(&active, -1)
+			for {
+				m := atomic.LoadInt32(&maxActive)
+				if n <= m || atomic.CompareAndSwapInt32(&maxActive, m, n) {
+					break
+				}
+			}
+
+			// A hanging sidecar is given up on its own timeout
+			if pod.Name == "pod-0" {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+			return []Sample{{Name: "metric", Value: 1}}, nil
+		},
+	}
+
+	var mu sync.Mutex
+	scraped := map[string]int{}
+	s.ScrapeAll(pods, func(pod *corev1.Pod, samples []Sample) {
+		mu.Lock()
+		defer mu.Unlock()
+		scraped[pod.Name] = len(samples)
+	})
+
+	assert.Len(scraped, 7)
+	assert.NotContains(scraped, "pod-0")
+	assert.NotContains(scraped, "no-sidecar")
+	assert.LessOrEqual(atomic.LoadInt32(&maxActive), int32(2))
+}
@@ -0,0 +1,240 @@
+package trafficpolicy
+
+import (
+	"fmt"
+	"strings"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+)
+
+// Evaluate returns whether the given request is allowed and the rule making the decision,
+// the returned rule is nil if the decision is made by default
+func (p *AuthorizationTrafficPolicy) Evaluate(req *AuthorizationRequest) (bool, *AuthorizationRule) {
+	if p == nil {
+		return true, nil
+	}
+
+	for _, rule := range p.Rules {
+		if rule.Matches(req) {
+			return rule.Action != policyv1alpha1.AuthorizationActionDeny, rule
+		}
+	}
+
+	return p.DefaultAllow, nil
+}
+
+// Matches returns true if the given request matches all the conditions of the rule
+func (r *AuthorizationRule) Matches(req *AuthorizationRequest) bool {
+	return matchPrincipal(r.Principals, req.Principal) &&
+		matchMethod(r.Methods, req.Method) &&
+		matchPath(r.Paths, req.Path) &&
+		matchHeaders(r.Headers, req.Headers) &&
+		matchClaims(r.Claims, req.Claims)
+}
+
+func matchPrincipal(principals []string, principal string) bool {
+	if len(principals) == 0 {
+		return true
+	}
+	if len(principal) == 0 {
+		return false
+	}
+
+	for _, p := range principals {
+		switch {
+		case p == "*":
+			return true
+		case strings.HasPrefix(p, "*."):
+			if strings.HasSuffix(principal, p[1:]) {
+				return true
+			}
+		case p == principal:
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchMethod(methods []string, method string) bool {
+	if len(methods) == 0 {
+		return true
+	}
+
+	for _, m := range methods {
+		if strings.EqualFold(m, method) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchPath(paths []string, path string) bool {
+	if len(paths) == 0 {
+		return true
+	}
+
+	path = NormalizePath(path)
+	for _, p := range paths {
+		if strings.HasPrefix(path, p) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NormalizePath returns the path of the given request target the way the upstream resolves it, so that a
+// path can't be crafted to bypass the rules on its prefix, e.g. //admin, /./admin, /x/../admin or /%61dmin:
+// the query is stripped, the percent-encoded unreserved characters are decoded, the repeated slashes are
+// collapsed and the dot segments are resolved. It's the same as normalizePath of inbound-http-authz.js.
+func NormalizePath(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	path = decodeUnreserved(path)
+
+	var segments []string
+	trailingSlash := false
+	for _, segment := range strings.Split(path, "/") {
+		trailingSlash = segment == "" || segment == "." || segment == ".."
+		switch segment {
+		case "", ".":
+		case "..":
+			if len(segments) > 0 {
+				segments = segments[:len(segments)-1]
+			}
+		default:
+			segments = append(segments, segment)
+		}
+	}
+
+	if len(segments) == 0 {
+		return "/"
+	}
+	if trailingSlash {
+		return "/" + strings.Join(segments, "/") + "/"
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+// decodeUnreserved decodes the percent-encoded unreserved characters of the given path,
+// the other percent-encoded characters such as %2F are kept as is
+func decodeUnreserved(path string) string {
+	if !strings.Contains(path, "%") {
+		return path
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		if path[i] == '%' && i+2 < len(path) {
+			if c, ok := unhex(path[i+1], path[i+2]); ok && isUnreserved(c) {
+				b.WriteByte(c)
+				i += 2
+				continue
+			}
+		}
+		b.WriteByte(path[i])
+	}
+	return b.String()
+}
+
+func unhex(h, l byte) (byte, bool) {
+	hexValue := func(c byte) (byte, bool) {
+		switch {
+		case c >= '0' && c <= '9':
+			return c - '0', true
+		case c >= 'a' && c <= 'f':
+			return c - 'a' + 10, true
+		case c >= 'A' && c <= 'F':
+			return c - 'A' + 10, true
+		}
+		return 0, false
+	}
+
+	hv, ok := hexValue(h)
+	if !ok {
+		return 0, false
+	}
+	lv, ok := hexValue(l)
+	if !ok {
+		return 0, false
+	}
+	return hv<<4 | lv, true
+}
+
+func isUnreserved(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+func matchHeaders(headers map[string][]string, reqHeaders map[string]string) bool {
+	for name, values := range headers {
+		value, ok := reqHeaders[name]
+		if !ok {
+			return false
+		}
+		if len(values) > 0 && !contains(values, value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchClaims(claims map[string][]string, payload map[string]interface{}) bool {
+	for name, values := range claims {
+		var claim interface{} = payload
+		for _, k := range strings.Split(name, ".") {
+			obj, ok := claim.(map[string]interface{})
+			if !ok {
+				return false
+			}
+			if claim, ok = obj[k]; !ok {
+				return false
+			}
+		}
+
+		switch c := claim.(type) {
+		case []interface{}:
+			found := false
+			for _, item := range c {
+				if contains(values, fmt.Sprint(item)) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		case []string:
+			found := false
+			for _, item := range c {
+				if contains(values, item) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		default:
+			if !contains(values, fmt.Sprint(c)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,60 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+)
+
+func TestNormalizePath(t *testing.T) {
+	testCases := []struct {
+		path     string
+		expected string
+	}{
+		{path: "", expected: "/"},
+		{path: "/", expected: "/"},
+		{path: "/admin", expected: "/admin"},
+		{path: "/admin/", expected: "/admin/"},
+		{path: "/admin?x=/y", expected: "/admin"},
+		{path: "//admin", expected: "/admin"},
+		{path: "/api//admin///users", expected: "/api/admin/users"},
+		{path: "/./admin", expected: "/admin"},
+		{path: "/x/../admin", expected: "/admin"},
+		{path: "/../../admin", expected: "/admin"},
+		{path: "/admin/.", expected: "/admin/"},
+		{path: "/admin/x/..", expected: "/admin/"},
+		{path: "/%61dmin", expected: "/admin"},
+		{path: "/%2E%2e/admin", expected: "/admin"},
+		{path: "/%7Eu%5Fx", expected: "/~u_x"},
+		{path: "/admin%2Fusers", expected: "/admin%2Fusers"},
+		{path: "/a%zz%4", expected: "/a%zz%4"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			tassert.Equal(t, tc.expected, NormalizePath(tc.path))
+		})
+	}
+}
+
+func TestEvaluateNormalizedPath(t *testing.T) {
+	assert := tassert.New(t)
+
+	policy := &AuthorizationTrafficPolicy{
+		Rules: []*AuthorizationRule{
+			{Action: policyv1alpha1.AuthorizationActionDeny, Paths: []string{"/admin"}},
+		},
+		DefaultAllow: true,
+	}
+
+	for _, path := range []string{"/admin", "//admin", "/./admin", "/x/../admin", "/%61dmin", "/%2e%2e/admin/users"} {
+		allowed, rule := policy.Evaluate(&AuthorizationRequest{Method: "GET", Path: path})
+		assert.False(allowed, path)
+		assert.NotNil(rule, path)
+	}
+
+	allowed, _ := policy.Evaluate(&AuthorizationRequest{Method: "GET", Path: "/x/admin"})
+	assert.True(allowed)
+}
@@ -0,0 +1,57 @@
+package trafficpolicy
+
+import policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+
+// AuthorizationTrafficPolicy defines the ordered authorization rules of the requests received by a given backend
+type AuthorizationTrafficPolicy struct {
+	// Rules is the list of rules in evaluation order, the action of the first matching rule is taken
+	Rules []*AuthorizationRule
+
+	// DefaultAllow defines if a request matching no rule is allowed, which is the case if no ALLOW policy applies
+	DefaultAllow bool
+}
+
+// AuthorizationRule defines the conditions of a request and the action taken when all of them match
+type AuthorizationRule struct {
+	// Policy is the namespaced name of the AuthorizationPolicy the rule belongs to
+	Policy string
+
+	// Index is the index of the rule in the AuthorizationPolicy
+	Index int
+
+	// Action is the action taken for the requests matching the rule
+	Action policyv1alpha1.AuthorizationAction
+
+	// Principals is the list of source service identities, `*` matches any identity and `*.<namespace>` matches any identity in the namespace
+	Principals []string
+
+	// Methods is the list of HTTP methods
+	Methods []string
+
+	// Paths is the list of path prefixes
+	Paths []string
+
+	// Headers maps the lowercased header names to the accepted values, any value is accepted if empty
+	Headers map[string][]string
+
+	// Claims maps the JWT claim names to the accepted values
+	Claims map[string][]string
+}
+
+// AuthorizationRequest defines the attributes of a request evaluated against an AuthorizationTrafficPolicy
+type AuthorizationRequest struct {
+	// Principal is the service identity of the source, empty if the source is not part of the mesh
+	Principal string
+
+	// Method is the HTTP method
+	Method string
+
+	// Path is the HTTP path, the query string is ignored
+	Path string
+
+	// Headers maps the lowercased header names to their values
+	Headers map[string]string
+
+	// Claims is the payload of the validated JWT
+	Claims map[string]interface{}
+}
@@ -25,6 +25,11 @@ type EgressTrafficPolicy struct {
 	// The specified config is used to program external clusters corresponding to
 	// the external endpoints defined in an Egress policy.
 	ClustersConfigs []*EgressClusterConfig
+
+	// PoliciesPerDestination defines the Egress policies allowing a destination, keyed by host:port for the
+	// policies scoped to hosts and by the destination port for the policies allowing any host. The policies
+	// are referred as namespace/name and are used to attribute the egress access logs and metrics.
+	PoliciesPerDestination map[string][]string
 }
 
 // EgressClusterConfig is the type used to represent an external cluster corresponding to a
@@ -0,0 +1,15 @@
+package trafficpolicy
+
+import policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+
+// RequestAuthenticationTrafficPolicy defines the JWT validation of the requests received by a given backend
+type RequestAuthenticationTrafficPolicy struct {
+	// Port is the target port of the backend
+	Port uint32
+
+	// JWTRules is the list of rules with the JSON Web Key Set of each rule resolved inline
+	JWTRules []policyv1alpha1.JWTRule
+
+	// AllowMissingToken defines if requests without JWT are allowed
+	AllowMissingToken bool
+}
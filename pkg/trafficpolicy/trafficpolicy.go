@@ -88,6 +88,12 @@ func (rwc *RouteWeightedClusters) TotalClustersWeight() int {
 // already exists, an error will be returned. If a Route with the given HTTP route match does not exist,
 // a Route with the given HTTP route match and weighted clusters will be added to the Routes on the OutboundTrafficPolicy
 func (out *OutboundTrafficPolicy) AddRoute(httpRouteMatch HTTPRouteMatch, retryPolicy *policyv1alpha1.RetryPolicySpec, weightedClusters ...service.WeightedCluster) error {
+	return out.AddRouteWithFilters(httpRouteMatch, retryPolicy, nil, nil, weightedClusters...)
+}
+
+// AddRouteWithFilters adds a route with the given filters and timeouts to an OutboundTrafficPolicy given an HTTP route match and weighted cluster.
+// If a Route with the given HTTP route match already exists, an error will be returned. If a Route with the given HTTP route match and weighted clusters already exists, the Route will not be added.
+func (out *OutboundTrafficPolicy) AddRouteWithFilters(httpRouteMatch HTTPRouteMatch, retryPolicy *policyv1alpha1.RetryPolicySpec, filters *HTTPRouteFilters, timeouts *HTTPRouteTimeouts, weightedClusters ...service.WeightedCluster) error {
 	wc := mapset.NewSet()
 	for _, c := range weightedClusters {
 		wc.Add(c)
@@ -97,6 +103,8 @@ func (out *OutboundTrafficPolicy) AddRoute(httpRouteMatch HTTPRouteMatch, retryP
 		if reflect.DeepEqual(existingRoute.HTTPRouteMatch, httpRouteMatch) {
 			if existingRoute.WeightedClusters.Equal(wc) {
 				existingRoute.RetryPolicy = retryPolicy
+				existingRoute.Filters = filters
+				existingRoute.Timeouts = timeouts
 				return nil
 			}
 			return fmt.Errorf("Route for HTTP Route Match: %v already exists: %v for outbound traffic policy: %s", existingRoute.HTTPRouteMatch, existingRoute, out.Name)
@@ -107,6 +115,8 @@ func (out *OutboundTrafficPolicy) AddRoute(httpRouteMatch HTTPRouteMatch, retryP
 		HTTPRouteMatch:   httpRouteMatch,
 		WeightedClusters: wc,
 		RetryPolicy:      retryPolicy,
+		Filters:          filters,
+		Timeouts:         timeouts,
 	})
 
 	return nil
@@ -3,6 +3,8 @@
 package trafficpolicy
 
 import (
+	"time"
+
 	mapset "github.com/deckarep/golang-set"
 
 	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
@@ -44,6 +46,70 @@ type HTTPRouteMatchWithWeightedClusters struct {
 	UpstreamClusters []service.WeightedCluster
 	RouteMatches     []HTTPRouteMatch
 	HasSplitMatches  bool
+
+	// Filters defines the filters applied to the requests matching RouteMatches
+	// +optional
+	Filters *HTTPRouteFilters
+
+	// Timeouts defines the timeouts applied to the requests matching RouteMatches
+	// +optional
+	Timeouts *HTTPRouteTimeouts
+}
+
+// HTTPHeaderModifier is a struct to represent the modifications applied to the headers of an HTTP message
+type HTTPHeaderModifier struct {
+	Set    map[string]string `json:"set:omitempty"`
+	Add    map[string]string `json:"add:omitempty"`
+	Remove []string          `json:"remove:omitempty"`
+}
+
+// HTTPURLRewrite is a struct to represent the rewrite of the host and path of an HTTP request
+type HTTPURLRewrite struct {
+	Hostname           *string `json:"hostname:omitempty"`
+	ReplaceFullPath    *string `json:"replace_full_path:omitempty"`
+	ReplacePrefixMatch *string `json:"replace_prefix_match:omitempty"`
+}
+
+// HTTPRouteFilters is a struct to represent the filters applied to the requests matching a route
+type HTTPRouteFilters struct {
+	RequestHeaderModifier  *HTTPHeaderModifier `json:"request_header_modifier:omitempty"`
+	ResponseHeaderModifier *HTTPHeaderModifier `json:"response_header_modifier:omitempty"`
+	URLRewrite             *HTTPURLRewrite     `json:"url_rewrite:omitempty"`
+}
+
+// HTTPRouteTimeouts is a struct to represent the timeouts applied to the requests matching a route
+type HTTPRouteTimeouts struct {
+	Request        *time.Duration `json:"request:omitempty"`
+	BackendRequest *time.Duration `json:"backend_request:omitempty"`
+	Idle           *time.Duration `json:"idle:omitempty"`
+	StreamDuration *time.Duration `json:"stream_duration:omitempty"`
+}
+
+// HTTPRouteMirror is a struct to represent the mirroring of the requests matching a route to a cluster
+type HTTPRouteMirror struct {
+	ClusterName         service.ClusterName `json:"cluster_name"`
+	Host                string              `json:"host:omitempty"`
+	Percent             uint32              `json:"percent"`
+	RequestHeadersToAdd map[string]string   `json:"request_headers_to_add:omitempty"`
+}
+
+// HTTPRouteFault is a struct to represent the faults injected into the requests matching a route
+type HTTPRouteFault struct {
+	Delay *HTTPFaultDelay `json:"delay:omitempty"`
+	Abort *HTTPFaultAbort `json:"abort:omitempty"`
+}
+
+// HTTPFaultDelay is a struct to represent the delay injected into a percentage of requests
+type HTTPFaultDelay struct {
+	Percent    uint32        `json:"percent"`
+	FixedDelay time.Duration `json:"fixed_delay"`
+}
+
+// HTTPFaultAbort is a struct to represent the abort injected into a percentage of requests
+type HTTPFaultAbort struct {
+	Percent    uint32  `json:"percent"`
+	HTTPStatus int32   `json:"http_status"`
+	GRPCStatus *uint32 `json:"grpc_status:omitempty"`
 }
 
 // TCPRouteMatch is a struct to represent a TCP route matching based on ports
@@ -57,10 +123,26 @@ type RouteWeightedClusters struct {
 	WeightedClusters mapset.Set                      `json:"weighted_clusters:omitempty"`
 	RetryPolicy      *policyv1alpha1.RetryPolicySpec `json:"retry_policy:omitempty"`
 
+	// Filters defines the filters applied to the requests matching HTTPRouteMatch
+	// +optional
+	Filters *HTTPRouteFilters `json:"filters:omitempty"`
+
+	// Timeouts defines the timeouts applied to the requests matching HTTPRouteMatch
+	// +optional
+	Timeouts *HTTPRouteTimeouts `json:"timeouts:omitempty"`
+
 	// RateLimit defines the rate limit settings applied at the route level
 	// for the given HTTPRouteMatch
 	// +optional
 	RateLimit *policyv1alpha1.HTTPPerRouteRateLimitSpec `json:"rate_limit:omitempty"`
+
+	// Mirror defines the mirroring of the requests matching HTTPRouteMatch
+	// +optional
+	Mirror *HTTPRouteMirror `json:"mirror:omitempty"`
+
+	// Fault defines the faults injected into the requests matching HTTPRouteMatch
+	// +optional
+	Fault *HTTPRouteFault `json:"fault:omitempty"`
 }
 
 // InboundTrafficPolicy is a struct that associates incoming traffic on a set of Hostnames with a list of Rules
@@ -205,5 +287,9 @@ type TrafficMatch struct {
 	// +optional
 	RateLimit *policyv1alpha1.RateLimitSpec
 
+	// Authorization defines the authorization rules applied for this TrafficMatch
+	// +optional
+	Authorization *AuthorizationTrafficPolicy
+
 	EgressGateWay *string
 }
@@ -34,7 +34,7 @@ func createOrUpdateValidatingWebhook(clientSet kubernetes.Interface, cert *certi
 			Rule: admissionregv1.Rule{
 				APIGroups:   []string{"policy.flomesh.io"},
 				APIVersions: []string{"v1alpha1"},
-				Resources:   []string{"ingressbackends", "egresses", "egressgateways"},
+				Resources:   []string{"ingressbackends", "egresses", "egressgateways", "requestauthentications", "authorizationpolicies", "sidecarscopes", "meshfaultinjections", "retries"},
 			},
 		},
 		{
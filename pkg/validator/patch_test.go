@@ -20,7 +20,7 @@ var (
 		Rule: admissionregv1.Rule{
 			APIGroups:   []string{"policy.flomesh.io"},
 			APIVersions: []string{"v1alpha1"},
-			Resources:   []string{"ingressbackends", "egresses", "egressgateways"},
+			Resources:   []string{"ingressbackends", "egresses", "egressgateways", "requestauthentications", "authorizationpolicies", "sidecarscopes", "meshfaultinjections", "retries"},
 		},
 	}
 
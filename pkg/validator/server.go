@@ -50,6 +50,11 @@ func NewValidatingWebhook(ctx context.Context, cfg *configurator.Client, webhook
 			policyv1alpha1.SchemeGroupVersion.WithKind("Egress").String():                 egressValidator,
 			policyv1alpha1.SchemeGroupVersion.WithKind("EgressGateway").String():          kv.egressGatewayValidator,
 			policyv1alpha1.SchemeGroupVersion.WithKind("UpstreamTrafficSetting").String(): kv.upstreamTrafficSettingValidator,
+			policyv1alpha1.SchemeGroupVersion.WithKind("RequestAuthentication").String():  requestAuthenticationValidator,
+			policyv1alpha1.SchemeGroupVersion.WithKind("AuthorizationPolicy").String():    authorizationPolicyValidator,
+			policyv1alpha1.SchemeGroupVersion.WithKind("SidecarScope").String():           sidecarScopeValidator,
+			policyv1alpha1.SchemeGroupVersion.WithKind("MeshFaultInjection").String():     meshFaultInjectionValidator,
+			policyv1alpha1.SchemeGroupVersion.WithKind("Retry").String():                  retryValidator,
 			smiAccess.SchemeGroupVersion.WithKind("TrafficTarget").String():               trafficTargetValidator,
 			pluginv1alpha1.SchemeGroupVersion.WithKind("Plugin").String():                 kv.pluginValidator,
 			pluginv1alpha1.SchemeGroupVersion.WithKind("PluginConfig").String():           kv.pluginConfigValidator,
@@ -5,13 +5,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"net/url"
 	"strings"
 
 	mapset "github.com/deckarep/golang-set"
 	smiAccess "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
 	smiSpecs "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
 	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	pluginv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/plugin/v1alpha1"
@@ -19,6 +22,7 @@ import (
 
 	"github.com/flomesh-io/fsm/pkg/configurator"
 	"github.com/flomesh-io/fsm/pkg/constants"
+	"github.com/flomesh-io/fsm/pkg/jwks"
 	"github.com/flomesh-io/fsm/pkg/k8s"
 	"github.com/flomesh-io/fsm/pkg/policy"
 	"github.com/flomesh-io/fsm/pkg/service"
@@ -294,6 +298,233 @@ func (kc *policyValidator) ingressBackendValidator(req *admissionv1.AdmissionReq
 	return nil, nil
 }
 
+// requestAuthenticationValidator validates the RequestAuthentication custom resource
+func requestAuthenticationValidator(req *admissionv1.AdmissionRequest) (*admissionv1.AdmissionResponse, error) {
+	authn := &policyv1alpha1.RequestAuthentication{}
+	if err := json.NewDecoder(bytes.NewBuffer(req.Object.Raw)).Decode(authn); err != nil {
+		return nil, err
+	}
+
+	type setEntry struct {
+		name string
+		port int
+	}
+
+	backends := mapset.NewSet()
+	for _, backend := range authn.Spec.Backends {
+		if unique := backends.Add(setEntry{backend.Name, backend.Port.Number}); !unique {
+			return nil, fmt.Errorf("Duplicate backends detected with service name: %s and port: %d", backend.Name, backend.Port.Number)
+		}
+	}
+
+	if len(authn.Spec.JWTRules) == 0 {
+		return nil, fmt.Errorf("At least one JWT rule must be specified")
+	}
+
+	issuers := mapset.NewSet()
+	for _, rule := range authn.Spec.JWTRules {
+		if len(rule.Issuer) == 0 {
+			return nil, fmt.Errorf("The issuer of a JWT rule must be specified")
+		}
+		if unique := issuers.Add(rule.Issuer); !unique {
+			return nil, fmt.Errorf("Duplicate JWT rules detected with issuer: %s", rule.Issuer)
+		}
+
+		switch {
+		case len(rule.JWKS) > 0:
+			if _, err := jwks.Parse(rule.JWKS); err != nil {
+				return nil, fmt.Errorf("Invalid JWKS of issuer %s: %w", rule.Issuer, err)
+			}
+		case len(rule.JWKSURI) > 0:
+			if u, err := url.Parse(rule.JWKSURI); err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+				return nil, fmt.Errorf("Invalid JWKS URI of issuer %s: %s", rule.Issuer, rule.JWKSURI)
+			}
+		default:
+			return nil, fmt.Errorf("Either jwks or jwksUri must be specified for issuer %s", rule.Issuer)
+		}
+
+		for _, header := range rule.FromHeaders {
+			if len(header.Name) == 0 {
+				return nil, fmt.Errorf("The name of a header the JWT of issuer %s is extracted from must be specified", rule.Issuer)
+			}
+		}
+
+		for _, claimToHeader := range rule.OutputClaimToHeaders {
+			if len(claimToHeader.Header) == 0 || len(claimToHeader.Claim) == 0 {
+				return nil, fmt.Errorf("Both header and claim must be specified in outputClaimToHeaders of issuer %s", rule.Issuer)
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// authorizationPolicyValidator validates the AuthorizationPolicy custom resource
+func authorizationPolicyValidator(req *admissionv1.AdmissionRequest) (*admissionv1.AdmissionResponse, error) {
+	authz := &policyv1alpha1.AuthorizationPolicy{}
+	if err := json.NewDecoder(bytes.NewBuffer(req.Object.Raw)).Decode(authz); err != nil {
+		return nil, err
+	}
+
+	type setEntry struct {
+		name string
+		port int
+	}
+
+	backends := mapset.NewSet()
+	for _, backend := range authz.Spec.Backends {
+		if unique := backends.Add(setEntry{backend.Name, backend.Port.Number}); !unique {
+			return nil, fmt.Errorf("Duplicate backends detected with service name: %s and port: %d", backend.Name, backend.Port.Number)
+		}
+	}
+
+	switch authz.Spec.Action {
+	case "", policyv1alpha1.AuthorizationActionAllow, policyv1alpha1.AuthorizationActionDeny:
+	default:
+		return nil, fmt.Errorf("Expected 'action' to be one of [%s %s], got: %s", policyv1alpha1.AuthorizationActionAllow, policyv1alpha1.AuthorizationActionDeny, authz.Spec.Action)
+	}
+
+	if len(authz.Spec.Rules) == 0 {
+		return nil, fmt.Errorf("At least one rule must be specified")
+	}
+
+	for i, rule := range authz.Spec.Rules {
+		for _, source := range rule.Sources {
+			switch source.Kind {
+			case policyv1alpha1.KindServiceAccount, policyv1alpha1.KindAuthenticatedPrincipal:
+			default:
+				return nil, fmt.Errorf("Expected 'sources.kind' of rule %d to be one of [%s %s], got: %s", i, policyv1alpha1.KindServiceAccount, policyv1alpha1.KindAuthenticatedPrincipal, source.Kind)
+			}
+			if len(source.Name) == 0 {
+				return nil, fmt.Errorf("The name of a source of rule %d must be specified", i)
+			}
+		}
+
+		for _, path := range rule.Paths {
+			if !strings.HasPrefix(path, "/") {
+				return nil, fmt.Errorf("Expected 'paths' of rule %d to start with '/', got: %s", i, path)
+			}
+		}
+
+		for _, header := range rule.Headers {
+			if len(header.Name) == 0 {
+				return nil, fmt.Errorf("The name of a header of rule %d must be specified", i)
+			}
+		}
+
+		for _, claim := range rule.Claims {
+			if len(claim.Name) == 0 || len(claim.Values) == 0 {
+				return nil, fmt.Errorf("Both name and values of a claim of rule %d must be specified", i)
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// sidecarScopeValidator validates the SidecarScope custom resource
+func sidecarScopeValidator(req *admissionv1.AdmissionRequest) (*admissionv1.AdmissionResponse, error) {
+	scope := &policyv1alpha1.SidecarScope{}
+	if err := json.NewDecoder(bytes.NewBuffer(req.Object.Raw)).Decode(scope); err != nil {
+		return nil, err
+	}
+
+	if scope.Spec.WorkloadSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(scope.Spec.WorkloadSelector); err != nil {
+			return nil, fmt.Errorf("Invalid 'workloadSelector': %w", err)
+		}
+	}
+
+	for _, ns := range scope.Spec.Namespaces {
+		if len(ns) == 0 {
+			return nil, fmt.Errorf("Empty namespace is not allowed in 'namespaces'")
+		}
+	}
+
+	for _, svc := range scope.Spec.Services {
+		if len(svc.Name) == 0 {
+			return nil, fmt.Errorf("The name of a service must be specified")
+		}
+	}
+
+	for _, host := range scope.Spec.Hosts {
+		if len(host) == 0 || strings.Contains(strings.TrimPrefix(host, "*."), "*") {
+			return nil, fmt.Errorf("Expected 'hosts' to be a hostname optionally prefixed with '*.', got: %s", host)
+		}
+	}
+
+	return nil, nil
+}
+
+// meshFaultInjectionValidator validates the MeshFaultInjection custom resource
+func meshFaultInjectionValidator(req *admissionv1.AdmissionRequest) (*admissionv1.AdmissionResponse, error) {
+	fault := &policyv1alpha1.MeshFaultInjection{}
+	if err := json.NewDecoder(bytes.NewBuffer(req.Object.Raw)).Decode(fault); err != nil {
+		return nil, err
+	}
+
+	if errs := validation.IsDNS1035Label(fault.Spec.Destination.Name); len(errs) > 0 {
+		return nil, fmt.Errorf("Invalid 'destination.name' %q: %s", fault.Spec.Destination.Name, strings.Join(errs, ", "))
+	}
+
+	if sources := fault.Spec.Sources; sources != nil {
+		for _, ns := range sources.Namespaces {
+			if len(ns) == 0 {
+				return nil, fmt.Errorf("Empty namespace is not allowed in 'sources.namespaces'")
+			}
+		}
+		if sources.PodSelector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(sources.PodSelector); err != nil {
+				return nil, fmt.Errorf("Invalid 'sources.podSelector': %w", err)
+			}
+		}
+	}
+
+	if fault.Spec.Delay == nil && fault.Spec.Abort == nil {
+		return nil, fmt.Errorf("At least one of 'delay' or 'abort' must be specified")
+	}
+
+	if delay := fault.Spec.Delay; delay != nil && delay.FixedDelay.Duration < 0 {
+		return nil, fmt.Errorf("Expected 'delay.fixedDelay' to be non-negative, got: %s", delay.FixedDelay.Duration)
+	}
+
+	return nil, nil
+}
+
+// retryValidator validates the Retry custom resource
+func retryValidator(req *admissionv1.AdmissionRequest) (*admissionv1.AdmissionResponse, error) {
+	retry := &policyv1alpha1.Retry{}
+	if err := json.NewDecoder(bytes.NewBuffer(req.Object.Raw)).Decode(retry); err != nil {
+		return nil, err
+	}
+
+	retryPolicy := retry.Spec.RetryPolicy
+	for _, code := range retryPolicy.RetriableStatusCodes {
+		if code < 100 || code > 599 {
+			return nil, fmt.Errorf("Invalid 'retryPolicy.retriableStatusCodes' %d, must be between 100 and 599", code)
+		}
+	}
+
+	if budget := retryPolicy.Budget; budget != nil && budget.BudgetPercent != nil && *budget.BudgetPercent > 100 {
+		return nil, fmt.Errorf("Invalid 'retryPolicy.budget.budgetPercent' %d, must be between 0 and 100", *budget.BudgetPercent)
+	}
+
+	if timeout := retryPolicy.PerTryTimeout; timeout != nil && timeout.Duration < 0 {
+		return nil, fmt.Errorf("Expected 'retryPolicy.perTryTimeout' to be non-negative, got: %s", timeout.Duration)
+	}
+
+	if hedging := retryPolicy.Hedging; hedging != nil {
+		if hedging.Delay.Duration <= 0 {
+			return nil, fmt.Errorf("Expected 'retryPolicy.hedging.delay' to be positive, got: %s", hedging.Delay.Duration)
+		}
+		if hedging.MaxAttempts != nil && *hedging.MaxAttempts < 2 {
+			return nil, fmt.Errorf("Invalid 'retryPolicy.hedging.maxAttempts' %d, must be at least 2", *hedging.MaxAttempts)
+		}
+	}
+
+	return nil, nil
+}
+
 // egressValidator validates the Egress custom resource
 func egressValidator(req *admissionv1.AdmissionRequest) (*admissionv1.AdmissionResponse, error) {
 	egress := &policyv1alpha1.Egress{}
@@ -373,9 +604,76 @@ func (kc *policyValidator) upstreamTrafficSettingValidator(req *admissionv1.Admi
 		}
 	}
 
+	// Validate mirroring config
+	if err := validateHTTPMirror(upstreamTrafficSetting, upstreamTrafficSetting.Spec.Mirror); err != nil {
+		return nil, err
+	}
+	for _, route := range upstreamTrafficSetting.Spec.HTTPRoutes {
+		if err := validateHTTPMirror(upstreamTrafficSetting, route.Mirror); err != nil {
+			return nil, err
+		}
+	}
+
+	// Validate route timeouts
+	for _, route := range upstreamTrafficSetting.Spec.HTTPRoutes {
+		if route.Timeouts == nil {
+			continue
+		}
+		for name, timeout := range map[string]*metav1.Duration{
+			"request":        route.Timeouts.Request,
+			"idle":           route.Timeouts.Idle,
+			"streamDuration": route.Timeouts.StreamDuration,
+		} {
+			if timeout != nil && timeout.Duration < 0 {
+				return nil, fmt.Errorf("Invalid %s timeout %s of route %s, must not be negative", name, timeout.Duration, route.Path)
+			}
+		}
+	}
+
+	// Validate outlier detection config
+	if od := upstreamTrafficSetting.Spec.OutlierDetection; od != nil {
+		if od.BaseEjectionTime != nil && od.BaseEjectionTime.Duration <= 0 {
+			return nil, fmt.Errorf("Invalid baseEjectionTime %s, must be positive", od.BaseEjectionTime.Duration)
+		}
+		if od.MaxEjectionTime != nil && od.MaxEjectionTime.Duration <= 0 {
+			return nil, fmt.Errorf("Invalid maxEjectionTime %s, must be positive", od.MaxEjectionTime.Duration)
+		}
+		if od.BaseEjectionTime != nil && od.MaxEjectionTime != nil && od.MaxEjectionTime.Duration < od.BaseEjectionTime.Duration {
+			return nil, fmt.Errorf("Invalid maxEjectionTime %s, must not be shorter than baseEjectionTime %s", od.MaxEjectionTime.Duration, od.BaseEjectionTime.Duration)
+		}
+	}
+
 	return nil, nil
 }
 
+// validateHTTPMirror validates the mirroring of the requests directed to the host of an UpstreamTrafficSetting
+func validateHTTPMirror(upstreamTrafficSetting *policyv1alpha1.UpstreamTrafficSetting, mirror *policyv1alpha1.HTTPMirrorSpec) error {
+	if mirror == nil {
+		return nil
+	}
+
+	namespace, name := upstreamTrafficSetting.Namespace, mirror.Service
+	if segs := strings.Split(mirror.Service, "/"); len(segs) == 2 {
+		namespace, name = segs[0], segs[1]
+	}
+	if errs := validation.IsDNS1035Label(name); len(errs) > 0 {
+		return fmt.Errorf("Invalid mirror service %q, expected <name> or <namespace>/<name>", mirror.Service)
+	}
+
+	hostComponents := strings.Split(upstreamTrafficSetting.Spec.Host, ".")
+	if hostComponents[0] == name && hostComponents[1] == namespace {
+		return fmt.Errorf("Invalid mirror service %q, requests cannot be mirrored to the host they are directed to", mirror.Service)
+	}
+
+	for _, header := range mirror.RequestHeadersToAdd {
+		if errs := validation.IsHTTPHeaderName(header.Name); len(errs) > 0 {
+			return fmt.Errorf("Invalid mirror request header name %q", header.Name)
+		}
+	}
+
+	return nil
+}
+
 // egressGatewayValidator validates the EgressGateway custom resource
 func (kc *policyValidator) egressGatewayValidator(req *admissionv1.AdmissionRequest) (*admissionv1.AdmissionResponse, error) {
 	egressGateway := &policyv1alpha1.EgressGateway{}
@@ -395,9 +693,57 @@ func (kc *policyValidator) egressGatewayValidator(req *admissionv1.AdmissionRequ
 		}
 	}
 
+	if len(egressGateway.Spec.Hosts) > 0 && len(egressGateway.Spec.GlobalEgressGateways) == 0 {
+		return nil, fmt.Errorf("Egress gateway hosts require at least one global egress gateway to enforce them")
+	}
+
+	if err := validateEgressGatewayHostRules(egressGateway.Namespace, egressGateway.Spec.Hosts); err != nil {
+		return nil, err
+	}
+
 	return nil, nil
 }
 
+// validateEgressGatewayHostRules validates the destination host rules of an EgressGateway,
+// the secrets referenced by the rules must be in the namespace of the EgressGateway
+func validateEgressGatewayHostRules(namespace string, hostRules []policyv1alpha1.EgressGatewayHostRule) error {
+	hosts := mapset.NewSet()
+	for _, hostRule := range hostRules {
+		host := strings.TrimPrefix(hostRule.Host, "*.")
+		if len(host) == 0 || strings.Contains(host, "*") || strings.Contains(host, ":") {
+			return fmt.Errorf("Invalid egress gateway host %q, expected a host name optionally prefixed with '*.'", hostRule.Host)
+		}
+		if !hosts.Add(hostRule.Host) {
+			return fmt.Errorf("Duplicate egress gateway host %q", hostRule.Host)
+		}
+
+		for _, port := range hostRule.Ports {
+			if port < 1 || port > 65535 {
+				return fmt.Errorf("Invalid port %d of egress gateway host %q", port, hostRule.Host)
+			}
+		}
+
+		if tls := hostRule.TLS; tls != nil && tls.CACertificate != nil {
+			if ns := tls.CACertificate.Namespace; len(ns) > 0 && ns != namespace {
+				return fmt.Errorf("Cross namespace CA certificate secret %s/%s of egress gateway host %q is not allowed", ns, tls.CACertificate.Name, hostRule.Host)
+			}
+		}
+
+		if proxy := hostRule.UpstreamProxy; proxy != nil {
+			if _, _, err := net.SplitHostPort(proxy.Address); err != nil {
+				return fmt.Errorf("Invalid upstream proxy address %q of egress gateway host %q, expected host:port", proxy.Address, hostRule.Host)
+			}
+			if proxy.CredentialsSecret != nil {
+				if ns := proxy.CredentialsSecret.Namespace; len(ns) > 0 && ns != namespace {
+					return fmt.Errorf("Cross namespace credentials secret %s/%s of egress gateway host %q is not allowed", ns, proxy.CredentialsSecret.Name, hostRule.Host)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 // pluginValidator validates the plugin custom resource
 func (kc *policyValidator) pluginValidator(req *admissionv1.AdmissionRequest) (*admissionv1.AdmissionResponse, error) {
 	if !kc.cfg.GetFeatureFlags().EnablePluginPolicy {
@@ -1728,6 +1728,616 @@ func TestEgressValidator(t *testing.T) {
 	}
 }
 
+func TestEgressGatewayValidator(t *testing.T) {
+	testCases := []struct {
+		name      string
+		hosts     string
+		noGlobal  bool
+		expErrStr string
+	}{
+		{
+			name:      "valid host rules pass",
+			hosts:     `[{"host": "*.example.com", "ports": [443], "tls": {"sni": "api.example.com"}}, {"host": "example.org", "upstreamProxy": {"address": "proxy.corp:3128"}}]`,
+			expErrStr: "",
+		},
+		{
+			name:      "wildcard in the middle of host is invalid",
+			hosts:     `[{"host": "api.*.example.com"}]`,
+			expErrStr: `Invalid egress gateway host "api.*.example.com", expected a host name optionally prefixed with '*.'`,
+		},
+		{
+			name:      "duplicate host is invalid",
+			hosts:     `[{"host": "example.org"}, {"host": "example.org", "ports": [80]}]`,
+			expErrStr: `Duplicate egress gateway host "example.org"`,
+		},
+		{
+			name:      "port out of range is invalid",
+			hosts:     `[{"host": "example.org", "ports": [0]}]`,
+			expErrStr: `Invalid port 0 of egress gateway host "example.org"`,
+		},
+		{
+			name:      "upstream proxy without port is invalid",
+			hosts:     `[{"host": "example.org", "upstreamProxy": {"address": "proxy.corp"}}]`,
+			expErrStr: `Invalid upstream proxy address "proxy.corp" of egress gateway host "example.org", expected host:port`,
+		},
+		{
+			name:      "secrets in the namespace of the policy pass",
+			hosts:     `[{"host": "example.org", "tls": {"caCertificate": {"name": "ca", "namespace": "test"}}, "upstreamProxy": {"address": "proxy.corp:3128", "credentialsSecret": {"name": "creds"}}}]`,
+			expErrStr: "",
+		},
+		{
+			name:      "cross namespace CA certificate secret is invalid",
+			hosts:     `[{"host": "example.org", "tls": {"caCertificate": {"name": "ca", "namespace": "other"}}}]`,
+			expErrStr: `Cross namespace CA certificate secret other/ca of egress gateway host "example.org" is not allowed`,
+		},
+		{
+			name:      "cross namespace credentials secret is invalid",
+			hosts:     `[{"host": "example.org", "upstreamProxy": {"address": "proxy.corp:3128", "credentialsSecret": {"name": "creds", "namespace": "other"}}}]`,
+			expErrStr: `Cross namespace credentials secret other/creds of egress gateway host "example.org" is not allowed`,
+		},
+		{
+			name:      "host rules without global egress gateway are invalid",
+			hosts:     `[{"host": "example.org"}]`,
+			noGlobal:  true,
+			expErrStr: "Egress gateway hosts require at least one global egress gateway to enforce them",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+
+			policyClient := policy.NewMockController(mockCtrl)
+			policyClient.EXPECT().ListEgressGateways().Return(nil).AnyTimes()
+			pv := &policyValidator{
+				policyClient: policyClient,
+			}
+
+			global := `[{"service": "fsm-egress-gateway", "namespace": "fsm-system"}]`
+			if tc.noGlobal {
+				global = `[]`
+			}
+
+			input := &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "EgressGateway",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`{"apiVersion": "v1alpha1", "kind": "EgressGateway", "metadata": {"namespace": "test"}, "spec": {"global": ` + global + `, "hosts": ` + tc.hosts + `}}`),
+				},
+			}
+
+			resp, err := pv.egressGatewayValidator(input)
+			assert.Nil(resp)
+			if tc.expErrStr == "" {
+				assert.Nil(err)
+			}
+			if err != nil {
+				assert.Equal(tc.expErrStr, err.Error())
+			}
+		})
+	}
+}
+
+func TestRequestAuthenticationValidator(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     *admissionv1.AdmissionRequest
+		expResp   *admissionv1.AdmissionResponse
+		expErrStr string
+	}{
+		{
+			name: "RequestAuthentication with a valid JWKS URI passes",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "RequestAuthentication",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "RequestAuthentication",
+						"spec": {"jwtRules": [{"issuer": "https://issuer.example.com", "jwksUri": "https://issuer.example.com/.well-known/jwks.json"}]}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "RequestAuthentication with a valid inline JWKS passes",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "RequestAuthentication",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "RequestAuthentication",
+						"spec": {"jwtRules": [{"issuer": "https://issuer.example.com", "jwks": "{\"keys\": [{\"kty\": \"RSA\", \"n\": \"AQAB\", \"e\": \"AQAB\"}]}"}]}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "RequestAuthentication without JWKS fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "RequestAuthentication",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "RequestAuthentication",
+						"spec": {"jwtRules": [{"issuer": "https://issuer.example.com"}]}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Either jwks or jwksUri must be specified for issuer https://issuer.example.com",
+		},
+		{
+			name: "RequestAuthentication with an invalid JWKS URI fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "RequestAuthentication",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "RequestAuthentication",
+						"spec": {"jwtRules": [{"issuer": "https://issuer.example.com", "jwksUri": "issuer.example.com/jwks"}]}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid JWKS URI of issuer https://issuer.example.com: issuer.example.com/jwks",
+		},
+		{
+			name: "RequestAuthentication with duplicate issuers fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "RequestAuthentication",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "RequestAuthentication",
+						"spec": {"jwtRules": [{"issuer": "https://issuer.example.com", "jwksUri": "https://issuer.example.com/jwks"}, {"issuer": "https://issuer.example.com", "jwksUri": "https://issuer.example.com/jwks"}]}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Duplicate JWT rules detected with issuer: https://issuer.example.com",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			resp, err := requestAuthenticationValidator(tc.input)
+			assert.Equal(tc.expResp, resp)
+			if tc.expErrStr == "" {
+				assert.NoError(err)
+			} else {
+				assert.EqualError(err, tc.expErrStr)
+			}
+		})
+	}
+}
+
+func TestSidecarScopeValidator(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     *admissionv1.AdmissionRequest
+		expResp   *admissionv1.AdmissionResponse
+		expErrStr string
+	}{
+		{
+			name: "SidecarScope with valid namespaces, services and hosts passes",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "SidecarScope",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "SidecarScope",
+						"spec": {"workloadSelector": {"matchLabels": {"app": "bookbuyer"}}, "namespaces": ["bookstore"], "services": [{"name": "bookwarehouse", "namespace": "bookwarehouse"}], "hosts": ["*.mysql.svc.cluster.local"]}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "SidecarScope with an invalid workload selector fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "SidecarScope",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "SidecarScope",
+						"spec": {"workloadSelector": {"matchExpressions": [{"key": "app", "operator": "Unknown"}]}}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid 'workloadSelector': \"Unknown\" is not a valid label selector operator",
+		},
+		{
+			name: "SidecarScope with a service without name fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "SidecarScope",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "SidecarScope",
+						"spec": {"services": [{"namespace": "bookwarehouse"}]}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "The name of a service must be specified",
+		},
+		{
+			name: "SidecarScope with an invalid wildcard host fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "SidecarScope",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "SidecarScope",
+						"spec": {"hosts": ["bookstore.*.svc.cluster.local"]}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Expected 'hosts' to be a hostname optionally prefixed with '*.', got: bookstore.*.svc.cluster.local",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			resp, err := sidecarScopeValidator(tc.input)
+			assert.Equal(tc.expResp, resp)
+			if tc.expErrStr == "" {
+				assert.NoError(err)
+			} else {
+				assert.EqualError(err, tc.expErrStr)
+			}
+		})
+	}
+}
+
+func TestMeshFaultInjectionValidator(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     *admissionv1.AdmissionRequest
+		expResp   *admissionv1.AdmissionResponse
+		expErrStr string
+	}{
+		{
+			name: "MeshFaultInjection with a delay and an abort passes",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "MeshFaultInjection",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "MeshFaultInjection",
+						"spec": {"destination": {"name": "bookstore", "port": 14001}, "sources": {"namespaces": ["bookbuyer"], "podSelector": {"matchLabels": {"app": "bookbuyer"}}}, "delay": {"percent": 50, "fixedDelay": "2s"}, "abort": {"percent": 10, "httpStatus": 503, "grpcStatus": 14}}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "MeshFaultInjection with an invalid destination name fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "MeshFaultInjection",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "MeshFaultInjection",
+						"spec": {"destination": {"name": "bookstore.bookstore"}, "abort": {"percent": 10, "httpStatus": 503}}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid 'destination.name' \"bookstore.bookstore\": a DNS-1035 label must consist of lower case alphanumeric characters or '-', start with an alphabetic character, and end with an alphanumeric character (e.g. 'my-name',  or 'abc-123', regex used for validation is '[a-z]([-a-z0-9]*[a-z0-9])?')",
+		},
+		{
+			name: "MeshFaultInjection with an invalid pod selector fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "MeshFaultInjection",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "MeshFaultInjection",
+						"spec": {"destination": {"name": "bookstore"}, "sources": {"podSelector": {"matchExpressions": [{"key": "app", "operator": "Unknown"}]}}, "abort": {"percent": 10, "httpStatus": 503}}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid 'sources.podSelector': \"Unknown\" is not a valid label selector operator",
+		},
+		{
+			name: "MeshFaultInjection without delay nor abort fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "MeshFaultInjection",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "MeshFaultInjection",
+						"spec": {"destination": {"name": "bookstore"}}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "At least one of 'delay' or 'abort' must be specified",
+		},
+		{
+			name: "MeshFaultInjection with a negative delay fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "MeshFaultInjection",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "MeshFaultInjection",
+						"spec": {"destination": {"name": "bookstore"}, "delay": {"percent": 50, "fixedDelay": "-1s"}}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Expected 'delay.fixedDelay' to be non-negative, got: -1s",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			resp, err := meshFaultInjectionValidator(tc.input)
+			assert.Equal(tc.expResp, resp)
+			if tc.expErrStr == "" {
+				assert.NoError(err)
+			} else {
+				assert.EqualError(err, tc.expErrStr)
+			}
+		})
+	}
+}
+
+func TestRetryValidator(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     *admissionv1.AdmissionRequest
+		expResp   *admissionv1.AdmissionResponse
+		expErrStr string
+	}{
+		{
+			name: "Retry with valid retry policy succeeds",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "Retry",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "Retry",
+						"spec": {"retryPolicy": {"retryOn": "5xx", "numRetries": 3, "perTryTimeout": "1s", "retriableStatusCodes": [409, 599], "budget": {"budgetPercent": 100}, "hedging": {"delay": "100ms", "maxAttempts": 3}}}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "Retry with an invalid retriable status code fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "Retry",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "Retry",
+						"spec": {"retryPolicy": {"retryOn": "5xx", "retriableStatusCodes": [409, 600]}}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid 'retryPolicy.retriableStatusCodes' 600, must be between 100 and 599",
+		},
+		{
+			name: "Retry with an invalid budget percent fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "Retry",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "Retry",
+						"spec": {"retryPolicy": {"retryOn": "5xx", "budget": {"budgetPercent": 101}}}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid 'retryPolicy.budget.budgetPercent' 101, must be between 0 and 100",
+		},
+		{
+			name: "Retry with a negative per-try timeout fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "Retry",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "Retry",
+						"spec": {"retryPolicy": {"retryOn": "5xx", "perTryTimeout": "-1s"}}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Expected 'retryPolicy.perTryTimeout' to be non-negative, got: -1s",
+		},
+		{
+			name: "Retry with a zero hedging delay fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "Retry",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "Retry",
+						"spec": {"retryPolicy": {"retryOn": "5xx", "hedging": {"delay": "0s"}}}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Expected 'retryPolicy.hedging.delay' to be positive, got: 0s",
+		},
+		{
+			name: "Retry with a single hedging attempt fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "Retry",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "Retry",
+						"spec": {"retryPolicy": {"retryOn": "5xx", "hedging": {"delay": "100ms", "maxAttempts": 1}}}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid 'retryPolicy.hedging.maxAttempts' 1, must be at least 2",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			resp, err := retryValidator(tc.input)
+			assert.Equal(tc.expResp, resp)
+			if tc.expErrStr == "" {
+				assert.NoError(err)
+			} else {
+				assert.EqualError(err, tc.expErrStr)
+			}
+		})
+	}
+}
+
 func TestTrafficTargetValidator(t *testing.T) {
 	testCases := []struct {
 		name      string
@@ -2050,6 +2660,154 @@ func TestUpstreamTrafficSettingValidator(t *testing.T) {
 			expResp:   nil,
 			expErrStr: "invalid responseStatusCode 1",
 		},
+		{
+			name: "UpstreamTrafficSetting with valid mirrors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.flomesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"mirror": {
+								"service": "httpbin-v2",
+								"percent": 10,
+								"requestHeadersToAdd": [{"name": "x-shadow", "value": "true"}]
+							},
+							"httpRoutes": [
+								{
+								"path": "/api",
+								"mirror": {
+									"service": "other/httpbin-v3"
+								}
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "UpstreamTrafficSetting mirroring a route to its own host",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.flomesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"mirror": {
+								"service": "httpbin-v2",
+								"percent": 10,
+								"requestHeadersToAdd": [{"name": "x-shadow", "value": "true"}]
+							},
+							"httpRoutes": [
+								{
+								"path": "/api",
+								"mirror": {
+									"service": "test/httpbin"
+								}
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid mirror service \"test/httpbin\", requests cannot be mirrored to the host they are directed to",
+		},
+		{
+			name: "UpstreamTrafficSetting with a negative route timeout",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.flomesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"httpRoutes": [
+								{
+								"path": "/api",
+								"timeouts": {
+									"request": "5s",
+									"idle": "-1s"
+								}
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid idle timeout -1s of route /api, must not be negative",
+		},
+		{
+			name: "UpstreamTrafficSetting with a max ejection time shorter than the base ejection time",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.flomesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.flomesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"outlierDetection": {
+								"consecutiveGatewayErrors": 3,
+								"baseEjectionTime": "1m",
+								"maxEjectionTime": "30s"
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid maxEjectionTime 30s, must not be shorter than baseEjectionTime 1m0s",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,110 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/flomesh-io/fsm/pkg/utils"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	extv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/extension/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/jwks"
+	"github.com/flomesh-io/fsm/pkg/webhook"
+	"github.com/flomesh-io/fsm/pkg/webhook/builder"
+	whtypes "github.com/flomesh-io/fsm/pkg/webhook/types"
+)
+
+type JWTAuthWebhook struct {
+	webhook.DefaultWebhook
+}
+
+func NewJWTAuthWebhook(cfg *whtypes.RegisterConfig) whtypes.Register {
+	r := &JWTAuthWebhook{
+		DefaultWebhook: webhook.DefaultWebhook{
+			RegisterConfig: cfg,
+			Client:         cfg.Manager.GetClient(),
+		},
+	}
+
+	if blder, err := builder.WebhookConfigurationManagedBy(cfg.Manager).
+		For(&extv1alpha1.JWTAuth{}).
+		WithWebhookServiceName(cfg.WebhookSvcName).
+		WithWebhookServiceNamespace(cfg.WebhookSvcNs).
+		WithCABundle(cfg.CaBundle).
+		Complete(); err != nil {
+		return nil
+	} else {
+		r.CfgBuilder = blder
+	}
+
+	return r
+}
+
+func (r *JWTAuthWebhook) Default(ctx context.Context, obj runtime.Object) error {
+	_, ok := obj.(*extv1alpha1.JWTAuth)
+	if !ok {
+		return fmt.Errorf("unexpected type: %T", obj)
+	}
+
+	return nil
+}
+
+func (r *JWTAuthWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
+	return r.doValidation(ctx, obj)
+}
+
+func (r *JWTAuthWebhook) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (warnings admission.Warnings, err error) {
+	return r.doValidation(ctx, newObj)
+}
+
+func (r *JWTAuthWebhook) doValidation(ctx context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
+	jwtAuth, ok := obj.(*extv1alpha1.JWTAuth)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type: %T", obj)
+	}
+
+	errs := r.validateSpec(ctx, jwtAuth.Spec, field.NewPath("spec"))
+
+	if len(errs) > 0 {
+		return warnings, utils.ErrorListToError(errs)
+	}
+
+	return nil, nil
+}
+
+func (r *JWTAuthWebhook) validateSpec(_ context.Context, spec extv1alpha1.JWTAuthSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	issuers := sets.New[string]()
+	for i, provider := range spec.Providers {
+		providerPath := path.Child("providers").Index(i)
+
+		if issuers.Has(provider.Issuer) {
+			errs = append(errs, field.Duplicate(providerPath.Child("issuer"), provider.Issuer))
+		}
+		issuers.Insert(provider.Issuer)
+
+		switch {
+		case provider.JWKS != nil:
+			if _, err := jwks.Parse(*provider.JWKS); err != nil {
+				errs = append(errs, field.Invalid(providerPath.Child("jwks"), *provider.JWKS, err.Error()))
+			}
+		case provider.JWKSURI != nil:
+			if u, err := url.Parse(*provider.JWKSURI); err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+				errs = append(errs, field.Invalid(providerPath.Child("jwksUri"), *provider.JWKSURI, "must be an absolute http or https URI"))
+			}
+		default:
+			errs = append(errs, field.Required(providerPath, "either jwks or jwksUri must be set"))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,201 @@
+package flb
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/constants"
+	"github.com/flomesh-io/fsm/pkg/flb"
+	"github.com/flomesh-io/fsm/pkg/utils"
+	"github.com/flomesh-io/fsm/pkg/webhook"
+	"github.com/flomesh-io/fsm/pkg/webhook/builder"
+	whtypes "github.com/flomesh-io/fsm/pkg/webhook/types"
+)
+
+type PolicyWebhook struct {
+	webhook.DefaultWebhook
+}
+
+func NewPolicyWebhook(cfg *whtypes.RegisterConfig) whtypes.Register {
+	r := &PolicyWebhook{
+		DefaultWebhook: webhook.DefaultWebhook{
+			RegisterConfig: cfg,
+			Client:         cfg.Manager.GetClient(),
+		},
+	}
+
+	if blder, err := builder.WebhookConfigurationManagedBy(cfg.Manager).
+		For(&policyv1alpha1.FLBPolicy{}).
+		WithWebhookServiceName(cfg.WebhookSvcName).
+		WithWebhookServiceNamespace(cfg.WebhookSvcNs).
+		WithCABundle(cfg.CaBundle).
+		Complete(); err != nil {
+		return nil
+	} else {
+		r.CfgBuilder = blder
+	}
+
+	return r
+}
+
+func (r *PolicyWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
+	return r.doValidation(ctx, obj)
+}
+
+func (r *PolicyWebhook) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (warnings admission.Warnings, err error) {
+	return r.doValidation(ctx, newObj)
+}
+
+func (r *PolicyWebhook) doValidation(ctx context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
+	policy, ok := obj.(*policyv1alpha1.FLBPolicy)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type: %T", obj)
+	}
+
+	errorList := r.validateTargetRefs(policy)
+	if len(errorList) > 0 {
+		return nil, utils.ErrorListToError(errorList)
+	}
+
+	errorList = append(errorList, r.validateConflicts(ctx, policy)...)
+	errorList = append(errorList, r.validateDesiredIP(policy)...)
+	errorList = append(errorList, r.validateTLS(ctx, policy)...)
+	if len(errorList) > 0 {
+		return nil, utils.ErrorListToError(errorList)
+	}
+
+	return nil, nil
+}
+
+func (r *PolicyWebhook) validateTargetRefs(policy *policyv1alpha1.FLBPolicy) field.ErrorList {
+	var errs field.ErrorList
+
+	for i, ref := range policy.Spec.TargetRefs {
+		path := field.NewPath("spec").Child("targetRefs").Index(i)
+
+		if string(ref.Group) != constants.KubernetesCoreGroup {
+			errs = append(errs, field.Invalid(path.Child("group"), ref.Group, "group must be set to core"))
+			continue
+		}
+
+		switch string(ref.Kind) {
+		case constants.KubernetesServiceKind:
+			// do nothing
+		case constants.KubernetesNamespaceKind:
+			if string(ref.Name) != policy.Namespace {
+				errs = append(errs, field.Invalid(path.Child("name"), ref.Name, "only the namespace of the policy can be targeted"))
+			}
+		default:
+			errs = append(errs, field.Invalid(path.Child("kind"), ref.Kind, "kind must be set to Service or Namespace"))
+		}
+	}
+
+	return errs
+}
+
+func (r *PolicyWebhook) validateConflicts(ctx context.Context, policy *policyv1alpha1.FLBPolicy) field.ErrorList {
+	var errs field.ErrorList
+
+	policies := &policyv1alpha1.FLBPolicyList{}
+	if err := r.List(ctx, policies, client.InNamespace(policy.Namespace)); err != nil {
+		return append(errs, field.InternalError(field.NewPath("spec").Child("targetRefs"), err))
+	}
+
+	for i, ref := range policy.Spec.TargetRefs {
+		for j := range policies.Items {
+			other := &policies.Items[j]
+			if other.Name == policy.Name {
+				continue
+			}
+
+			if flb.HasTargetRef(other, ref) {
+				path := field.NewPath("spec").Child("targetRefs").Index(i)
+				errs = append(errs, field.Duplicate(path, fmt.Sprintf("%s %s is already targeted by FLBPolicy %s", ref.Kind, ref.Name, other.Name)))
+			}
+		}
+	}
+
+	return errs
+}
+
+func (r *PolicyWebhook) validateDesiredIP(policy *policyv1alpha1.FLBPolicy) field.ErrorList {
+	var errs field.ErrorList
+
+	if policy.Spec.DesiredIP == nil {
+		return errs
+	}
+
+	path := field.NewPath("spec").Child("desiredIP")
+	if net.ParseIP(*policy.Spec.DesiredIP) == nil {
+		errs = append(errs, field.Invalid(path, *policy.Spec.DesiredIP, "must be a valid IP address"))
+	}
+
+	if len(policy.Spec.TargetRefs) != 1 || string(policy.Spec.TargetRefs[0].Kind) != constants.KubernetesServiceKind {
+		errs = append(errs, field.Forbidden(path, "can only be set when the policy targets a single Service"))
+	}
+
+	return errs
+}
+
+func (r *PolicyWebhook) validateTLS(ctx context.Context, policy *policyv1alpha1.FLBPolicy) field.ErrorList {
+	var errs field.ErrorList
+
+	tls := policy.Spec.TLS
+	if tls == nil {
+		return errs
+	}
+
+	path := field.NewPath("spec").Child("tls")
+
+	if tls.SecretMode == "" || tls.SecretMode == policyv1alpha1.FLBTLSSecretModeLocal {
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: policy.Namespace, Name: tls.SecretName}, secret); err != nil {
+			errs = append(errs, field.Invalid(path.Child("secretName"), tls.SecretName, err.Error()))
+		} else if !flb.IsFLBTLSSecret(secret) {
+			errs = append(errs, field.Invalid(path.Child("secretName"), tls.SecretName, fmt.Sprintf("secret doesn't have required label: %s=true", constants.FLBTLSSecretLabel)))
+		} else if _, err := flb.IsValidTLSSecret(secret); err != nil {
+			errs = append(errs, field.Invalid(path.Child("secretName"), tls.SecretName, err.Error()))
+		}
+	}
+
+	for _, ref := range policy.Spec.TargetRefs {
+		if string(ref.Kind) != constants.KubernetesServiceKind {
+			continue
+		}
+
+		svc := &corev1.Service{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: policy.Namespace, Name: string(ref.Name)}, svc); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			errs = append(errs, field.InternalError(path.Child("port"), err))
+			continue
+		}
+
+		if !hasServicePort(svc, tls.Port) {
+			errs = append(errs, field.Invalid(path.Child("port"), tls.Port, fmt.Sprintf("port is not found in Service %s", svc.Name)))
+		}
+	}
+
+	return errs
+}
+
+func hasServicePort(svc *corev1.Service, port int32) bool {
+	for _, p := range svc.Spec.Ports {
+		if p.Port == port {
+			return true
+		}
+	}
+
+	return false
+}
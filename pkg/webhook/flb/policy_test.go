@@ -0,0 +1,146 @@
+package flb
+
+import (
+	"context"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	policyv1alpha1 "github.com/flomesh-io/fsm/pkg/apis/policy/v1alpha1"
+	"github.com/flomesh-io/fsm/pkg/constants"
+	policyscheme "github.com/flomesh-io/fsm/pkg/gen/client/policy/clientset/versioned/scheme"
+	"github.com/flomesh-io/fsm/pkg/webhook"
+)
+
+func targetRef(kind, name string) gwv1alpha2.LocalPolicyTargetReference {
+	return gwv1alpha2.LocalPolicyTargetReference{
+		Group: constants.KubernetesCoreGroup,
+		Kind:  gwv1alpha2.Kind(kind),
+		Name:  gwv1alpha2.ObjectName(name),
+	}
+}
+
+func TestPolicyValidation(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = policyscheme.AddToScheme(scheme)
+
+	existing := &policyv1alpha1.FLBPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "existing"},
+		Spec: policyv1alpha1.FLBPolicySpec{
+			TargetRefs: []gwv1alpha2.LocalPolicyTargetReference{targetRef(constants.KubernetesServiceKind, "taken")},
+		},
+	}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "lb"},
+		Spec: corev1.ServiceSpec{
+			Type:  corev1.ServiceTypeLoadBalancer,
+			Ports: []corev1.ServicePort{{Port: 80}},
+		},
+	}
+	unlabeled := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "unlabeled"}}
+
+	wh := &PolicyWebhook{
+		DefaultWebhook: webhook.DefaultWebhook{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing, svc, unlabeled).Build(),
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		spec      policyv1alpha1.FLBPolicySpec
+		expectErr string
+	}{
+		{
+			name: "policy targeting a service",
+			spec: policyv1alpha1.FLBPolicySpec{
+				TargetRefs: []gwv1alpha2.LocalPolicyTargetReference{targetRef(constants.KubernetesServiceKind, "lb")},
+				DesiredIP:  ptr.To("192.168.1.10"),
+			},
+		},
+		{
+			name: "policy targeting its namespace",
+			spec: policyv1alpha1.FLBPolicySpec{
+				TargetRefs: []gwv1alpha2.LocalPolicyTargetReference{targetRef(constants.KubernetesNamespaceKind, "test")},
+			},
+		},
+		{
+			name: "policy targeting another namespace",
+			spec: policyv1alpha1.FLBPolicySpec{
+				TargetRefs: []gwv1alpha2.LocalPolicyTargetReference{targetRef(constants.KubernetesNamespaceKind, "other")},
+			},
+			expectErr: "only the namespace of the policy can be targeted",
+		},
+		{
+			name: "policy targeting a deployment",
+			spec: policyv1alpha1.FLBPolicySpec{
+				TargetRefs: []gwv1alpha2.LocalPolicyTargetReference{targetRef("Deployment", "lb")},
+			},
+			expectErr: "kind must be set to Service or Namespace",
+		},
+		{
+			name: "policy targeting a service targeted by another policy",
+			spec: policyv1alpha1.FLBPolicySpec{
+				TargetRefs: []gwv1alpha2.LocalPolicyTargetReference{targetRef(constants.KubernetesServiceKind, "taken")},
+			},
+			expectErr: "is already targeted by FLBPolicy existing",
+		},
+		{
+			name: "desired IP with a namespace target",
+			spec: policyv1alpha1.FLBPolicySpec{
+				TargetRefs: []gwv1alpha2.LocalPolicyTargetReference{targetRef(constants.KubernetesNamespaceKind, "test")},
+				DesiredIP:  ptr.To("192.168.1.10"),
+			},
+			expectErr: "can only be set when the policy targets a single Service",
+		},
+		{
+			name: "invalid desired IP",
+			spec: policyv1alpha1.FLBPolicySpec{
+				TargetRefs: []gwv1alpha2.LocalPolicyTargetReference{targetRef(constants.KubernetesServiceKind, "lb")},
+				DesiredIP:  ptr.To("192.168.1"),
+			},
+			expectErr: "must be a valid IP address",
+		},
+		{
+			name: "TLS secret without the FLB label",
+			spec: policyv1alpha1.FLBPolicySpec{
+				TargetRefs: []gwv1alpha2.LocalPolicyTargetReference{targetRef(constants.KubernetesServiceKind, "lb")},
+				TLS:        &policyv1alpha1.FLBTLSConfig{Port: 80, SecretName: "unlabeled"},
+			},
+			expectErr: "secret doesn't have required label",
+		},
+		{
+			name: "TLS port not exposed by the service",
+			spec: policyv1alpha1.FLBPolicySpec{
+				TargetRefs: []gwv1alpha2.LocalPolicyTargetReference{targetRef(constants.KubernetesServiceKind, "lb")},
+				TLS:        &policyv1alpha1.FLBTLSConfig{Port: 443, SecretName: "remote", SecretMode: policyv1alpha1.FLBTLSSecretModeRemote},
+			},
+			expectErr: "port is not found in Service lb",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			policy := &policyv1alpha1.FLBPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "policy"},
+				Spec:       tc.spec,
+			}
+
+			_, err := wh.ValidateCreate(context.TODO(), policy)
+			if tc.expectErr == "" {
+				assert.NoError(err)
+			} else {
+				assert.ErrorContains(err, tc.expectErr)
+			}
+		})
+	}
+}
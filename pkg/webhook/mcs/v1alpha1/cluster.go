@@ -113,5 +113,15 @@ func (r *ClusterWebhook) doValidation(_ context.Context, obj runtime.Object) (wa
 		return nil, fmt.Errorf("invalid port number %d: %v", c.Spec.GatewayPort, errs)
 	}
 
+	if c.Spec.GatewayTLSPort != 0 {
+		if errs := validation.IsValidPortNum(int(c.Spec.GatewayTLSPort)); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid TLS port number %d: %v", c.Spec.GatewayTLSPort, errs)
+		}
+	}
+
+	if hc := c.Spec.HealthCheck; hc != nil && hc.Interval != nil && hc.Timeout != nil && hc.Timeout.Duration >= hc.Interval.Duration {
+		return nil, fmt.Errorf("health check timeout %s must be shorter than the interval %s", hc.Timeout.Duration, hc.Interval.Duration)
+	}
+
 	return nil, nil
 }
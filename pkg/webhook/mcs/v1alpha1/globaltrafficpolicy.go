@@ -69,6 +69,14 @@ func (r *GlobalTrafficPolicyWebhook) doValidation(_ context.Context, obj runtime
 		return nil, fmt.Errorf("unexpected type: %T", obj)
 	}
 
+	clusterKeys := make(map[string]bool)
+	for _, t := range policy.Spec.Targets {
+		if clusterKeys[t.ClusterKey] {
+			return nil, fmt.Errorf("duplicate target %s", t.ClusterKey)
+		}
+		clusterKeys[t.ClusterKey] = true
+	}
+
 	switch policy.Spec.LbType {
 	case mcsv1alpha1.LocalityLbType:
 		if len(policy.Spec.Targets) > 1 {
@@ -78,6 +86,16 @@ func (r *GlobalTrafficPolicyWebhook) doValidation(_ context.Context, obj runtime
 		if len(policy.Spec.Targets) == 0 {
 			return nil, fmt.Errorf("requires at least one cluster for failover")
 		}
+
+		for _, t := range policy.Spec.Targets {
+			if t.Priority != nil && *t.Priority < 0 {
+				return nil, fmt.Errorf("priority %d of %s is invalid for failover, it must be >= 0", *t.Priority, t.ClusterKey)
+			}
+		}
+
+		if hc := policy.Spec.HealthCheck; hc != nil && hc.Interval != nil && hc.Timeout != nil && hc.Timeout.Duration >= hc.Interval.Duration {
+			return nil, fmt.Errorf("health check timeout %s must be shorter than the interval %s", hc.Timeout.Duration, hc.Interval.Duration)
+		}
 	case mcsv1alpha1.ActiveActiveLbType:
 		//if len(policy.Spec.Targets) == 0 {
 		//	return fmt.Errorf("requires at least another one cluster for active-active load balancing")
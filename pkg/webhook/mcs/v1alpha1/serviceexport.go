@@ -1,6 +1,12 @@
 package ingress
 
 import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
 	"github.com/flomesh-io/fsm/pkg/webhook"
 	whtypes "github.com/flomesh-io/fsm/pkg/webhook/types"
 
@@ -34,3 +40,51 @@ func NewServiceExportWebhook(cfg *whtypes.RegisterConfig) whtypes.Register {
 
 	return r
 }
+
+func (r *ServiceExportWebhook) Default(_ context.Context, obj runtime.Object) error {
+	export, ok := obj.(*mcsv1alpha1.ServiceExport)
+	if !ok {
+		return fmt.Errorf("unexpected type: %T", obj)
+	}
+
+	for i := range export.Spec.Rules {
+		if export.Spec.Rules[i].Protocol == "" {
+			export.Spec.Rules[i].Protocol = mcsv1alpha1.ServiceExportProtocolHTTP
+		}
+	}
+
+	return nil
+}
+
+func (r *ServiceExportWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
+	return r.doValidation(ctx, obj)
+}
+
+func (r *ServiceExportWebhook) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (warnings admission.Warnings, err error) {
+	return r.doValidation(ctx, newObj)
+}
+
+func (r *ServiceExportWebhook) doValidation(_ context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
+	export, ok := obj.(*mcsv1alpha1.ServiceExport)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type: %T", obj)
+	}
+
+	protocols := make(map[int32]mcsv1alpha1.ServiceExportProtocol)
+	for _, rule := range export.Spec.Rules {
+		if protocol, found := protocols[rule.PortNumber]; found && (protocol != mcsv1alpha1.ServiceExportProtocolHTTP || rule.IsRoutedBySNI()) {
+			return nil, fmt.Errorf("port %d is exported more than once, only HTTP port can be exported with multiple paths", rule.PortNumber)
+		}
+		protocols[rule.PortNumber] = rule.Protocol
+
+		if rule.IsRoutedBySNI() {
+			if rule.Path != "" || rule.PathType != nil {
+				return nil, fmt.Errorf("path and pathType are not applicable to the %s port %d, it's routed by SNI", rule.Protocol, rule.PortNumber)
+			}
+		} else if rule.Path == "" || rule.PathType == nil {
+			return nil, fmt.Errorf("path and pathType are required for the HTTP port %d", rule.PortNumber)
+		}
+	}
+
+	return nil, nil
+}
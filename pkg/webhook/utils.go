@@ -59,6 +59,11 @@ func NewValidatingWebhookConfiguration(webhooks []admissionregv1.ValidatingWebho
 func ValidateParentRefs(refs []gwv1.ParentReference) field.ErrorList {
 	var errs field.ErrorList
 	for i, ref := range refs {
+		// A route attached to a Service (GAMMA) applies to all ports of the Service if port is not set
+		if isServiceParentRef(ref) {
+			continue
+		}
+
 		if ref.Port == nil {
 			path := field.NewPath("spec").Child("parentRefs").Index(i)
 			errs = append(errs, field.Required(path, "port must be set"))
@@ -68,6 +73,11 @@ func ValidateParentRefs(refs []gwv1.ParentReference) field.ErrorList {
 	return errs
 }
 
+func isServiceParentRef(ref gwv1.ParentReference) bool {
+	return ref.Group != nil && string(*ref.Group) == constants.KubernetesCoreGroup &&
+		ref.Kind != nil && string(*ref.Kind) == constants.KubernetesServiceKind
+}
+
 // IsValidHostname validates the hostname of gateway route resource
 func IsValidHostname(hostname string) error {
 	if net.ParseIP(hostname) != nil {